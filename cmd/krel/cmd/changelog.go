@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/changelog"
+)
+
+type changelogOptions struct {
+	changelog.Options
+
+	profile string
+}
+
+var changelogOpts = &changelogOptions{}
+
+func init() {
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.Tag,
+		"tag",
+		"",
+		"The version tag of the release, for example v1.30.0",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.Branch,
+		"branch",
+		"",
+		"The branch to be used for the release notes, defaults to the release branch of the tag from the profile",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.RepoPath,
+		"repo",
+		".",
+		"The local path to the repository to be used",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.Bucket,
+		"bucket",
+		"kubernetes-release",
+		"The bucket used for the downloads table",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.Tars,
+		"tars",
+		".",
+		"The directory containing the release tarballs for the downloads table",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.Images,
+		"images",
+		"",
+		"The directory containing the release images for the downloads table",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.HTMLFile,
+		"html-file",
+		"",
+		"The target HTML file to be written, defaults to the changelog file name of the profile",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.JSONFile,
+		"json-file",
+		"",
+		"The target JSON file to be written, defaults to the changelog file name of the profile",
+	)
+
+	changelogCmd.PersistentFlags().BoolVar(
+		&changelogOpts.Dependencies,
+		"dependencies",
+		true,
+		"Add dependency report to the changelog",
+	)
+
+	changelogCmd.PersistentFlags().StringVar(
+		&changelogOpts.profile,
+		"profile",
+		"",
+		"Path to a YAML changelog profile describing the repository conventions, defaults to kubernetes/kubernetes",
+	)
+
+	rootCmd.AddCommand(changelogCmd)
+}
+
+// changelogCmd represents the subcommand for `krel changelog`.
+var changelogCmd = &cobra.Command{
+	Use:   "changelog",
+	Short: "Automate the lifecycle of CHANGELOG-x.y.{md,html,json} files",
+	Long: `krel changelog --tag v1.30.0 [--profile profile.yaml]
+
+The 'changelog' subcommand of krel generates the release notes for a tag,
+writes them into the markdown changelog on the main and release branch and
+produces the HTML and JSON changelog files.
+
+The repository conventions are read from the changelog profile, which
+defaults to kubernetes/kubernetes. A custom profile allows to use the
+changelog for any repository using the same release notes pipeline:
+
+  githubOrg: example
+  githubRepo: app
+  tagPrefix: v
+  mainBranch: main
+  branchPattern: release-{{.Major}}.{{.Minor}}
+  changelogDir: CHANGELOG
+  changelogFilePattern: CHANGELOG-{{.Major}}.{{.Minor}}
+  changelogReadme: README.md
+  draftSource: ""
+
+Fields which are not set keep their Kubernetes default. An empty draftSource
+generates the notes of new minor releases from the previous minor release
+instead of downloading the release notes draft.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PreRunE: func(*cobra.Command, []string) error {
+		return changelogOpts.Validate()
+	},
+	RunE: func(*cobra.Command, []string) error {
+		return runChangelog(changelogOpts)
+	},
+}
+
+// Validate checks the options and loads the changelog profile.
+func (o *changelogOptions) Validate() error {
+	if o.Tag == "" {
+		return errors.New("--tag must be provided")
+	}
+
+	profile, err := loadChangelogProfile(o.profile)
+	if err != nil {
+		return err
+	}
+
+	o.Profile = profile
+
+	return nil
+}
+
+// loadChangelogProfile loads the changelog profile from the provided path or
+// returns the default profile if the path is empty.
+func loadChangelogProfile(path string) (*changelog.Profile, error) {
+	if path == "" {
+		return changelog.DefaultProfile(), nil
+	}
+
+	profile, err := changelog.LoadProfile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading changelog profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+func runChangelog(opts *changelogOptions) error {
+	if err := changelog.New(&opts.Options).Run(); err != nil {
+		return fmt.Errorf("generating changelog: %w", err)
+	}
+
+	return nil
+}
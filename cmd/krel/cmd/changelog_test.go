@@ -54,6 +54,25 @@ func TestChangelogNoArgumentsOrFlags(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestChangelogOptionsValidate(t *testing.T) {
+	opts := &changelogOptions{}
+	require.Error(t, opts.Validate())
+
+	opts.Tag = "v1.30.0"
+	require.NoError(t, opts.Validate())
+	require.True(t, opts.Profile.IsKubernetes())
+
+	profile := filepath.Join(t.TempDir(), "profile.yaml")
+	require.NoError(t, os.WriteFile(profile, []byte("githubOrg: example\ngithubRepo: app\n"), 0o600))
+
+	opts.profile = profile
+	require.NoError(t, opts.Validate())
+	require.Equal(t, "example", opts.Profile.GithubOrg)
+
+	opts.profile = filepath.Join(t.TempDir(), "missing.yaml")
+	require.Error(t, opts.Validate())
+}
+
 func TestNewPatchRelease(t *testing.T) {
 	// Given
 	s := newSUT(t)
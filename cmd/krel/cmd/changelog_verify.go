@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/changelog"
+)
+
+type changelogVerifyOptions struct {
+	githubTags bool
+	checkLinks bool
+	output     string
+}
+
+var changelogVerifyOpts = &changelogVerifyOptions{}
+
+func init() {
+	changelogVerifyCmd.Flags().BoolVar(
+		&changelogVerifyOpts.githubTags,
+		"github-tags",
+		false,
+		"Cross check the latest GitHub tags per branch in addition to the local git tags",
+	)
+
+	changelogVerifyCmd.Flags().BoolVar(
+		&changelogVerifyOpts.checkLinks,
+		"check-links",
+		false,
+		"Check if the links of the downloads tables can be reached",
+	)
+
+	changelogVerifyCmd.Flags().StringVar(
+		&changelogVerifyOpts.output,
+		"output",
+		"",
+		"The path to the JSON report, defaults to stdout",
+	)
+
+	changelogCmd.AddCommand(changelogVerifyCmd)
+}
+
+// changelogVerifyCmd represents the subcommand for `krel changelog verify`.
+var changelogVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the consistency of the CHANGELOG-x.y.md files",
+	Long: `krel changelog verify [--repo <path>] [--profile profile.yaml]
+
+The 'verify' subcommand walks all markdown changelog files of the repository
+and reports their drift as JSON:
+
+- released tags without a changelog file or heading, and headings without a tag
+- duplicate headings or headings in the changelog file of another minor version
+- missing table of contents markers or an outdated table of contents
+- downloads table links which do not match their release and invalid SHA512
+  checksums
+
+The command fails if any issue has been found.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runChangelogVerify(changelogOpts, changelogVerifyOpts, cmd.OutOrStdout())
+	},
+}
+
+func runChangelogVerify(opts *changelogOptions, verifyOpts *changelogVerifyOptions, w io.Writer) error {
+	profile, err := loadChangelogProfile(opts.profile)
+	if err != nil {
+		return err
+	}
+
+	report, err := changelog.NewVerifier(&changelog.VerifyOptions{
+		RepoPath:   opts.RepoPath,
+		GitHubTags: verifyOpts.githubTags,
+		CheckLinks: verifyOpts.checkLinks,
+		Profile:    profile,
+	}).Verify()
+	if err != nil {
+		return fmt.Errorf("verifying changelog: %w", err)
+	}
+
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling report: %w", err)
+	}
+
+	if verifyOpts.output == "" {
+		if _, err := fmt.Fprintln(w, string(content)); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+	} else if err := os.WriteFile(verifyOpts.output, content, os.FileMode(0o644)); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+
+	if len(report.Issues) > 0 {
+		return fmt.Errorf("found %d changelog issues", len(report.Issues))
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/changelog"
+)
+
+func TestRunChangelogVerify(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, changelog.RepoChangelogDir), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, changelog.RepoChangelogDir, "CHANGELOG-1.30.md"),
+		[]byte(`<!-- BEGIN MUNGE: GENERATED_TOC -->
+
+- [v1.30.0](#v1300)
+
+<!-- END MUNGE: GENERATED_TOC -->
+
+# v1.30.0
+`), 0o600,
+	))
+
+	git("init", "-q")
+	git("add", "-A")
+	git("commit", "-q", "-m", "changelog")
+	git("tag", "v1.30.0")
+	git("tag", "v1.30.1")
+
+	output := filepath.Join(t.TempDir(), "report.json")
+	opts := &changelogOptions{Options: changelog.Options{RepoPath: dir}}
+
+	out := &bytes.Buffer{}
+	require.Error(t, runChangelogVerify(opts, &changelogVerifyOptions{output: output}, out))
+	require.Empty(t, out.String())
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	report := &changelog.VerifyReport{}
+	require.NoError(t, json.Unmarshal(content, report))
+	require.Equal(t, []string{"v1.30.0", "v1.30.1"}, report.Tags)
+	require.Len(t, report.Issues, 1)
+	require.Equal(t, changelog.IssueMissingHeading, report.Issues[0].Type)
+	require.Equal(t, "v1.30.1", report.Issues[0].Tag)
+
+	// Report to the writer
+	require.Error(t, runChangelogVerify(opts, &changelogVerifyOptions{}, out))
+	require.JSONEq(t, string(content), out.String())
+
+	// Invalid profile
+	opts.profile = filepath.Join(t.TempDir(), "missing.yaml")
+	require.Error(t, runChangelogVerify(opts, &changelogVerifyOptions{output: output}, out))
+}
@@ -42,6 +42,11 @@ CVE vulnerability information.
 
 The command enables creatin, editing and deleting existing CVE entries in the 
 release bucket. See each subcommand for more information.
+
+The CVE maps are stored in the release bucket by default. Use --storage local
+or --storage git together with --storage-path to manage the maps in a local
+directory or git repository instead, for example to keep embargoed CVE data
+offline. The git storage commits every change but never pushes.
 `,
 	SilenceUsage:  false,
 	SilenceErrors: false,
@@ -79,8 +84,9 @@ delete all content from the file.
 }
 
 type cveOptions struct {
-	CVE      string   // CVE identifier to work on
-	mapFiles []string // List of mapfiles
+	CVE      string             // CVE identifier to work on
+	mapFiles []string           // List of mapfiles
+	client   *cve.ClientOptions // Storage of the CVE maps
 }
 
 var argFunc = func(cmd *cobra.Command, args []string) error {
@@ -95,7 +101,7 @@ var argFunc = func(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-var cveOpts = &cveOptions{}
+var cveOpts = &cveOptions{client: cve.DefaultClientOptions()}
 
 func init() {
 	cveCmd.PersistentFlags().StringSliceVarP(
@@ -106,13 +112,40 @@ func init() {
 		"update vulnerability data from a local map file",
 	)
 
+	cveCmd.PersistentFlags().StringVar(
+		&cveOpts.client.Storage,
+		"storage",
+		cveOpts.client.Storage,
+		fmt.Sprintf("storage backend of the CVE maps, one of: %s", strings.Join(cve.StorageBackends(), ", ")),
+	)
+
+	cveCmd.PersistentFlags().StringVar(
+		&cveOpts.client.Path,
+		"storage-path",
+		"",
+		"directory of the CVE maps when using the local or git storage",
+	)
+
 	cveCmd.AddCommand(cveEditCmd, cveDeleteCmd)
 	rootCmd.AddCommand(cveCmd)
 }
 
+// newCVEClient creates a CVE client using the selected storage.
+func newCVEClient(opts *cveOptions) (*cve.Client, error) {
+	client, err := cve.NewClientWithOptions(opts.client)
+	if err != nil {
+		return nil, fmt.Errorf("creating CVE client: %w", err)
+	}
+
+	return client, nil
+}
+
 // writeNewCVE opens an editor to edit a new CVE entry interactively.
 func writeNewCVE(opts *cveOptions) (err error) {
-	client := cve.NewClient()
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
 
 	file, err := client.CreateEmptyMap(opts.CVE)
 	if err != nil {
@@ -147,7 +180,11 @@ func writeNewCVE(opts *cveOptions) (err error) {
 
 // writeCVEFiles handles non interactive file writes.
 func writeCVEFiles(opts *cveOptions) error {
-	client := cve.NewClient()
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
+
 	for _, mapFile := range opts.mapFiles {
 		if err := client.Write(opts.CVE, mapFile); err != nil {
 			return fmt.Errorf("writing map file %s: %w", mapFile, err)
@@ -159,22 +196,28 @@ func writeCVEFiles(opts *cveOptions) error {
 
 // deleteCVE removes an existing map file.
 func deleteCVE(opts *cveOptions) (err error) {
-	client := cve.NewClient()
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
 
 	return client.Delete(opts.CVE)
 }
 
 // editCVE main edit function.
 func editCVE(opts *cveOptions) (err error) {
-	client := cve.NewClient()
-
 	// If yaml files were specified, skip the interactive mode
 	if len(opts.mapFiles) != 0 {
 		return writeCVEFiles(opts)
 	}
 
 	// If we're editing interactively, check if it is a new CVE
-	// or we should first pull the data from the bucket
+	// or we should first pull the data from the storage
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
+
 	exists, err := client.EntryExists(opts.CVE)
 	if err != nil {
 		return fmt.Errorf("checking if cve entry exists: %w", err)
@@ -187,10 +230,13 @@ func editCVE(opts *cveOptions) (err error) {
 	return writeNewCVE(opts)
 }
 
-// editExistingCVE loads an existing map from the bucket and opens is
+// editExistingCVE loads an existing map from the storage and opens is
 // in the user's default editor.
 func editExistingCVE(opts *cveOptions) (err error) {
-	client := cve.NewClient()
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
 
 	file, err := client.CopyToTemp(opts.CVE)
 	if err != nil {
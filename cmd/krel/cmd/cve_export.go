@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/cve"
+)
+
+type cveExportOptions struct {
+	*cve.ExportOptions
+
+	ids []string
+}
+
+var cveExportOpts = &cveExportOptions{ExportOptions: cve.DefaultExportOptions()}
+
+func init() {
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.Format,
+		"format",
+		cveExportOpts.Format,
+		fmt.Sprintf("The export format, one of: %s", strings.Join(cve.ExportFormats(), ", ")),
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.OutputDir,
+		"output",
+		"",
+		"The directory where one document per CVE will be written",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.RepoPath,
+		"repo",
+		"",
+		"Path to a local repository clone including all release tags, used to derive the affected and fixed versions from the linked PRs",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.GithubOrg,
+		"org",
+		cveExportOpts.GithubOrg,
+		"The GitHub organization of the linked PRs",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.GithubRepo,
+		"github-repo",
+		cveExportOpts.GithubRepo,
+		"The GitHub repository of the linked PRs",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.Package,
+		"package",
+		cveExportOpts.Package,
+		"The affected package name of the OSV documents",
+	)
+
+	cveExportCmd.PersistentFlags().StringVar(
+		&cveExportOpts.Product,
+		"product",
+		cveExportOpts.Product,
+		"The affected product name of the CSAF documents",
+	)
+
+	cveCmd.AddCommand(cveExportCmd)
+}
+
+var cveExportCmd = &cobra.Command{
+	Use:   "export [CVE-ID...]",
+	Short: "Export CVE maps as OSV or CSAF VEX documents",
+	Long: `krel cve export -f <map file or directory> --format osv|csaf --output <dir>
+
+The export command converts validated CVE maps into vulnerability documents
+consumed by security tooling:
+
+- osv:  Open Source Vulnerability documents (https://ossf.github.io/osv-schema)
+- csaf: CSAF 2.0 documents using the VEX profile
+
+If a local repository clone is provided via --repo, the affected and fixed
+version ranges are derived from the release tags containing the merge commits
+of the linked PRs. Otherwise, all versions are reported as affected.
+
+The maps are read from the files and directories provided via --file. If CVE
+identifiers are passed as arguments, only those CVEs are exported.
+`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PreRunE: func(_ *cobra.Command, args []string) error {
+		for _, id := range args {
+			if err := cve.ValidateID(strings.ToUpper(id)); err != nil {
+				return fmt.Errorf("invalid CVE ID %s: %w", id, err)
+			}
+
+			cveExportOpts.ids = append(cveExportOpts.ids, strings.ToUpper(id))
+		}
+
+		return cveExportOpts.Validate()
+	},
+	RunE: func(*cobra.Command, []string) error {
+		return exportCVEs(cveOpts, cveExportOpts)
+	},
+}
+
+// exportCVEs reads the CVE maps and exports them.
+func exportCVEs(opts *cveOptions, exportOpts *cveExportOptions) error {
+	if len(opts.mapFiles) == 0 {
+		return errors.New("no CVE maps provided, use --file to specify map files or directories")
+	}
+
+	cves, err := cve.ReadMaps(opts.mapFiles...)
+	if err != nil {
+		return fmt.Errorf("reading CVE maps: %w", err)
+	}
+
+	if len(exportOpts.ids) > 0 {
+		cves = slices.DeleteFunc(cves, func(c *cve.CVE) bool {
+			return !slices.Contains(exportOpts.ids, c.ID)
+		})
+	}
+
+	if len(cves) == 0 {
+		return errors.New("no CVEs found to export")
+	}
+
+	if err := cve.NewExporter(exportOpts.ExportOptions).Export(cves); err != nil {
+		return fmt.Errorf("exporting CVEs: %w", err)
+	}
+
+	return nil
+}
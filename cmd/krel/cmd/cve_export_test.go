@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/cve"
+)
+
+func TestExportCVEs(t *testing.T) {
+	mapFile := filepath.Join(t.TempDir(), "cve.yaml")
+	require.NoError(t, os.WriteFile(mapFile, []byte(`---
+pr: 100
+datafields:
+  cve:
+    id: CVE-2024-1234
+    title: Node escape via crafted volume
+    vector: CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
+    score: 6.4
+    rating: Medium
+    description: A crafted volume allows escaping to the node.
+`), 0o600))
+
+	exportOpts := &cveExportOptions{ExportOptions: cve.DefaultExportOptions()}
+	exportOpts.Format = cve.FormatCSAF
+	exportOpts.OutputDir = t.TempDir()
+
+	// No maps provided
+	require.Error(t, exportCVEs(&cveOptions{}, exportOpts))
+
+	opts := &cveOptions{mapFiles: []string{mapFile}}
+	require.NoError(t, exportCVEs(opts, exportOpts))
+	require.FileExists(t, filepath.Join(exportOpts.OutputDir, "cve-2024-1234.json"))
+
+	// Unknown CVE ID filter
+	exportOpts.ids = []string{"CVE-2024-9999"}
+	require.Error(t, exportCVEs(opts, exportOpts))
+}
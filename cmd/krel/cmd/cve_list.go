@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cveCmd.AddCommand(cveListCmd, cveShowCmd)
+}
+
+var cveListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the existing CVE maps",
+	Long: `krel cve list [--storage gcs|local|git] [--storage-path <dir>]
+
+Lists the identifiers of all CVE maps in the selected storage.
+`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Args:          cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return listCVEs(cveOpts, os.Stdout)
+	},
+}
+
+var cveShowCmd = &cobra.Command{
+	Use:   "show CVE-ID",
+	Short: "Print an existing CVE map",
+	Long: `krel cve show CVE-ID [--storage gcs|local|git] [--storage-path <dir>]
+
+Prints the CVE map of the identifier from the selected storage.
+`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Args:          argFunc,
+	RunE: func(*cobra.Command, []string) error {
+		return showCVE(cveOpts, os.Stdout)
+	},
+}
+
+// listCVEs prints the IDs of all CVE maps in the storage.
+func listCVEs(opts *cveOptions, w io.Writer) error {
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
+
+	ids, err := client.List()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if _, err := fmt.Fprintln(w, id); err != nil {
+			return fmt.Errorf("writing CVE ID: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// showCVE prints the map of a CVE from the storage.
+func showCVE(opts *cveOptions, w io.Writer) error {
+	client, err := newCVEClient(opts)
+	if err != nil {
+		return err
+	}
+
+	content, err := client.Read(opts.CVE)
+	if err != nil {
+		return fmt.Errorf("reading %s map: %w", opts.CVE, err)
+	}
+
+	if _, err := w.Write(content); err != nil {
+		return fmt.Errorf("writing %s map: %w", opts.CVE, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/cve"
+)
+
+func TestListAndShowCVEs(t *testing.T) {
+	const cveMap = "---\npr: 100\ndatafields:\n  cve:\n    id: CVE-2024-1234\n"
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CVE-2024-1234.yaml"), []byte(cveMap), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CVE-2023-1.yaml"), []byte(cveMap), 0o600))
+
+	opts := &cveOptions{
+		CVE:    "CVE-2024-1234",
+		client: &cve.ClientOptions{Storage: cve.StorageLocal, Path: dir},
+	}
+
+	out := &bytes.Buffer{}
+	require.NoError(t, listCVEs(opts, out))
+	require.Equal(t, "CVE-2023-1\nCVE-2024-1234\n", out.String())
+
+	out.Reset()
+	require.NoError(t, showCVE(opts, out))
+	require.Equal(t, cveMap, out.String())
+
+	opts.CVE = "CVE-2024-9999"
+	require.Error(t, showCVE(opts, out))
+
+	// Unsupported storage
+	opts.client.Storage = "invalid"
+	require.Error(t, listCVEs(opts, out))
+}
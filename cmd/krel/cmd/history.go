@@ -58,5 +58,12 @@ func init() {
 		"Get the jobs ending from a specific date.",
 	)
 
+	historyCmd.PersistentFlags().BoolVar(
+		&historyOpts.Reports,
+		"reports",
+		historyOpts.Reports,
+		"Add the step durations from the stage and release run reports.",
+	)
+
 	rootCmd.AddCommand(historyCmd)
 }
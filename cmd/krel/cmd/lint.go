@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/notes/lint"
+)
+
+type releaseNotesLintOptions struct {
+	maps       string
+	notes      string
+	format     string
+	outputFile string
+	fix        bool
+	severities map[string]string
+	maxLength  int
+}
+
+var releaseNotesLintOpts = &releaseNotesLintOptions{}
+
+func init() {
+	lintCmd.PersistentFlags().StringVar(
+		&releaseNotesLintOpts.maps,
+		"maps",
+		"",
+		"The path to the release notes maps to lint. Can be a top level directory or a specific file.",
+	)
+
+	lintCmd.PersistentFlags().StringVar(
+		&releaseNotesLintOpts.notes,
+		"notes",
+		"",
+		"The path to a release notes JSON document to lint, as generated by `release-notes --format=json`.",
+	)
+
+	lintCmd.PersistentFlags().StringVar(
+		&releaseNotesLintOpts.format,
+		"format",
+		lint.FormatText,
+		fmt.Sprintf("The output format of the findings, options: %s", strings.Join(lint.Formats(), ", ")),
+	)
+
+	lintCmd.PersistentFlags().StringVar(
+		&releaseNotesLintOpts.outputFile,
+		"output",
+		"",
+		"The path where the findings will be written, defaults to stdout",
+	)
+
+	lintCmd.PersistentFlags().BoolVar(
+		&releaseNotesLintOpts.fix,
+		"fix",
+		false,
+		"Fix the violations of rules which support it and write the changes back. Maps containing comments are not fixed.",
+	)
+
+	lintCmd.PersistentFlags().StringToStringVar(
+		&releaseNotesLintOpts.severities,
+		"severity",
+		map[string]string{},
+		"Override the severity of a rule, for example --severity past-tense=error,kep-link=off. "+
+			"Valid severities are: error, warning, note, off",
+	)
+
+	lintCmd.PersistentFlags().IntVar(
+		&releaseNotesLintOpts.maxLength,
+		"max-length",
+		lint.DefaultMaxLength,
+		"The maximum length of a release note text in characters, 0 to disable the check",
+	)
+
+	releaseNotesCmd.AddCommand(lintCmd)
+}
+
+// lintCmd represents the subcommand for `krel release-notes lint`.
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Lint release notes maps and gathered release notes",
+	Long: `krel release-notes lint --maps <path> --notes <release-notes.json>
+
+The 'lint' subcommand of krel applies a set of rules to release notes maps and
+gathered release notes:
+
+` + lintRulesDescription() + `
+Every rule has a default severity which can be overridden using --severity.
+Findings with the severity 'error' fail the command. The findings can be
+written as text, JSON or SARIF for annotations in CI systems.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PreRunE: func(*cobra.Command, []string) error {
+		return releaseNotesLintOpts.Validate()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		output := cmd.OutOrStdout()
+
+		if releaseNotesLintOpts.outputFile != "" {
+			f, err := os.Create(releaseNotesLintOpts.outputFile)
+			if err != nil {
+				return fmt.Errorf("creating output file: %w", err)
+			}
+			defer f.Close()
+
+			output = f
+		}
+
+		return runLintReleaseNotes(output, releaseNotesLintOpts)
+	},
+}
+
+func lintRulesDescription() string {
+	var sb strings.Builder
+
+	for _, rule := range lint.DefaultRules() {
+		fmt.Fprintf(&sb, "- %s (%s): %s\n", rule.ID(), rule.DefaultSeverity(), rule.Description())
+	}
+
+	return sb.String()
+}
+
+// Validate checks the options.
+func (o *releaseNotesLintOptions) Validate() error {
+	if o.maps == "" && o.notes == "" {
+		return errors.New("at least one of --maps or --notes must be provided")
+	}
+
+	if !slices.Contains(lint.Formats(), o.format) {
+		return fmt.Errorf("invalid format %q, must be one of: %s", o.format, strings.Join(lint.Formats(), ", "))
+	}
+
+	if o.maxLength < 0 {
+		return errors.New("--max-length must not be negative")
+	}
+
+	return nil
+}
+
+// linterOptions converts the command line options into linter options.
+func (o *releaseNotesLintOptions) linterOptions() (*lint.Options, error) {
+	opts := lint.DefaultOptions()
+	opts.MaxLength = o.maxLength
+	opts.Fix = o.fix
+
+	for rule, value := range o.severities {
+		severity, err := lint.ParseSeverity(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing severity of rule %s: %w", rule, err)
+		}
+
+		opts.Severities[rule] = severity
+	}
+
+	return opts, nil
+}
+
+func runLintReleaseNotes(output io.Writer, opts *releaseNotesLintOptions) error {
+	linterOpts, err := opts.linterOptions()
+	if err != nil {
+		return err
+	}
+
+	linter, err := lint.New(linterOpts)
+	if err != nil {
+		return fmt.Errorf("creating linter: %w", err)
+	}
+
+	files := []*lint.File{}
+
+	if opts.maps != "" {
+		if _, err := os.Stat(opts.maps); err != nil {
+			return fmt.Errorf("checking release notes maps path: %w", err)
+		}
+
+		mapFiles, err := lint.LoadMaps(opts.maps)
+		if err != nil {
+			return fmt.Errorf("loading release notes maps: %w", err)
+		}
+
+		files = append(files, mapFiles...)
+	}
+
+	if opts.notes != "" {
+		notesFile, err := lint.LoadNotes(opts.notes)
+		if err != nil {
+			return fmt.Errorf("loading release notes: %w", err)
+		}
+
+		files = append(files, notesFile)
+	}
+
+	targets := []*lint.Target{}
+	for _, file := range files {
+		targets = append(targets, file.Targets...)
+	}
+
+	res := linter.Lint(targets)
+
+	if opts.fix {
+		for _, file := range files {
+			if !file.Modified() {
+				continue
+			}
+
+			logrus.Infof("Writing fixes to %s", file.Path)
+
+			if err := file.Write(); err != nil {
+				return fmt.Errorf("writing fixes: %w", err)
+			}
+		}
+	}
+
+	if err := res.Write(output, opts.format); err != nil {
+		return fmt.Errorf("writing lint result: %w", err)
+	}
+
+	if res.Failed() {
+		return errors.New("release notes lint failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes/lint"
+)
+
+func TestRunLintReleaseNotes(t *testing.T) {
+	testDataPath := "testdata/validation-data"
+	output := &bytes.Buffer{}
+
+	// Valid map has no findings
+	opts := &releaseNotesLintOptions{
+		maps:      filepath.Join(testDataPath, "valid.yaml"),
+		format:    lint.FormatText,
+		maxLength: lint.DefaultMaxLength,
+	}
+	require.NoError(t, opts.Validate())
+	require.NoError(t, runLintReleaseNotes(output, opts))
+	require.Empty(t, output.String())
+
+	// Missing punctuation fails
+	opts.maps = filepath.Join(testDataPath, "missing-punctuation.yaml")
+	err := runLintReleaseNotes(output, opts)
+	require.Error(t, err)
+	require.Contains(t, output.String(), "[trailing-punctuation]")
+
+	// ... unless the rule is disabled
+	output.Reset()
+	opts.severities = map[string]string{lint.RuleTrailingPunctuation: "off"}
+	require.NoError(t, runLintReleaseNotes(output, opts))
+
+	// Invalid options
+	opts.severities = map[string]string{lint.RuleTrailingPunctuation: "wrong"}
+	require.Error(t, runLintReleaseNotes(output, opts))
+	require.Error(t, (&releaseNotesLintOptions{format: lint.FormatText}).Validate())
+	require.Error(t, (&releaseNotesLintOptions{maps: "maps", format: "wrong"}).Validate())
+}
@@ -90,7 +90,7 @@ func init() {
 		&pushBuildOpts.Bucket,
 		"bucket",
 		"devel",
-		"Specify an alternate bucket for pushes (normally 'devel' or 'ci')",
+		"Specify an alternate bucket for pushes (normally 'devel' or 'ci'), can be prefixed with 'file://' or 's3://' to use a local directory or an S3 compatible store",
 	)
 
 	pushBuildCmd.PersistentFlags().StringVar(
@@ -143,6 +143,13 @@ func init() {
 		"Validate that the remote image digests exists",
 	)
 
+	pushBuildCmd.PersistentFlags().IntVar(
+		&pushBuildOpts.MaxImageWorkers,
+		"max-image-workers",
+		release.DefaultMaxImageWorkers,
+		"The maximum amount of container images to be pushed or validated in parallel",
+	)
+
 	pushBuildCmd.PersistentFlags().StringVar(
 		&pushBuildOpts.RepoRoot,
 		"repo-root",
@@ -121,6 +121,54 @@ func init() {
 			"Run the Google Cloud Build job synchronously",
 		)
 
+	releaseCmd.PersistentFlags().
+		BoolVar(
+			&releaseOptions.Resume,
+			resumeFlag,
+			false,
+			"Resume a previously interrupted local run from its checkpoint and skip all completed steps",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringSliceVar(
+			&releaseOptions.OnlySteps,
+			onlyStepsFlag,
+			nil,
+			"Comma separated list of steps to run exclusively",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringSliceVar(
+			&releaseOptions.SkipSteps,
+			skipStepsFlag,
+			nil,
+			"Comma separated list of steps to skip",
+		)
+
+	releaseCmd.PersistentFlags().
+		StringVar(
+			&releaseOptions.CustomBucket,
+			bucketFlag,
+			"",
+			"Custom bucket for mock runs, for example a local directory like 'file:///tmp/bucket' or an S3 bucket like 's3://bucket'",
+		)
+
+	releaseCmd.PersistentFlags().
+		IntVar(
+			&releaseOptions.MaxImageWorkers,
+			maxImageWorkersFlag,
+			releaseOptions.MaxImageWorkers,
+			"The maximum amount of container images to be pushed or validated in parallel",
+		)
+
+	releaseCmd.PersistentFlags().
+		BoolVar(
+			&listSteps,
+			listStepsFlag,
+			false,
+			"List all available steps and exit",
+		)
+
 	if err := releaseCmd.PersistentFlags().MarkHidden(submitJobFlag); err != nil {
 		logrus.Fatal(err)
 	}
@@ -136,7 +184,15 @@ func runRelease(options *anago.ReleaseOptions) error {
 	options.NoMock = rootOpts.nomock
 	rel := anago.NewRelease(options)
 
+	if listSteps {
+		return printSteps(rel.Steps())
+	}
+
 	if submitJob {
+		if err := checkLocalOnlyOptions(options.Options); err != nil {
+			return err
+		}
+
 		// Perform a local check of the specified options
 		// before launching a Cloud Build job:
 		if err := options.Validate(&anago.State{}); err != nil {
@@ -138,6 +138,7 @@ type releaseNotesOptions struct {
 	githubOrg          string
 	draftRepo          string
 	mapProviders       []string
+	enhancementsDir    string
 }
 
 type releaseNotesResult struct {
@@ -194,7 +195,14 @@ func init() {
 		"maps-from",
 		"m",
 		[]string{},
-		"specify a location to recursively look for release notes *.y[a]ml file mappings",
+		"specify a location to recursively look for release notes *.y[a]ml file mappings, either a local directory, a 'gs://' bucket path, a 'https://' URL to a single file or a 'git+<url>@<ref>//<path>' repository path",
+	)
+
+	releaseNotesCmd.PersistentFlags().StringVar(
+		&releaseNotesOpts.enhancementsDir,
+		"enhancements-dir",
+		"",
+		"the local path to a kubernetes/enhancements checkout to enrich KEP links and add a Graduations section to the draft",
 	)
 
 	releaseNotesCmd.PersistentFlags().BoolVar(
@@ -908,6 +916,7 @@ func releaseNotesJSON(repoPath, tag string) (jsonString string, err error) {
 	notesOptions.EndRev = tag
 	notesOptions.Debug = logrus.StandardLogger().Level >= logrus.DebugLevel
 	notesOptions.MapProviderStrings = releaseNotesOpts.mapProviders
+	notesOptions.EnhancementsDir = releaseNotesOpts.enhancementsDir
 	notesOptions.AddMarkdownLinks = true
 
 	// If the release for the tag we are using has a mapping directory,
@@ -963,6 +972,7 @@ func gatherNotesFrom(repoPath, startTag string) (*notes.ReleaseNotes, error) {
 	notesOptions.Debug = logrus.StandardLogger().Level >= logrus.DebugLevel
 	notesOptions.MapProviderStrings = releaseNotesOpts.mapProviders
 	notesOptions.ListReleaseNotesV2 = releaseNotesOpts.listReleaseNotesV2
+	notesOptions.EnhancementsDir = releaseNotesOpts.enhancementsDir
 	notesOptions.AddMarkdownLinks = true
 
 	if err := notesOptions.ValidateAndFinish(); err != nil {
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/notes/site"
+)
+
+type releaseNotesSiteOptions struct {
+	notesDir  string
+	outputDir string
+	title     string
+}
+
+var releaseNotesSiteOpts = &releaseNotesSiteOptions{}
+
+func init() {
+	siteCmd.PersistentFlags().StringVar(
+		&releaseNotesSiteOpts.notesDir,
+		"notes-dir",
+		"",
+		"The directory containing the JSON release notes of all versions, named by their version like v1.30.1.json, as generated by `release-notes --format=json`.",
+	)
+
+	siteCmd.PersistentFlags().StringVar(
+		&releaseNotesSiteOpts.outputDir,
+		"output",
+		"",
+		"The directory where the static site will be written",
+	)
+
+	siteCmd.PersistentFlags().StringVar(
+		&releaseNotesSiteOpts.title,
+		"title",
+		site.DefaultTitle,
+		"The title of the site",
+	)
+
+	releaseNotesCmd.AddCommand(siteCmd)
+}
+
+// siteCmd represents the subcommand for `krel release-notes site`.
+var siteCmd = &cobra.Command{
+	Use:   "site",
+	Short: "Generate a static release notes site from the JSON notes of all versions",
+	Long: `krel release-notes site --notes-dir <dir> --output <dir>
+
+The 'site' subcommand of krel generates a static HTML site from the JSON
+release notes of multiple versions. The site contains:
+
+- an index page linking all releases, starting with the latest version
+- a page per release, which highlights the action required notes and allows
+  filtering the notes by SIG, kind and area
+- a prebuilt search index used by the client side search of all notes
+
+The output directory can be published from any static web server.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PreRunE: func(*cobra.Command, []string) error {
+		return releaseNotesSiteOpts.Validate()
+	},
+	RunE: func(*cobra.Command, []string) error {
+		return runReleaseNotesSite(releaseNotesSiteOpts)
+	},
+}
+
+// Validate checks the options.
+func (o *releaseNotesSiteOptions) Validate() error {
+	if o.notesDir == "" {
+		return errors.New("--notes-dir must be provided")
+	}
+
+	if o.outputDir == "" {
+		return errors.New("--output must be provided")
+	}
+
+	return nil
+}
+
+func runReleaseNotesSite(opts *releaseNotesSiteOptions) error {
+	if _, err := os.Stat(opts.notesDir); err != nil {
+		return fmt.Errorf("checking release notes directory: %w", err)
+	}
+
+	siteOpts := site.DefaultOptions()
+	siteOpts.NotesDir = opts.notesDir
+	siteOpts.OutputDir = opts.outputDir
+	siteOpts.Title = opts.title
+
+	if err := site.New(siteOpts).Generate(); err != nil {
+		return fmt.Errorf("generating release notes site: %w", err)
+	}
+
+	return nil
+}
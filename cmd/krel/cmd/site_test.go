@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunReleaseNotesSite(t *testing.T) {
+	opts := &releaseNotesSiteOptions{
+		notesDir:  "../../../pkg/notes/site/testdata",
+		outputDir: t.TempDir(),
+		title:     "Release Notes",
+	}
+	require.NoError(t, opts.Validate())
+	require.NoError(t, runReleaseNotesSite(opts))
+	require.FileExists(t, filepath.Join(opts.outputDir, "index.html"))
+
+	opts.notesDir = filepath.Join(t.TempDir(), "missing")
+	require.Error(t, runReleaseNotesSite(opts))
+
+	require.Error(t, (&releaseNotesSiteOptions{outputDir: "output"}).Validate())
+	require.Error(t, (&releaseNotesSiteOptions{notesDir: "notes"}).Validate())
+}
@@ -18,7 +18,9 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -70,12 +72,19 @@ var (
 	stageOptions = anago.DefaultStageOptions()
 	submitJob    = true
 	stream       = false
+	listSteps    = false
 )
 
 const (
-	buildVersionFlag = "build-version"
-	submitJobFlag    = "submit"
-	streamFlag       = "stream"
+	buildVersionFlag    = "build-version"
+	submitJobFlag       = "submit"
+	streamFlag          = "stream"
+	resumeFlag          = "resume"
+	onlyStepsFlag       = "only-steps"
+	skipStepsFlag       = "skip-steps"
+	listStepsFlag       = "list-steps"
+	bucketFlag          = "bucket"
+	maxImageWorkersFlag = "max-image-workers"
 )
 
 func init() {
@@ -125,6 +134,54 @@ func init() {
 			"Run the Google Cloud Build job synchronously",
 		)
 
+	stageCmd.PersistentFlags().
+		BoolVar(
+			&stageOptions.Resume,
+			resumeFlag,
+			false,
+			"Resume a previously interrupted local run from its checkpoint and skip all completed steps",
+		)
+
+	stageCmd.PersistentFlags().
+		StringSliceVar(
+			&stageOptions.OnlySteps,
+			onlyStepsFlag,
+			nil,
+			"Comma separated list of steps to run exclusively",
+		)
+
+	stageCmd.PersistentFlags().
+		StringSliceVar(
+			&stageOptions.SkipSteps,
+			skipStepsFlag,
+			nil,
+			"Comma separated list of steps to skip",
+		)
+
+	stageCmd.PersistentFlags().
+		StringVar(
+			&stageOptions.CustomBucket,
+			bucketFlag,
+			"",
+			"Custom bucket for mock runs, for example a local directory like 'file:///tmp/bucket' or an S3 bucket like 's3://bucket'",
+		)
+
+	stageCmd.PersistentFlags().
+		IntVar(
+			&stageOptions.MaxImageWorkers,
+			maxImageWorkersFlag,
+			stageOptions.MaxImageWorkers,
+			"The maximum amount of container images to be pushed or validated in parallel",
+		)
+
+	stageCmd.PersistentFlags().
+		BoolVar(
+			&listSteps,
+			listStepsFlag,
+			false,
+			"List all available steps and exit",
+		)
+
 	for _, flag := range []string{buildVersionFlag, submitJobFlag} {
 		if err := stageCmd.PersistentFlags().MarkHidden(flag); err != nil {
 			logrus.Fatal(err)
@@ -138,7 +195,15 @@ func runStage(options *anago.StageOptions) error {
 	options.NoMock = rootOpts.nomock
 	stage := anago.NewStage(options)
 
+	if listSteps {
+		return printSteps(stage.Steps())
+	}
+
 	if submitJob {
+		if err := checkLocalOnlyOptions(options.Options); err != nil {
+			return err
+		}
+
 		// Perform a local check of the specified options before launching a
 		// Cloud Build job:
 		if err := options.Validate(&anago.State{}); err != nil {
@@ -150,3 +215,38 @@ func runStage(options *anago.StageOptions) error {
 
 	return stage.Run()
 }
+
+// checkLocalOnlyOptions verifies that no options are set which are not
+// supported when submitting a Google Cloud Build job.
+func checkLocalOnlyOptions(options *anago.Options) error {
+	if options.Resume ||
+		len(options.OnlySteps) > 0 ||
+		len(options.SkipSteps) > 0 ||
+		options.CustomBucket != "" ||
+		options.MaxImageWorkers != release.DefaultMaxImageWorkers {
+		return fmt.Errorf(
+			"--%s, --%s, --%s, --%s and --%s are only supported together with --%s=false",
+			resumeFlag, onlyStepsFlag, skipStepsFlag, bucketFlag, maxImageWorkersFlag, submitJobFlag,
+		)
+	}
+
+	return nil
+}
+
+// printSteps writes the provided steps including their dependencies to
+// stdout.
+func printSteps(steps *anago.Steps) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tDEPENDS ON\tDESCRIPTION")
+
+	for _, step := range steps.List() {
+		deps := "-"
+		if len(step.DependsOn) > 0 {
+			deps = strings.Join(step.DependsOn, ",")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", step.Name, deps, step.Description)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/options"
+)
+
+type diffOptions struct {
+	format     string
+	outputFile string
+}
+
+var diffOpts = &diffOptions{}
+
+func (o *diffOptions) ValidateAndFinish() error {
+	if o.format != options.FormatMarkdown && o.format != options.FormatJSON {
+		return fmt.Errorf(
+			"invalid format %q, must be %s or %s",
+			o.format, options.FormatMarkdown, options.FormatJSON,
+		)
+	}
+
+	return nil
+}
+
+func addDiff(parent *cobra.Command) {
+	diffCmd := &cobra.Command{
+		Short: "Compare two release notes JSON documents",
+		Long: `release-notes diff compares two release notes documents generated with
+--format=json, for example between two release candidates or between a draft
+and the final release notes.
+
+It reports the added, removed and modified notes, where a note is considered
+modified if its content hash differs.`,
+		Use:           "diff OLD.json NEW.json",
+		Args:          cobra.ExactArgs(2),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE: func(*cobra.Command, []string) error {
+			return diffOpts.ValidateAndFinish()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output := cmd.OutOrStdout()
+
+			if diffOpts.outputFile != "" {
+				f, err := os.Create(diffOpts.outputFile)
+				if err != nil {
+					return fmt.Errorf("creating output file: %w", err)
+				}
+				defer f.Close()
+
+				output = f
+			}
+
+			return runDiff(output, args[0], args[1], diffOpts.format)
+		},
+	}
+
+	diffCmd.PersistentFlags().StringVar(
+		&diffOpts.format,
+		"format",
+		options.FormatMarkdown,
+		fmt.Sprintf("The format of the diff output, options: %s, %s", options.FormatMarkdown, options.FormatJSON),
+	)
+
+	diffCmd.PersistentFlags().StringVar(
+		&diffOpts.outputFile,
+		"output",
+		"",
+		"The path where the diff will be written, defaults to stdout",
+	)
+
+	parent.AddCommand(diffCmd)
+}
+
+// runDiff compares the release notes files and writes the diff in the
+// provided format.
+func runDiff(output io.Writer, oldFile, newFile, format string) error {
+	oldNotes, err := readReleaseNotesJSON(oldFile)
+	if err != nil {
+		return err
+	}
+
+	newNotes, err := readReleaseNotesJSON(newFile)
+	if err != nil {
+		return err
+	}
+
+	diff, err := notes.DiffReleaseNotes(oldNotes, newNotes)
+	if err != nil {
+		return fmt.Errorf("comparing release notes: %w", err)
+	}
+
+	if format == options.FormatJSON {
+		encoder := json.NewEncoder(output)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(diff); err != nil {
+			return fmt.Errorf("encoding diff: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, err := io.WriteString(output, diff.Markdown()); err != nil {
+		return fmt.Errorf("writing diff: %w", err)
+	}
+
+	return nil
+}
+
+// readReleaseNotesJSON reads a release notes document generated with
+// `--format=json`.
+func readReleaseNotesJSON(path string) (notes.ReleaseNotesByPR, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading release notes: %w", err)
+	}
+
+	doc, err := notes.DecodeJSONDocument(content)
+	if err != nil {
+		return nil, fmt.Errorf("reading release notes %s: %w", path, err)
+	}
+
+	return doc.ReleaseNotes, nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/options"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldFile := filepath.Join(dir, "old.json")
+	newFile := filepath.Join(dir, "new.json")
+
+	require.NoError(t, os.WriteFile(oldFile, []byte(
+		`{"1": {"pr_number": 1, "text": "Old", "markdown": "Old (#1, @user)"}}`,
+	), os.FileMode(0o644)))
+	require.NoError(t, os.WriteFile(newFile, []byte(
+		`{"1": {"pr_number": 1, "text": "New", "markdown": "New (#1, @user)"},
+		  "2": {"pr_number": 2, "text": "Added", "markdown": "Added (#2, @user)"}}`,
+	), os.FileMode(0o644)))
+
+	// JSON output
+	output := &bytes.Buffer{}
+	require.NoError(t, runDiff(output, oldFile, newFile, options.FormatJSON))
+
+	diff := &notes.NotesDiff{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), diff))
+	require.Len(t, diff.Added, 1)
+	require.Empty(t, diff.Removed)
+	require.Len(t, diff.Modified, 1)
+	require.Equal(t, []string{"text"}, diff.Modified[0].Fields)
+
+	// Markdown output
+	output.Reset()
+	require.NoError(t, runDiff(output, oldFile, newFile, options.FormatMarkdown))
+	require.Contains(t, output.String(), "## Added (1)\n\n- Added (#2, @user)")
+
+	// Invalid input
+	require.Error(t, runDiff(output, oldFile, filepath.Join(dir, "missing.json"), options.FormatJSON))
+	require.Error(t, (&diffOptions{format: options.FormatRST}).ValidateAndFinish())
+}
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/release-utils/env"
 
 	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/forge"
 	"k8s.io/release/pkg/notes/options"
 	"k8s.io/release/pkg/release"
 )
@@ -39,6 +40,25 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"Base URL of github",
 	)
 
+	// forge contains the forge hosting the repository.
+	subcommand.PersistentFlags().StringVar(
+		&opts.Forge,
+		"forge",
+		env.Default("FORGE", forge.GitHub),
+		fmt.Sprintf("The forge hosting the repository, options: %s", strings.Join(forge.Names(), ", ")),
+	)
+
+	// forgeURL contains the base URL of the forge.
+	subcommand.PersistentFlags().StringVar(
+		&opts.ForgeURL,
+		"forge-url",
+		env.Default("FORGE_URL", ""),
+		fmt.Sprintf(
+			"Base URL of the forge if not GitHub (defaults to %s or %s)",
+			forge.DefaultGitLabURL, forge.DefaultGiteaURL,
+		),
+	)
+
 	// githubUploadURL contains the github upload URL.
 	subcommand.PersistentFlags().StringVar(
 		&opts.GithubUploadURL,
@@ -141,7 +161,7 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"format",
 		env.Default("FORMAT", options.FormatMarkdown),
 		fmt.Sprintf("The format for notes output (options: %s)",
-			options.FormatJSON+", "+options.FormatMarkdown,
+			strings.Join(options.Formats(), ", "),
 		),
 	)
 
@@ -229,6 +249,36 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"Replay a previously recorded API from a directory",
 	)
 
+	subcommand.PersistentFlags().StringVar(
+		&opts.CacheDir,
+		"cache-dir",
+		env.Default("CACHE_DIR", ""),
+		"Cache the release notes per commit in a directory to only query GitHub for new or updated pull requests on subsequent runs",
+	)
+
+	subcommand.PersistentFlags().StringVar(
+		&opts.EnhancementsDir,
+		"enhancements-dir",
+		env.Default("ENHANCEMENTS_DIR", ""),
+		"Path to a local checkout of kubernetes/enhancements to enrich KEP links with the KEP number, title, stage and feature gates",
+	)
+
+	subcommand.PersistentFlags().StringVar(
+		&opts.PreviousReleaseNotesDir,
+		"previous-notes-dir",
+		"",
+		"Path to a directory with the JSON release notes of previous patch releases, named by their version like v1.30.1.json. Only releases of the same minor version lower than the --end-rev tag are considered. Notes whose origin PR got already published in one of them are handled according to --previously-released",
+	)
+
+	subcommand.PersistentFlags().StringVar(
+		&opts.PreviouslyReleased,
+		"previously-released",
+		options.PreviouslyReleasedMark,
+		fmt.Sprintf("How to handle notes which got already published in a previous release, can be one of: %s",
+			strings.Join(options.PreviouslyReleasedActions(), ", "),
+		),
+	)
+
 	subcommand.PersistentFlags().BoolVar(
 		&releaseNotesOpts.dependencies,
 		"dependencies",
@@ -236,12 +286,33 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		"Add dependency report",
 	)
 
+	subcommand.PersistentFlags().StringVar(
+		&releaseNotesOpts.dependenciesOutput,
+		"dependencies-output",
+		"",
+		"The path where the structured dependency report including license changes will be written as JSON. Requires a local repository in --repo-path",
+	)
+
+	subcommand.PersistentFlags().BoolVar(
+		&releaseNotesOpts.featureGates,
+		"feature-gates",
+		false,
+		"Add a report of the feature gates added, removed, promoted or flipped by default between the start and end SHA. Requires a local repository in --repo-path. With --format=json, the output contains the release notes and the report as separate fields",
+	)
+
+	subcommand.PersistentFlags().StringVar(
+		&releaseNotesOpts.featureGatesOutput,
+		"feature-gates-output",
+		"",
+		"The path where the feature gate report will be written as JSON, which implies --feature-gates",
+	)
+
 	subcommand.PersistentFlags().StringSliceVarP(
 		&opts.MapProviderStrings,
 		"maps-from",
 		"m",
 		[]string{},
-		"specify a location to recursively look for release notes *.y[a]ml file mappings",
+		"specify a location to recursively look for release notes *.y[a]ml file mappings, either a local directory, a 'gs://' bucket path, a 'https://' URL to a single file or a 'git+<url>@<ref>//<path>' repository path",
 	)
 	subcommand.PersistentFlags().BoolVar(
 		&opts.ListReleaseNotesV2,
@@ -249,6 +320,16 @@ func addGenerateFlags(subcommand *cobra.Command) {
 		false,
 		"enable experimental implementation to list commits (ListReleaseNotesV2)",
 	)
+
+	subcommand.PersistentFlags().StringVar(
+		&opts.NoteSource,
+		"note-source",
+		env.Default("NOTE_SOURCE", options.NoteSourcePullRequest),
+		fmt.Sprintf(
+			"The source of the release notes, options: %s. Conventional commits are read from the local repository in --repo-path",
+			strings.Join(options.NoteSources(), ", "),
+		),
+	)
 }
 
 // addGenerate adds the generate subcomand to the main release notes cobra cmd.
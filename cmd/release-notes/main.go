@@ -37,9 +37,12 @@ import (
 )
 
 type releaseNotesOptions struct {
-	outputFile      string
-	tableOfContents bool
-	dependencies    bool
+	outputFile         string
+	tableOfContents    bool
+	dependencies       bool
+	featureGates       bool
+	featureGatesOutput string
+	dependenciesOutput string
 }
 
 var (
@@ -50,13 +53,13 @@ var (
 func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 	logrus.Infof(
 		"Got %d release notes, performing rendering",
-		len(releaseNotes.History()),
+		len(releaseNotes.All()),
 	)
 
 	var (
 		// Open a handle to the file which will contain the release notes output
-		output        *os.File
-		existingNotes notes.ReleaseNotesByPR
+		output   *os.File
+		existing *notes.JSONDocument
 	)
 
 	if releaseNotesOpts.outputFile != "" {
@@ -71,6 +74,16 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 		}
 	}
 
+	featureGates, err := featureGateReport()
+	if err != nil {
+		return err
+	}
+
+	dependencies, err := dependencyReport()
+	if err != nil {
+		return err
+	}
+
 	// Contextualized release notes can be printed in a variety of formats
 	if opts.Format == options.FormatJSON {
 		byteValue, err := io.ReadAll(output)
@@ -79,12 +92,13 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 		}
 
 		if len(byteValue) > 0 {
-			if err := json.Unmarshal(byteValue, &existingNotes); err != nil {
+			existing, err = notes.DecodeJSONDocument(byteValue)
+			if err != nil {
 				return fmt.Errorf("unmarshalling existing notes: %w", err)
 			}
 		}
 
-		if len(existingNotes) > 0 {
+		if existing != nil && len(existing.ReleaseNotes)+len(existing.ReleaseNotesByCommit) > 0 {
 			if err := output.Truncate(0); err != nil {
 				return err
 			}
@@ -93,19 +107,26 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 				return err
 			}
 
-			for _, existingNote := range existingNotes {
+			for _, existingNote := range existing.ReleaseNotes {
 				pr := existingNote.PrNumber
 				if releaseNotes.Get(pr) == nil {
 					releaseNotes.Set(pr, existingNote)
 				}
 			}
-		}
 
-		enc := json.NewEncoder(output)
-		enc.SetIndent("", "  ")
+			for sha, existingNote := range existing.ReleaseNotesByCommit {
+				if _, ok := releaseNotes.ByCommit()[sha]; !ok {
+					releaseNotes.SetCommit(sha, existingNote)
+				}
+			}
+		}
 
-		if err := enc.Encode(releaseNotes.ByPR()); err != nil {
-			return fmt.Errorf("encoding JSON output: %w", err)
+		if err := notes.EncodeJSONDocument(output, &notes.JSONDocument{
+			ReleaseNotes:         releaseNotes.ByPR(),
+			ReleaseNotesByCommit: releaseNotes.ByCommit(),
+			FeatureGates:         featureGates,
+		}); err != nil {
+			return err
 		}
 	} else {
 		doc, err := document.New(releaseNotes, opts.StartRev, opts.EndRev)
@@ -113,6 +134,30 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 			return fmt.Errorf("creating release note document: %w", err)
 		}
 
+		doc.FeatureGates = featureGates
+
+		if opts.Format != options.FormatMarkdown {
+			rendered, err := doc.RenderFormat(opts.Format, opts.ReleaseBucket, opts.ReleaseTars, "")
+			if err != nil {
+				return fmt.Errorf("rendering release note document as %s: %w", opts.Format, err)
+			}
+
+			if releaseNotesOpts.dependencies || releaseNotesOpts.tableOfContents {
+				logrus.Warnf(
+					"Skipping dependency report and table of contents, which are only supported for %s format",
+					options.FormatMarkdown,
+				)
+			}
+
+			if _, err := output.WriteString(rendered); err != nil {
+				return fmt.Errorf("writing output file: %w", err)
+			}
+
+			logrus.Infof("Release notes written to file: %s", output.Name())
+
+			return nil
+		}
+
 		markdown, err := doc.RenderMarkdownTemplate(opts.ReleaseBucket, opts.ReleaseTars, "", opts.GoTemplate)
 		if err != nil {
 			return fmt.Errorf("rendering release note document with template: %w", err)
@@ -134,6 +179,10 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 				}
 
 				markdown += strings.Repeat(nl, 2) + deps
+
+				if licenseChanges := dependencies.LicenseChangesMarkdown(); licenseChanges != "" {
+					markdown = strings.TrimRight(markdown, nl) + strings.Repeat(nl, 2) + licenseChanges
+				}
 			}
 		}
 
@@ -160,6 +209,83 @@ func WriteReleaseNotes(releaseNotes *notes.ReleaseNotes) (err error) {
 	return nil
 }
 
+// featureGateReport generates the feature gate change report if enabled and
+// writes it as JSON to the feature gates output file, if provided.
+func featureGateReport() (*notes.FeatureGateReport, error) {
+	if !releaseNotesOpts.featureGates && releaseNotesOpts.featureGatesOutput == "" {
+		return nil, nil
+	}
+
+	if opts.StartSHA == opts.EndSHA {
+		logrus.Info("Skipping feature gate report because start and end SHA are the same")
+
+		return nil, nil
+	}
+
+	report, err := notes.NewFeatureGates().Changes(opts.RepoPath, opts.StartSHA, opts.EndSHA)
+	if err != nil {
+		return nil, fmt.Errorf("generating feature gate report: %w", err)
+	}
+
+	logrus.Infof("Found %d feature gate changes", len(report.Changes))
+
+	if releaseNotesOpts.featureGatesOutput != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshalling feature gate report: %w", err)
+		}
+
+		if err := os.WriteFile(releaseNotesOpts.featureGatesOutput, data, os.FileMode(0o644)); err != nil {
+			return nil, fmt.Errorf("writing feature gate report: %w", err)
+		}
+
+		logrus.Infof("Feature gate report written to file: %s", releaseNotesOpts.featureGatesOutput)
+	}
+
+	return report, nil
+}
+
+// dependencyReport generates the structured dependency report if the
+// dependencies get rendered as markdown and writes it as JSON to the
+// dependencies output file, if provided.
+func dependencyReport() (*notes.DependencyReport, error) {
+	if releaseNotesOpts.dependenciesOutput == "" &&
+		(!releaseNotesOpts.dependencies || opts.Format != options.FormatMarkdown) {
+		return nil, nil
+	}
+
+	if opts.StartSHA == opts.EndSHA {
+		logrus.Info("Skipping structured dependency report because start and end SHA are the same")
+
+		return nil, nil
+	}
+
+	report, err := notes.NewDependencies().Report(opts.RepoPath, opts.StartSHA, opts.EndSHA)
+	if err != nil {
+		return nil, fmt.Errorf("generating structured dependency report: %w", err)
+	}
+
+	logrus.Infof(
+		"Found %d added, %d removed and %d updated dependencies with %d license changes",
+		len(report.Added), len(report.Removed), len(report.Updated), len(report.LicenseChanges()),
+	)
+
+	if releaseNotesOpts.dependenciesOutput != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshalling dependency report: %w", err)
+		}
+
+		if err := os.WriteFile(releaseNotesOpts.dependenciesOutput, data, os.FileMode(0o644)); err != nil {
+			return nil, fmt.Errorf("writing dependency report: %w", err)
+		}
+
+		logrus.Infof("Dependency report written to file: %s", releaseNotesOpts.dependenciesOutput)
+	}
+
+	return report, nil
+}
+
 // hackDefaultSubcommand is a utility function that hacks the "generate"
 // subcommand as default to avoid breaking compatibility with previoud
 // versions of release-notes.
@@ -171,7 +297,7 @@ func hackDefaultSubcommand(cmd *cobra.Command) {
 
 		// Check if the first arg corresponds to a registered subcommand
 		for _, command := range cmd.Commands() {
-			if command.Use == os.Args[1] {
+			if command.Name() == os.Args[1] {
 				return
 			}
 		}
@@ -195,6 +321,7 @@ func main() {
 
 	addGenerate(cmd)
 	addCheckPR(cmd)
+	addDiff(cmd)
 
 	cmd.AddCommand(version.WithFont("slant"))
 
@@ -25,9 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/release-sdk/git"
-	"sigs.k8s.io/release-utils/log"
 	"sigs.k8s.io/release-utils/util"
-	"sigs.k8s.io/release-utils/version"
 
 	"k8s.io/release/pkg/announce"
 	"k8s.io/release/pkg/release"
@@ -55,6 +53,12 @@ const (
 	// announcementHTMLFile is the file containing the release announcement in HTML format.
 	announcementHTMLFile = workspaceDir + "/src/" + announce.AnnouncementFile
 
+	// stageReportFile is the file containing the machine-readable stage report.
+	stageReportFile = workspaceDir + "/" + release.StageReportFilename
+
+	// releaseReportFile is the file containing the machine-readable release report.
+	releaseReportFile = workspaceDir + "/" + release.ReleaseReportFilename
+
 	// The default license for all artifacts.
 	LicenseIdentifier = "Apache-2.0"
 )
@@ -78,21 +82,40 @@ type Options struct {
 	// The build version to be released. Has to be specified in the format:
 	// `vX.Y.Z-[alpha|beta|rc].N.C+SHA`
 	BuildVersion string
+
+	// Resume a previously interrupted run by restoring the state from its
+	// checkpoint file and skipping all completed steps.
+	Resume bool
+
+	// OnlySteps restricts the run to the steps with the provided names.
+	OnlySteps []string
+
+	// SkipSteps excludes the steps with the provided names from the run.
+	SkipSteps []string
+
+	// CustomBucket overrides the bucket of mock runs. It can be a `file://`
+	// path to stage and release into a directory on the local file system.
+	CustomBucket string
+
+	// MaxImageWorkers is the maximum amount of container images which get
+	// pushed or validated in parallel.
+	MaxImageWorkers int
 }
 
 // DefaultOptions returns a new Options instance.
 func DefaultOptions() *Options {
 	return &Options{
-		ReleaseType:   release.ReleaseTypeAlpha,
-		ReleaseBranch: git.DefaultBranch,
+		ReleaseType:     release.ReleaseTypeAlpha,
+		ReleaseBranch:   git.DefaultBranch,
+		MaxImageWorkers: release.DefaultMaxImageWorkers,
 	}
 }
 
 // String returns a string representation for the `ReleaseOptions` type.
 func (o *Options) String() string {
 	return fmt.Sprintf(
-		"NoMock: %v, ReleaseType: %q, BuildVersion: %q, ReleaseBranch: %q",
-		o.NoMock, o.ReleaseType, o.BuildVersion, o.ReleaseBranch,
+		"NoMock: %v, ReleaseType: %q, BuildVersion: %q, ReleaseBranch: %q, Resume: %v, CustomBucket: %q",
+		o.NoMock, o.ReleaseType, o.BuildVersion, o.ReleaseBranch, o.Resume, o.CustomBucket,
 	)
 }
 
@@ -111,6 +134,10 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("invalid release branch: %s", o.ReleaseBranch)
 	}
 
+	if o.NoMock && o.CustomBucket != "" {
+		return errors.New("a custom bucket is only supported for mock runs")
+	}
+
 	return nil
 }
 
@@ -135,12 +162,17 @@ func (o *Options) ValidateBuildVersion(state *State) error {
 	return nil
 }
 
-// Bucket returns the Google Cloud Bucket for these `Options`.
+// Bucket returns the Google Cloud Bucket for these `Options`, or the custom
+// bucket of mock runs if set.
 func (o *Options) Bucket() string {
 	if o.NoMock {
 		return release.ProductionBucket
 	}
 
+	if o.CustomBucket != "" {
+		return o.CustomBucket
+	}
+
 	return release.TestBucket
 }
 
@@ -171,6 +203,16 @@ type State struct {
 
 	// startTime is the time when stage/release starts
 	startTime time.Time
+
+	// completedSteps are the names of the steps which finished successfully.
+	completedSteps []string
+
+	// resumed indicates that the state has been restored from a checkpoint.
+	resumed bool
+
+	// imageDigests are the digests of the pushed container images per
+	// release version.
+	imageDigests map[string]map[string]string
 }
 
 // DefaultState returns a new empty State.
@@ -190,6 +232,16 @@ func (s *State) SetVersions(versions *release.Versions) {
 	s.versions = versions
 }
 
+// setImageDigests records the digests of the pushed container images of a
+// release version.
+func (s *State) setImageDigests(version string, digests map[string]string) {
+	if s.imageDigests == nil {
+		s.imageDigests = map[string]map[string]string{}
+	}
+
+	s.imageDigests[version] = digests
+}
+
 // StageState holds the release process state.
 type StageState struct {
 	*State
@@ -238,12 +290,17 @@ func (s *StageOptions) Validate(state *State) error {
 
 // Stage is the structure to be used for staging releases.
 type Stage struct {
-	client stageClient
+	client  stageClient
+	options *StageOptions
+	steps   *Steps
 }
 
 // NewStage creates a new `Stage` instance.
 func NewStage(options *StageOptions) *Stage {
-	return &Stage{NewDefaultStage(options)}
+	s := &Stage{client: NewDefaultStage(options), options: options}
+	s.steps = s.defaultSteps()
+
+	return s
 }
 
 // SetClient can be used to set the internal stage client.
@@ -262,6 +319,89 @@ func (s *Stage) Submit(stream bool) error {
 	return nil
 }
 
+// Steps returns the registry of steps executed by `Run`. It can be used to
+// insert custom steps into the stage process.
+func (s *Stage) Steps() *Steps {
+	return s.steps
+}
+
+// defaultSteps returns the default stage steps.
+func (s *Stage) defaultSteps() *Steps {
+	return &Steps{steps: []*Step{
+		{
+			Name:        StepValidateOptions,
+			Description: "Validating options",
+			Idempotent:  true,
+			Run:         func() error { return s.client.ValidateOptions() },
+		},
+		{
+			Name:        StepCheckPrerequisites,
+			Description: "Checking prerequisites",
+			Idempotent:  true,
+			Run:         func() error { return s.client.CheckPrerequisites() },
+		},
+		{
+			Name:        StepCheckReleaseBranchState,
+			Description: "Checking release branch state",
+			DependsOn:   []string{StepValidateOptions},
+			Run:         func() error { return s.client.CheckReleaseBranchState() },
+		},
+		{
+			Name:        StepGenerateReleaseVersion,
+			Description: "Generating release version",
+			DependsOn:   []string{StepCheckReleaseBranchState},
+			Run:         func() error { return s.client.GenerateReleaseVersion() },
+		},
+		{
+			Name:        StepPrepareWorkspace,
+			Description: "Preparing workspace",
+			Idempotent:  true,
+			DependsOn:   []string{StepValidateOptions},
+			Run:         func() error { return s.client.PrepareWorkspace() },
+		},
+		{
+			Name:        StepTagRepository,
+			Description: "Tagging repository",
+			DependsOn:   []string{StepGenerateReleaseVersion, StepPrepareWorkspace},
+			Run:         func() error { return s.client.TagRepository() },
+		},
+		{
+			Name:        StepBuild,
+			Description: "Building release",
+			DependsOn:   []string{StepTagRepository},
+			Run:         func() error { return s.client.Build() },
+		},
+		{
+			Name:        StepGenerateChangelog,
+			Description: "Generating changelog",
+			DependsOn:   []string{StepBuild},
+			Run:         func() error { return s.client.GenerateChangelog() },
+		},
+		{
+			Name:        StepVerifyArtifacts,
+			Description: "Verifying artifacts",
+			DependsOn:   []string{StepBuild},
+			Run:         func() error { return s.client.VerifyArtifacts() },
+		},
+		{
+			Name:        StepGenerateBillOfMaterials,
+			Description: "Generating bill of materials",
+			DependsOn:   []string{StepBuild},
+			Run:         func() error { return s.client.GenerateBillOfMaterials() },
+		},
+		{
+			Name:        StepStageArtifacts,
+			Description: "Staging artifacts",
+			DependsOn: []string{
+				StepGenerateChangelog,
+				StepVerifyArtifacts,
+				StepGenerateBillOfMaterials,
+			},
+			Run: func() error { return s.client.StageArtifacts() },
+		},
+	}}
+}
+
 // Run for the `Stage` struct prepares a release and puts the results on a
 // staging bucket.
 func (s *Stage) Run() error {
@@ -271,77 +411,30 @@ func (s *Stage) Run() error {
 		return fmt.Errorf("init log file: %w", err)
 	}
 
-	logger := log.NewStepLogger(12)
-	v := version.GetVersionInfo()
-	logger.Infof("Using krel version: %s", v.GitVersion)
-
-	logger.WithStep().Info("Validating options")
-
-	if err := s.client.ValidateOptions(); err != nil {
-		return fmt.Errorf("validate options: %w", err)
-	}
-
-	logger.WithStep().Info("Checking prerequisites")
-
-	if err := s.client.CheckPrerequisites(); err != nil {
-		return fmt.Errorf("check prerequisites: %w", err)
-	}
-
-	logger.WithStep().Info("Checking release branch state")
-
-	if err := s.client.CheckReleaseBranchState(); err != nil {
-		return fmt.Errorf("check release branch state: %w", err)
-	}
-
-	logger.WithStep().Info("Generating release version")
-
-	if err := s.client.GenerateReleaseVersion(); err != nil {
-		return fmt.Errorf("generate release version: %w", err)
+	if err := s.client.InitCheckpoint(); err != nil {
+		return fmt.Errorf("init checkpoint: %w", err)
 	}
 
-	logger.WithStep().Info("Preparing workspace")
+	report := release.NewRunReport(release.RunTypeStage)
 
-	if err := s.client.PrepareWorkspace(); err != nil {
-		return fmt.Errorf("prepare workspace: %w", err)
-	}
-
-	logger.WithStep().Info("Tagging repository")
-
-	if err := s.client.TagRepository(); err != nil {
-		return fmt.Errorf("tag repository: %w", err)
-	}
-
-	logger.WithStep().Info("Building release")
-
-	if err := s.client.Build(); err != nil {
-		return fmt.Errorf("build release: %w", err)
-	}
-
-	logger.WithStep().Info("Generating changelog")
-
-	if err := s.client.GenerateChangelog(); err != nil {
-		return fmt.Errorf("generate changelog: %w", err)
-	}
-
-	logger.WithStep().Info("Verifying artifacts")
-
-	if err := s.client.VerifyArtifacts(); err != nil {
-		return fmt.Errorf("verifying artifacts: %w", err)
+	runErr := s.steps.run(
+		s.client, report, s.options.OnlySteps, s.options.SkipSteps,
+	)
+	if runErr != nil {
+		runErr = fmt.Errorf("run stage steps: %w", runErr)
 	}
 
-	logger.WithStep().Info("Generating bill of materials")
+	report.Finish(runErr)
 
-	if err := s.client.GenerateBillOfMaterials(); err != nil {
-		return fmt.Errorf("generating sbom: %w", err)
+	if err := s.client.WriteReport(report); err != nil {
+		logrus.Warnf("Unable to write stage report: %v", err)
 	}
 
-	logger.WithStep().Info("Staging artifacts")
-
-	if err := s.client.StageArtifacts(); err != nil {
-		return fmt.Errorf("stage release artifacts: %w", err)
+	if runErr != nil {
+		return runErr
 	}
 
-	logger.Info("Stage done")
+	logrus.Info("Stage done")
 
 	return nil
 }
@@ -390,12 +483,17 @@ func (r *ReleaseOptions) Validate(state *State) error {
 
 // Release is the structure to be used for releasing staged releases.
 type Release struct {
-	client releaseClient
+	client  releaseClient
+	options *ReleaseOptions
+	steps   *Steps
 }
 
 // NewRelease creates a new `Release` instance.
 func NewRelease(options *ReleaseOptions) *Release {
-	return &Release{NewDefaultRelease(options)}
+	r := &Release{client: NewDefaultRelease(options), options: options}
+	r.steps = r.defaultSteps()
+
+	return r
 }
 
 // SetClient can be used to set the internal stage client.
@@ -414,6 +512,87 @@ func (r *Release) Submit(stream bool) error {
 	return nil
 }
 
+// Steps returns the registry of steps executed by `Run`. It can be used to
+// insert custom steps into the release process.
+func (r *Release) Steps() *Steps {
+	return r.steps
+}
+
+// defaultSteps returns the default release steps.
+func (r *Release) defaultSteps() *Steps {
+	return &Steps{steps: []*Step{
+		{
+			Name:        StepValidateOptions,
+			Description: "Validating options",
+			Idempotent:  true,
+			Run:         func() error { return r.client.ValidateOptions() },
+		},
+		{
+			Name:        StepCheckPrerequisites,
+			Description: "Checking prerequisites",
+			Idempotent:  true,
+			Run:         func() error { return r.client.CheckPrerequisites() },
+		},
+		{
+			Name:        StepCheckReleaseBranchState,
+			Description: "Checking release branch state",
+			DependsOn:   []string{StepValidateOptions},
+			Run:         func() error { return r.client.CheckReleaseBranchState() },
+		},
+		{
+			Name:        StepGenerateReleaseVersion,
+			Description: "Generating release version",
+			DependsOn:   []string{StepCheckReleaseBranchState},
+			Run:         func() error { return r.client.GenerateReleaseVersion() },
+		},
+		{
+			Name:        StepPrepareWorkspace,
+			Description: "Preparing workspace",
+			Idempotent:  true,
+			DependsOn:   []string{StepValidateOptions},
+			Run:         func() error { return r.client.PrepareWorkspace() },
+		},
+		{
+			Name:        StepCheckProvenance,
+			Description: "Checking artifacts provenance",
+			DependsOn:   []string{StepGenerateReleaseVersion, StepPrepareWorkspace},
+			Run: func() error {
+				if err := r.client.CheckProvenance(); err != nil {
+					// For now, we only notify provenance errors as not to treat
+					// them as fatal while we finish testing SLSA compliance.
+					logrus.Warnf("Unable to check provenance attestation: %v", err)
+				}
+
+				return nil
+			},
+		},
+		{
+			Name:        StepCreateAnnouncement,
+			Description: "Creating announcement",
+			DependsOn:   []string{StepGenerateReleaseVersion, StepPrepareWorkspace},
+			Run:         func() error { return r.client.CreateAnnouncement() },
+		},
+		{
+			Name:        StepPushArtifacts,
+			Description: "Pushing artifacts",
+			DependsOn:   []string{StepCheckProvenance, StepCreateAnnouncement},
+			Run:         func() error { return r.client.PushArtifacts() },
+		},
+		{
+			Name:        StepPushGitObjects,
+			Description: "Pushing git objects",
+			DependsOn:   []string{StepPushArtifacts},
+			Run:         func() error { return r.client.PushGitObjects() },
+		},
+		{
+			Name:        StepUpdateGitHubPage,
+			Description: "Updating GitHub release page",
+			DependsOn:   []string{StepPushGitObjects},
+			Run:         func() error { return r.client.UpdateGitHubPage() },
+		},
+	}}
+}
+
 // Run for `Release` struct finishes a previously staged release.
 func (r *Release) Run() error {
 	r.client.InitState()
@@ -422,73 +601,30 @@ func (r *Release) Run() error {
 		return fmt.Errorf("init log file: %w", err)
 	}
 
-	logger := log.NewStepLogger(11)
-	v := version.GetVersionInfo()
-	logger.Infof("Using krel version: %s", v.GitVersion)
-
-	logger.WithStep().Info("Validating options")
-
-	if err := r.client.ValidateOptions(); err != nil {
-		return fmt.Errorf("validate options: %w", err)
-	}
-
-	logger.WithStep().Info("Checking prerequisites")
-
-	if err := r.client.CheckPrerequisites(); err != nil {
-		return fmt.Errorf("check prerequisites: %w", err)
+	if err := r.client.InitCheckpoint(); err != nil {
+		return fmt.Errorf("init checkpoint: %w", err)
 	}
 
-	logger.WithStep().Info("Checking release branch state")
+	report := release.NewRunReport(release.RunTypeRelease)
 
-	if err := r.client.CheckReleaseBranchState(); err != nil {
-		return fmt.Errorf("check release branch state: %w", err)
-	}
-
-	logger.WithStep().Info("Generating release version")
-
-	if err := r.client.GenerateReleaseVersion(); err != nil {
-		return fmt.Errorf("generate release version: %w", err)
-	}
-
-	logger.WithStep().Info("Preparing workspace")
-
-	if err := r.client.PrepareWorkspace(); err != nil {
-		return fmt.Errorf("prepare workspace: %w", err)
-	}
-
-	logger.WithStep().Info("Checking artifacts provenance")
-
-	if err := r.client.CheckProvenance(); err != nil {
-		// For now, we only notify provenance errors as not to treat
-		// them as fatal while we finish testing SLSA compliance.
-		logrus.Warnf("Unable to check provenance attestation: %v", err)
-	}
-
-	logger.WithStep().Info("Creating announcement")
-
-	if err := r.client.CreateAnnouncement(); err != nil {
-		return fmt.Errorf("create announcement: %w", err)
-	}
-
-	logger.WithStep().Info("Pushing artifacts")
-
-	if err := r.client.PushArtifacts(); err != nil {
-		return fmt.Errorf("push artifacts: %w", err)
+	runErr := r.steps.run(
+		r.client, report, r.options.OnlySteps, r.options.SkipSteps,
+	)
+	if runErr != nil {
+		runErr = fmt.Errorf("run release steps: %w", runErr)
 	}
 
-	logger.WithStep().Info("Pushing git objects")
+	report.Finish(runErr)
 
-	if err := r.client.PushGitObjects(); err != nil {
-		return fmt.Errorf("push git objects: %w", err)
+	if err := r.client.WriteReport(report); err != nil {
+		logrus.Warnf("Unable to write release report: %v", err)
 	}
 
-	logger.WithStep().Info("Updating GitHub release page")
-
-	if err := r.client.UpdateGitHubPage(); err != nil {
-		return fmt.Errorf("updating github page: %w", err)
+	if runErr != nil {
+		return runErr
 	}
 
-	logger.Info("Release done")
+	logrus.Info("Release done")
 
 	return nil
 }
@@ -64,6 +64,12 @@ func TestRunStage(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // WriteReport fails
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mock.WriteReportReturns(err)
+			},
+			shouldError: false,
+		},
 		{ // ValidateOptions fails
 			prepare: func(mock *anagofakes.FakeStageClient) {
 				mock.ValidateOptionsReturns(err)
@@ -116,6 +122,26 @@ func TestRunStage(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // InitCheckpoint fails
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mock.InitCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // SaveCheckpoint fails
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mockGenerateReleaseVersionStage(mock)
+				mock.SaveCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // all steps completed previously
+			prepare: func(mock *anagofakes.FakeStageClient) {
+				mock.StepCompletedReturns(true)
+				mock.StageArtifactsReturns(err)
+			},
+			shouldError: false,
+		},
 	} {
 		opts := anago.DefaultStageOptions()
 		sut := anago.NewStage(opts)
@@ -150,6 +176,12 @@ func TestRunRelease(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // WriteReport fails
+			prepare: func(mock *anagofakes.FakeReleaseClient) {
+				mock.WriteReportReturns(err)
+			},
+			shouldError: false,
+		},
 		{ // CheckPrerequisites fails
 			prepare: func(mock *anagofakes.FakeReleaseClient) {
 				mock.CheckPrerequisitesReturns(err)
@@ -202,6 +234,26 @@ func TestRunRelease(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // InitCheckpoint fails
+			prepare: func(mock *anagofakes.FakeReleaseClient) {
+				mock.InitCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // SaveCheckpoint fails
+			prepare: func(mock *anagofakes.FakeReleaseClient) {
+				mockGenerateReleaseVersionRelease(mock)
+				mock.SaveCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // all steps completed previously
+			prepare: func(mock *anagofakes.FakeReleaseClient) {
+				mock.StepCompletedReturns(true)
+				mock.PushGitObjectsReturns(err)
+			},
+			shouldError: false,
+		},
 	} {
 		opts := anago.DefaultReleaseOptions()
 		sut := anago.NewRelease(opts)
@@ -243,6 +295,23 @@ func TestValidateOptions(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{ // success custom bucket
+			provided: &anago.Options{
+				ReleaseType:   release.ReleaseTypeAlpha,
+				ReleaseBranch: git.DefaultBranch,
+				CustomBucket:  "file:///tmp/bucket",
+			},
+			shouldError: false,
+		},
+		{ // custom bucket with nomock
+			provided: &anago.Options{
+				NoMock:        true,
+				ReleaseType:   release.ReleaseTypeAlpha,
+				ReleaseBranch: git.DefaultBranch,
+				CustomBucket:  "file:///tmp/bucket",
+			},
+			shouldError: true,
+		},
 	} {
 		err := tc.provided.Validate()
 		if tc.shouldError {
@@ -253,6 +322,17 @@ func TestValidateOptions(t *testing.T) {
 	}
 }
 
+func TestOptionsBucket(t *testing.T) {
+	opts := anago.DefaultOptions()
+	require.Equal(t, release.TestBucket, opts.Bucket())
+
+	opts.CustomBucket = "file:///tmp/bucket"
+	require.Equal(t, "file:///tmp/bucket", opts.Bucket())
+
+	opts.NoMock = true
+	require.Equal(t, release.ProductionBucket, opts.Bucket())
+}
+
 func TestValidateBuildVersion(t *testing.T) {
 	for _, tc := range []struct {
 		provided    *anago.Options
@@ -19,6 +19,8 @@ package anagofakes
 
 import (
 	"sync"
+
+	"k8s.io/release/pkg/release"
 )
 
 type FakeReleaseClient struct {
@@ -72,6 +74,16 @@ type FakeReleaseClient struct {
 	generateReleaseVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InitCheckpointStub        func() error
+	initCheckpointMutex       sync.RWMutex
+	initCheckpointArgsForCall []struct {
+	}
+	initCheckpointReturns struct {
+		result1 error
+	}
+	initCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InitLogFileStub        func() error
 	initLogFileMutex       sync.RWMutex
 	initLogFileArgsForCall []struct {
@@ -116,6 +128,38 @@ type FakeReleaseClient struct {
 	pushGitObjectsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveCheckpointStub        func() error
+	removeCheckpointMutex       sync.RWMutex
+	removeCheckpointArgsForCall []struct {
+	}
+	removeCheckpointReturns struct {
+		result1 error
+	}
+	removeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveCheckpointStub        func(string) error
+	saveCheckpointMutex       sync.RWMutex
+	saveCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	saveCheckpointReturns struct {
+		result1 error
+	}
+	saveCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StepCompletedStub        func(string) bool
+	stepCompletedMutex       sync.RWMutex
+	stepCompletedArgsForCall []struct {
+		arg1 string
+	}
+	stepCompletedReturns struct {
+		result1 bool
+	}
+	stepCompletedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	SubmitStub        func(bool) error
 	submitMutex       sync.RWMutex
 	submitArgsForCall []struct {
@@ -147,6 +191,17 @@ type FakeReleaseClient struct {
 	validateOptionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteReportStub        func(*release.RunReport) error
+	writeReportMutex       sync.RWMutex
+	writeReportArgsForCall []struct {
+		arg1 *release.RunReport
+	}
+	writeReportReturns struct {
+		result1 error
+	}
+	writeReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -416,6 +471,59 @@ func (fake *FakeReleaseClient) GenerateReleaseVersionReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeReleaseClient) InitCheckpoint() error {
+	fake.initCheckpointMutex.Lock()
+	ret, specificReturn := fake.initCheckpointReturnsOnCall[len(fake.initCheckpointArgsForCall)]
+	fake.initCheckpointArgsForCall = append(fake.initCheckpointArgsForCall, struct {
+	}{})
+	stub := fake.InitCheckpointStub
+	fakeReturns := fake.initCheckpointReturns
+	fake.recordInvocation("InitCheckpoint", []interface{}{})
+	fake.initCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) InitCheckpointCallCount() int {
+	fake.initCheckpointMutex.RLock()
+	defer fake.initCheckpointMutex.RUnlock()
+	return len(fake.initCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseClient) InitCheckpointCalls(stub func() error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = stub
+}
+
+func (fake *FakeReleaseClient) InitCheckpointReturns(result1 error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = nil
+	fake.initCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) InitCheckpointReturnsOnCall(i int, result1 error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = nil
+	if fake.initCheckpointReturnsOnCall == nil {
+		fake.initCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReleaseClient) InitLogFile() error {
 	fake.initLogFileMutex.Lock()
 	ret, specificReturn := fake.initLogFileReturnsOnCall[len(fake.initLogFileArgsForCall)]
@@ -652,6 +760,181 @@ func (fake *FakeReleaseClient) PushGitObjectsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeReleaseClient) RemoveCheckpoint() error {
+	fake.removeCheckpointMutex.Lock()
+	ret, specificReturn := fake.removeCheckpointReturnsOnCall[len(fake.removeCheckpointArgsForCall)]
+	fake.removeCheckpointArgsForCall = append(fake.removeCheckpointArgsForCall, struct {
+	}{})
+	stub := fake.RemoveCheckpointStub
+	fakeReturns := fake.removeCheckpointReturns
+	fake.recordInvocation("RemoveCheckpoint", []interface{}{})
+	fake.removeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) RemoveCheckpointCallCount() int {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	return len(fake.removeCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseClient) RemoveCheckpointCalls(stub func() error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = stub
+}
+
+func (fake *FakeReleaseClient) RemoveCheckpointReturns(result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	fake.removeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) RemoveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	if fake.removeCheckpointReturnsOnCall == nil {
+		fake.removeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) SaveCheckpoint(arg1 string) error {
+	fake.saveCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveCheckpointReturnsOnCall[len(fake.saveCheckpointArgsForCall)]
+	fake.saveCheckpointArgsForCall = append(fake.saveCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SaveCheckpointStub
+	fakeReturns := fake.saveCheckpointReturns
+	fake.recordInvocation("SaveCheckpoint", []interface{}{arg1})
+	fake.saveCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) SaveCheckpointCallCount() int {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	return len(fake.saveCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseClient) SaveCheckpointCalls(stub func(string) error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = stub
+}
+
+func (fake *FakeReleaseClient) SaveCheckpointArgsForCall(i int) string {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	argsForCall := fake.saveCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseClient) SaveCheckpointReturns(result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	fake.saveCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) SaveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	if fake.saveCheckpointReturnsOnCall == nil {
+		fake.saveCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) StepCompleted(arg1 string) bool {
+	fake.stepCompletedMutex.Lock()
+	ret, specificReturn := fake.stepCompletedReturnsOnCall[len(fake.stepCompletedArgsForCall)]
+	fake.stepCompletedArgsForCall = append(fake.stepCompletedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StepCompletedStub
+	fakeReturns := fake.stepCompletedReturns
+	fake.recordInvocation("StepCompleted", []interface{}{arg1})
+	fake.stepCompletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) StepCompletedCallCount() int {
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
+	return len(fake.stepCompletedArgsForCall)
+}
+
+func (fake *FakeReleaseClient) StepCompletedCalls(stub func(string) bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = stub
+}
+
+func (fake *FakeReleaseClient) StepCompletedArgsForCall(i int) string {
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
+	argsForCall := fake.stepCompletedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseClient) StepCompletedReturns(result1 bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = nil
+	fake.stepCompletedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) StepCompletedReturnsOnCall(i int, result1 bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = nil
+	if fake.stepCompletedReturnsOnCall == nil {
+		fake.stepCompletedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.stepCompletedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeReleaseClient) Submit(arg1 bool) error {
 	fake.submitMutex.Lock()
 	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
@@ -819,6 +1102,67 @@ func (fake *FakeReleaseClient) ValidateOptionsReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeReleaseClient) WriteReport(arg1 *release.RunReport) error {
+	fake.writeReportMutex.Lock()
+	ret, specificReturn := fake.writeReportReturnsOnCall[len(fake.writeReportArgsForCall)]
+	fake.writeReportArgsForCall = append(fake.writeReportArgsForCall, struct {
+		arg1 *release.RunReport
+	}{arg1})
+	stub := fake.WriteReportStub
+	fakeReturns := fake.writeReportReturns
+	fake.recordInvocation("WriteReport", []interface{}{arg1})
+	fake.writeReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseClient) WriteReportCallCount() int {
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
+	return len(fake.writeReportArgsForCall)
+}
+
+func (fake *FakeReleaseClient) WriteReportCalls(stub func(*release.RunReport) error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = stub
+}
+
+func (fake *FakeReleaseClient) WriteReportArgsForCall(i int) *release.RunReport {
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
+	argsForCall := fake.writeReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseClient) WriteReportReturns(result1 error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = nil
+	fake.writeReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseClient) WriteReportReturnsOnCall(i int, result1 error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = nil
+	if fake.writeReportReturnsOnCall == nil {
+		fake.writeReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReleaseClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -832,6 +1176,8 @@ func (fake *FakeReleaseClient) Invocations() map[string][][]interface{} {
 	defer fake.createAnnouncementMutex.RUnlock()
 	fake.generateReleaseVersionMutex.RLock()
 	defer fake.generateReleaseVersionMutex.RUnlock()
+	fake.initCheckpointMutex.RLock()
+	defer fake.initCheckpointMutex.RUnlock()
 	fake.initLogFileMutex.RLock()
 	defer fake.initLogFileMutex.RUnlock()
 	fake.initStateMutex.RLock()
@@ -842,12 +1188,20 @@ func (fake *FakeReleaseClient) Invocations() map[string][][]interface{} {
 	defer fake.pushArtifactsMutex.RUnlock()
 	fake.pushGitObjectsMutex.RLock()
 	defer fake.pushGitObjectsMutex.RUnlock()
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
 	fake.submitMutex.RLock()
 	defer fake.submitMutex.RUnlock()
 	fake.updateGitHubPageMutex.RLock()
 	defer fake.updateGitHubPageMutex.RUnlock()
 	fake.validateOptionsMutex.RLock()
 	defer fake.validateOptionsMutex.RUnlock()
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
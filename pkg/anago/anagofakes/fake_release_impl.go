@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	semver "github.com/blang/semver/v4"
+	"k8s.io/release/pkg/anago"
 	"k8s.io/release/pkg/announce"
 	"k8s.io/release/pkg/announce/github"
 	"k8s.io/release/pkg/build"
@@ -143,6 +144,35 @@ type FakeReleaseImpl struct {
 		result1 *release.Versions
 		result2 error
 	}
+	GitHubReleaseExistsStub        func(string, string, string) (bool, error)
+	gitHubReleaseExistsMutex       sync.RWMutex
+	gitHubReleaseExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	gitHubReleaseExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	gitHubReleaseExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	HasRemoteTagStub        func(*release.GitObjectPusher, string) (bool, error)
+	hasRemoteTagMutex       sync.RWMutex
+	hasRemoteTagArgsForCall []struct {
+		arg1 *release.GitObjectPusher
+		arg2 string
+	}
+	hasRemoteTagReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasRemoteTagReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	NewGitPusherStub        func(*release.GitObjectPusherOptions) (*release.GitObjectPusher, error)
 	newGitPusherMutex       sync.RWMutex
 	newGitPusherArgsForCall []struct {
@@ -170,6 +200,20 @@ type FakeReleaseImpl struct {
 		result1 string
 		result2 error
 	}
+	PathExistsStub        func(object.Store, string) (bool, error)
+	pathExistsMutex       sync.RWMutex
+	pathExistsArgsForCall []struct {
+		arg1 object.Store
+		arg2 string
+	}
+	pathExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	pathExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PrepareWorkspaceReleaseStub        func(string, string) error
 	prepareWorkspaceReleaseMutex       sync.RWMutex
 	prepareWorkspaceReleaseArgsForCall []struct {
@@ -248,6 +292,30 @@ type FakeReleaseImpl struct {
 	pushTagsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReadCheckpointStub        func(string) (*anago.Checkpoint, error)
+	readCheckpointMutex       sync.RWMutex
+	readCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	readCheckpointReturns struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
+	readCheckpointReturnsOnCall map[int]struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
+	RemoveCheckpointStub        func(string) error
+	removeCheckpointMutex       sync.RWMutex
+	removeCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	removeCheckpointReturns struct {
+		result1 error
+	}
+	removeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SubmitStub        func(*gcb.Options) error
 	submitMutex       sync.RWMutex
 	submitArgsForCall []struct {
@@ -281,12 +349,13 @@ type FakeReleaseImpl struct {
 	updateGitHubPageReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ValidateImagesStub        func(string, string, string) error
+	ValidateImagesStub        func(string, string, string, int) error
 	validateImagesMutex       sync.RWMutex
 	validateImagesArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
+		arg4 int
 	}
 	validateImagesReturns struct {
 		result1 error
@@ -294,6 +363,30 @@ type FakeReleaseImpl struct {
 	validateImagesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteCheckpointStub        func(string, *anago.Checkpoint) error
+	writeCheckpointMutex       sync.RWMutex
+	writeCheckpointArgsForCall []struct {
+		arg1 string
+		arg2 *anago.Checkpoint
+	}
+	writeCheckpointReturns struct {
+		result1 error
+	}
+	writeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteRunReportStub        func(*release.RunReport, string) error
+	writeRunReportMutex       sync.RWMutex
+	writeRunReportArgsForCall []struct {
+		arg1 *release.RunReport
+		arg2 string
+	}
+	writeRunReportReturns struct {
+		result1 error
+	}
+	writeRunReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -856,6 +949,137 @@ func (fake *FakeReleaseImpl) GenerateReleaseVersionReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeReleaseImpl) GitHubReleaseExists(arg1 string, arg2 string, arg3 string) (bool, error) {
+	fake.gitHubReleaseExistsMutex.Lock()
+	ret, specificReturn := fake.gitHubReleaseExistsReturnsOnCall[len(fake.gitHubReleaseExistsArgsForCall)]
+	fake.gitHubReleaseExistsArgsForCall = append(fake.gitHubReleaseExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GitHubReleaseExistsStub
+	fakeReturns := fake.gitHubReleaseExistsReturns
+	fake.recordInvocation("GitHubReleaseExists", []interface{}{arg1, arg2, arg3})
+	fake.gitHubReleaseExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseImpl) GitHubReleaseExistsCallCount() int {
+	fake.gitHubReleaseExistsMutex.RLock()
+	defer fake.gitHubReleaseExistsMutex.RUnlock()
+	return len(fake.gitHubReleaseExistsArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) GitHubReleaseExistsCalls(stub func(string, string, string) (bool, error)) {
+	fake.gitHubReleaseExistsMutex.Lock()
+	defer fake.gitHubReleaseExistsMutex.Unlock()
+	fake.GitHubReleaseExistsStub = stub
+}
+
+func (fake *FakeReleaseImpl) GitHubReleaseExistsArgsForCall(i int) (string, string, string) {
+	fake.gitHubReleaseExistsMutex.RLock()
+	defer fake.gitHubReleaseExistsMutex.RUnlock()
+	argsForCall := fake.gitHubReleaseExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeReleaseImpl) GitHubReleaseExistsReturns(result1 bool, result2 error) {
+	fake.gitHubReleaseExistsMutex.Lock()
+	defer fake.gitHubReleaseExistsMutex.Unlock()
+	fake.GitHubReleaseExistsStub = nil
+	fake.gitHubReleaseExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) GitHubReleaseExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.gitHubReleaseExistsMutex.Lock()
+	defer fake.gitHubReleaseExistsMutex.Unlock()
+	fake.GitHubReleaseExistsStub = nil
+	if fake.gitHubReleaseExistsReturnsOnCall == nil {
+		fake.gitHubReleaseExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.gitHubReleaseExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTag(arg1 *release.GitObjectPusher, arg2 string) (bool, error) {
+	fake.hasRemoteTagMutex.Lock()
+	ret, specificReturn := fake.hasRemoteTagReturnsOnCall[len(fake.hasRemoteTagArgsForCall)]
+	fake.hasRemoteTagArgsForCall = append(fake.hasRemoteTagArgsForCall, struct {
+		arg1 *release.GitObjectPusher
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HasRemoteTagStub
+	fakeReturns := fake.hasRemoteTagReturns
+	fake.recordInvocation("HasRemoteTag", []interface{}{arg1, arg2})
+	fake.hasRemoteTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTagCallCount() int {
+	fake.hasRemoteTagMutex.RLock()
+	defer fake.hasRemoteTagMutex.RUnlock()
+	return len(fake.hasRemoteTagArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTagCalls(stub func(*release.GitObjectPusher, string) (bool, error)) {
+	fake.hasRemoteTagMutex.Lock()
+	defer fake.hasRemoteTagMutex.Unlock()
+	fake.HasRemoteTagStub = stub
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTagArgsForCall(i int) (*release.GitObjectPusher, string) {
+	fake.hasRemoteTagMutex.RLock()
+	defer fake.hasRemoteTagMutex.RUnlock()
+	argsForCall := fake.hasRemoteTagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTagReturns(result1 bool, result2 error) {
+	fake.hasRemoteTagMutex.Lock()
+	defer fake.hasRemoteTagMutex.Unlock()
+	fake.HasRemoteTagStub = nil
+	fake.hasRemoteTagReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) HasRemoteTagReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasRemoteTagMutex.Lock()
+	defer fake.hasRemoteTagMutex.Unlock()
+	fake.HasRemoteTagStub = nil
+	if fake.hasRemoteTagReturnsOnCall == nil {
+		fake.hasRemoteTagReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasRemoteTagReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeReleaseImpl) NewGitPusher(arg1 *release.GitObjectPusherOptions) (*release.GitObjectPusher, error) {
 	fake.newGitPusherMutex.Lock()
 	ret, specificReturn := fake.newGitPusherReturnsOnCall[len(fake.newGitPusherArgsForCall)]
@@ -985,6 +1209,71 @@ func (fake *FakeReleaseImpl) NormalizePathReturnsOnCall(i int, result1 string, r
 	}{result1, result2}
 }
 
+func (fake *FakeReleaseImpl) PathExists(arg1 object.Store, arg2 string) (bool, error) {
+	fake.pathExistsMutex.Lock()
+	ret, specificReturn := fake.pathExistsReturnsOnCall[len(fake.pathExistsArgsForCall)]
+	fake.pathExistsArgsForCall = append(fake.pathExistsArgsForCall, struct {
+		arg1 object.Store
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PathExistsStub
+	fakeReturns := fake.pathExistsReturns
+	fake.recordInvocation("PathExists", []interface{}{arg1, arg2})
+	fake.pathExistsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseImpl) PathExistsCallCount() int {
+	fake.pathExistsMutex.RLock()
+	defer fake.pathExistsMutex.RUnlock()
+	return len(fake.pathExistsArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) PathExistsCalls(stub func(object.Store, string) (bool, error)) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = stub
+}
+
+func (fake *FakeReleaseImpl) PathExistsArgsForCall(i int) (object.Store, string) {
+	fake.pathExistsMutex.RLock()
+	defer fake.pathExistsMutex.RUnlock()
+	argsForCall := fake.pathExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseImpl) PathExistsReturns(result1 bool, result2 error) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = nil
+	fake.pathExistsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) PathExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pathExistsMutex.Lock()
+	defer fake.pathExistsMutex.Unlock()
+	fake.PathExistsStub = nil
+	if fake.pathExistsReturnsOnCall == nil {
+		fake.pathExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pathExistsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeReleaseImpl) PrepareWorkspaceRelease(arg1 string, arg2 string) error {
 	fake.prepareWorkspaceReleaseMutex.Lock()
 	ret, specificReturn := fake.prepareWorkspaceReleaseReturnsOnCall[len(fake.prepareWorkspaceReleaseArgsForCall)]
@@ -1378,6 +1667,131 @@ func (fake *FakeReleaseImpl) PushTagsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeReleaseImpl) ReadCheckpoint(arg1 string) (*anago.Checkpoint, error) {
+	fake.readCheckpointMutex.Lock()
+	ret, specificReturn := fake.readCheckpointReturnsOnCall[len(fake.readCheckpointArgsForCall)]
+	fake.readCheckpointArgsForCall = append(fake.readCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadCheckpointStub
+	fakeReturns := fake.readCheckpointReturns
+	fake.recordInvocation("ReadCheckpoint", []interface{}{arg1})
+	fake.readCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeReleaseImpl) ReadCheckpointCallCount() int {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	return len(fake.readCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) ReadCheckpointCalls(stub func(string) (*anago.Checkpoint, error)) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = stub
+}
+
+func (fake *FakeReleaseImpl) ReadCheckpointArgsForCall(i int) string {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	argsForCall := fake.readCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseImpl) ReadCheckpointReturns(result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	fake.readCheckpointReturns = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) ReadCheckpointReturnsOnCall(i int, result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	if fake.readCheckpointReturnsOnCall == nil {
+		fake.readCheckpointReturnsOnCall = make(map[int]struct {
+			result1 *anago.Checkpoint
+			result2 error
+		})
+	}
+	fake.readCheckpointReturnsOnCall[i] = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpoint(arg1 string) error {
+	fake.removeCheckpointMutex.Lock()
+	ret, specificReturn := fake.removeCheckpointReturnsOnCall[len(fake.removeCheckpointArgsForCall)]
+	fake.removeCheckpointArgsForCall = append(fake.removeCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveCheckpointStub
+	fakeReturns := fake.removeCheckpointReturns
+	fake.recordInvocation("RemoveCheckpoint", []interface{}{arg1})
+	fake.removeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpointCallCount() int {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	return len(fake.removeCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpointCalls(stub func(string) error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = stub
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpointArgsForCall(i int) string {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	argsForCall := fake.removeCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpointReturns(result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	fake.removeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseImpl) RemoveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	if fake.removeCheckpointReturnsOnCall == nil {
+		fake.removeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReleaseImpl) Submit(arg1 *gcb.Options) error {
 	fake.submitMutex.Lock()
 	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
@@ -1561,20 +1975,21 @@ func (fake *FakeReleaseImpl) UpdateGitHubPageReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
-func (fake *FakeReleaseImpl) ValidateImages(arg1 string, arg2 string, arg3 string) error {
+func (fake *FakeReleaseImpl) ValidateImages(arg1 string, arg2 string, arg3 string, arg4 int) error {
 	fake.validateImagesMutex.Lock()
 	ret, specificReturn := fake.validateImagesReturnsOnCall[len(fake.validateImagesArgsForCall)]
 	fake.validateImagesArgsForCall = append(fake.validateImagesArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.ValidateImagesStub
 	fakeReturns := fake.validateImagesReturns
-	fake.recordInvocation("ValidateImages", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("ValidateImages", []interface{}{arg1, arg2, arg3, arg4})
 	fake.validateImagesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1588,17 +2003,17 @@ func (fake *FakeReleaseImpl) ValidateImagesCallCount() int {
 	return len(fake.validateImagesArgsForCall)
 }
 
-func (fake *FakeReleaseImpl) ValidateImagesCalls(stub func(string, string, string) error) {
+func (fake *FakeReleaseImpl) ValidateImagesCalls(stub func(string, string, string, int) error) {
 	fake.validateImagesMutex.Lock()
 	defer fake.validateImagesMutex.Unlock()
 	fake.ValidateImagesStub = stub
 }
 
-func (fake *FakeReleaseImpl) ValidateImagesArgsForCall(i int) (string, string, string) {
+func (fake *FakeReleaseImpl) ValidateImagesArgsForCall(i int) (string, string, string, int) {
 	fake.validateImagesMutex.RLock()
 	defer fake.validateImagesMutex.RUnlock()
 	argsForCall := fake.validateImagesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeReleaseImpl) ValidateImagesReturns(result1 error) {
@@ -1624,6 +2039,130 @@ func (fake *FakeReleaseImpl) ValidateImagesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeReleaseImpl) WriteCheckpoint(arg1 string, arg2 *anago.Checkpoint) error {
+	fake.writeCheckpointMutex.Lock()
+	ret, specificReturn := fake.writeCheckpointReturnsOnCall[len(fake.writeCheckpointArgsForCall)]
+	fake.writeCheckpointArgsForCall = append(fake.writeCheckpointArgsForCall, struct {
+		arg1 string
+		arg2 *anago.Checkpoint
+	}{arg1, arg2})
+	stub := fake.WriteCheckpointStub
+	fakeReturns := fake.writeCheckpointReturns
+	fake.recordInvocation("WriteCheckpoint", []interface{}{arg1, arg2})
+	fake.writeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseImpl) WriteCheckpointCallCount() int {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	return len(fake.writeCheckpointArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) WriteCheckpointCalls(stub func(string, *anago.Checkpoint) error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = stub
+}
+
+func (fake *FakeReleaseImpl) WriteCheckpointArgsForCall(i int) (string, *anago.Checkpoint) {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	argsForCall := fake.writeCheckpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseImpl) WriteCheckpointReturns(result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	fake.writeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseImpl) WriteCheckpointReturnsOnCall(i int, result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	if fake.writeCheckpointReturnsOnCall == nil {
+		fake.writeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseImpl) WriteRunReport(arg1 *release.RunReport, arg2 string) error {
+	fake.writeRunReportMutex.Lock()
+	ret, specificReturn := fake.writeRunReportReturnsOnCall[len(fake.writeRunReportArgsForCall)]
+	fake.writeRunReportArgsForCall = append(fake.writeRunReportArgsForCall, struct {
+		arg1 *release.RunReport
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WriteRunReportStub
+	fakeReturns := fake.writeRunReportReturns
+	fake.recordInvocation("WriteRunReport", []interface{}{arg1, arg2})
+	fake.writeRunReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeReleaseImpl) WriteRunReportCallCount() int {
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
+	return len(fake.writeRunReportArgsForCall)
+}
+
+func (fake *FakeReleaseImpl) WriteRunReportCalls(stub func(*release.RunReport, string) error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = stub
+}
+
+func (fake *FakeReleaseImpl) WriteRunReportArgsForCall(i int) (*release.RunReport, string) {
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
+	argsForCall := fake.writeRunReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeReleaseImpl) WriteRunReportReturns(result1 error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = nil
+	fake.writeRunReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReleaseImpl) WriteRunReportReturnsOnCall(i int, result1 error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = nil
+	if fake.writeRunReportReturnsOnCall == nil {
+		fake.writeRunReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeRunReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeReleaseImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1645,10 +2184,16 @@ func (fake *FakeReleaseImpl) Invocations() map[string][][]interface{} {
 	defer fake.createPubBotBranchIssueMutex.RUnlock()
 	fake.generateReleaseVersionMutex.RLock()
 	defer fake.generateReleaseVersionMutex.RUnlock()
+	fake.gitHubReleaseExistsMutex.RLock()
+	defer fake.gitHubReleaseExistsMutex.RUnlock()
+	fake.hasRemoteTagMutex.RLock()
+	defer fake.hasRemoteTagMutex.RUnlock()
 	fake.newGitPusherMutex.RLock()
 	defer fake.newGitPusherMutex.RUnlock()
 	fake.normalizePathMutex.RLock()
 	defer fake.normalizePathMutex.RUnlock()
+	fake.pathExistsMutex.RLock()
+	defer fake.pathExistsMutex.RUnlock()
 	fake.prepareWorkspaceReleaseMutex.RLock()
 	defer fake.prepareWorkspaceReleaseMutex.RUnlock()
 	fake.publishReleaseNotesIndexMutex.RLock()
@@ -1661,6 +2206,10 @@ func (fake *FakeReleaseImpl) Invocations() map[string][][]interface{} {
 	defer fake.pushMainBranchMutex.RUnlock()
 	fake.pushTagsMutex.RLock()
 	defer fake.pushTagsMutex.RUnlock()
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
 	fake.submitMutex.RLock()
 	defer fake.submitMutex.RUnlock()
 	fake.toFileMutex.RLock()
@@ -1669,6 +2218,10 @@ func (fake *FakeReleaseImpl) Invocations() map[string][][]interface{} {
 	defer fake.updateGitHubPageMutex.RUnlock()
 	fake.validateImagesMutex.RLock()
 	defer fake.validateImagesMutex.RUnlock()
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -19,6 +19,8 @@ package anagofakes
 
 import (
 	"sync"
+
+	"k8s.io/release/pkg/release"
 )
 
 type FakeStageClient struct {
@@ -82,6 +84,16 @@ type FakeStageClient struct {
 	generateReleaseVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	InitCheckpointStub        func() error
+	initCheckpointMutex       sync.RWMutex
+	initCheckpointArgsForCall []struct {
+	}
+	initCheckpointReturns struct {
+		result1 error
+	}
+	initCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InitLogFileStub        func() error
 	initLogFileMutex       sync.RWMutex
 	initLogFileArgsForCall []struct {
@@ -106,6 +118,27 @@ type FakeStageClient struct {
 	prepareWorkspaceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveCheckpointStub        func() error
+	removeCheckpointMutex       sync.RWMutex
+	removeCheckpointArgsForCall []struct {
+	}
+	removeCheckpointReturns struct {
+		result1 error
+	}
+	removeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveCheckpointStub        func(string) error
+	saveCheckpointMutex       sync.RWMutex
+	saveCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	saveCheckpointReturns struct {
+		result1 error
+	}
+	saveCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StageArtifactsStub        func() error
 	stageArtifactsMutex       sync.RWMutex
 	stageArtifactsArgsForCall []struct {
@@ -116,6 +149,17 @@ type FakeStageClient struct {
 	stageArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StepCompletedStub        func(string) bool
+	stepCompletedMutex       sync.RWMutex
+	stepCompletedArgsForCall []struct {
+		arg1 string
+	}
+	stepCompletedReturns struct {
+		result1 bool
+	}
+	stepCompletedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	SubmitStub        func(bool) error
 	submitMutex       sync.RWMutex
 	submitArgsForCall []struct {
@@ -157,6 +201,17 @@ type FakeStageClient struct {
 	verifyArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteReportStub        func(*release.RunReport) error
+	writeReportMutex       sync.RWMutex
+	writeReportArgsForCall []struct {
+		arg1 *release.RunReport
+	}
+	writeReportReturns struct {
+		result1 error
+	}
+	writeReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -479,6 +534,59 @@ func (fake *FakeStageClient) GenerateReleaseVersionReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeStageClient) InitCheckpoint() error {
+	fake.initCheckpointMutex.Lock()
+	ret, specificReturn := fake.initCheckpointReturnsOnCall[len(fake.initCheckpointArgsForCall)]
+	fake.initCheckpointArgsForCall = append(fake.initCheckpointArgsForCall, struct {
+	}{})
+	stub := fake.InitCheckpointStub
+	fakeReturns := fake.initCheckpointReturns
+	fake.recordInvocation("InitCheckpoint", []interface{}{})
+	fake.initCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) InitCheckpointCallCount() int {
+	fake.initCheckpointMutex.RLock()
+	defer fake.initCheckpointMutex.RUnlock()
+	return len(fake.initCheckpointArgsForCall)
+}
+
+func (fake *FakeStageClient) InitCheckpointCalls(stub func() error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = stub
+}
+
+func (fake *FakeStageClient) InitCheckpointReturns(result1 error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = nil
+	fake.initCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) InitCheckpointReturnsOnCall(i int, result1 error) {
+	fake.initCheckpointMutex.Lock()
+	defer fake.initCheckpointMutex.Unlock()
+	fake.InitCheckpointStub = nil
+	if fake.initCheckpointReturnsOnCall == nil {
+		fake.initCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.initCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageClient) InitLogFile() error {
 	fake.initLogFileMutex.Lock()
 	ret, specificReturn := fake.initLogFileReturnsOnCall[len(fake.initLogFileArgsForCall)]
@@ -609,6 +717,120 @@ func (fake *FakeStageClient) PrepareWorkspaceReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStageClient) RemoveCheckpoint() error {
+	fake.removeCheckpointMutex.Lock()
+	ret, specificReturn := fake.removeCheckpointReturnsOnCall[len(fake.removeCheckpointArgsForCall)]
+	fake.removeCheckpointArgsForCall = append(fake.removeCheckpointArgsForCall, struct {
+	}{})
+	stub := fake.RemoveCheckpointStub
+	fakeReturns := fake.removeCheckpointReturns
+	fake.recordInvocation("RemoveCheckpoint", []interface{}{})
+	fake.removeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) RemoveCheckpointCallCount() int {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	return len(fake.removeCheckpointArgsForCall)
+}
+
+func (fake *FakeStageClient) RemoveCheckpointCalls(stub func() error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = stub
+}
+
+func (fake *FakeStageClient) RemoveCheckpointReturns(result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	fake.removeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) RemoveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	if fake.removeCheckpointReturnsOnCall == nil {
+		fake.removeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) SaveCheckpoint(arg1 string) error {
+	fake.saveCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveCheckpointReturnsOnCall[len(fake.saveCheckpointArgsForCall)]
+	fake.saveCheckpointArgsForCall = append(fake.saveCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SaveCheckpointStub
+	fakeReturns := fake.saveCheckpointReturns
+	fake.recordInvocation("SaveCheckpoint", []interface{}{arg1})
+	fake.saveCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) SaveCheckpointCallCount() int {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	return len(fake.saveCheckpointArgsForCall)
+}
+
+func (fake *FakeStageClient) SaveCheckpointCalls(stub func(string) error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = stub
+}
+
+func (fake *FakeStageClient) SaveCheckpointArgsForCall(i int) string {
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
+	argsForCall := fake.saveCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageClient) SaveCheckpointReturns(result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	fake.saveCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) SaveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.saveCheckpointMutex.Lock()
+	defer fake.saveCheckpointMutex.Unlock()
+	fake.SaveCheckpointStub = nil
+	if fake.saveCheckpointReturnsOnCall == nil {
+		fake.saveCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageClient) StageArtifacts() error {
 	fake.stageArtifactsMutex.Lock()
 	ret, specificReturn := fake.stageArtifactsReturnsOnCall[len(fake.stageArtifactsArgsForCall)]
@@ -662,6 +884,67 @@ func (fake *FakeStageClient) StageArtifactsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStageClient) StepCompleted(arg1 string) bool {
+	fake.stepCompletedMutex.Lock()
+	ret, specificReturn := fake.stepCompletedReturnsOnCall[len(fake.stepCompletedArgsForCall)]
+	fake.stepCompletedArgsForCall = append(fake.stepCompletedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StepCompletedStub
+	fakeReturns := fake.stepCompletedReturns
+	fake.recordInvocation("StepCompleted", []interface{}{arg1})
+	fake.stepCompletedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) StepCompletedCallCount() int {
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
+	return len(fake.stepCompletedArgsForCall)
+}
+
+func (fake *FakeStageClient) StepCompletedCalls(stub func(string) bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = stub
+}
+
+func (fake *FakeStageClient) StepCompletedArgsForCall(i int) string {
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
+	argsForCall := fake.stepCompletedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageClient) StepCompletedReturns(result1 bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = nil
+	fake.stepCompletedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeStageClient) StepCompletedReturnsOnCall(i int, result1 bool) {
+	fake.stepCompletedMutex.Lock()
+	defer fake.stepCompletedMutex.Unlock()
+	fake.StepCompletedStub = nil
+	if fake.stepCompletedReturnsOnCall == nil {
+		fake.stepCompletedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.stepCompletedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeStageClient) Submit(arg1 bool) error {
 	fake.submitMutex.Lock()
 	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
@@ -882,6 +1165,67 @@ func (fake *FakeStageClient) VerifyArtifactsReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStageClient) WriteReport(arg1 *release.RunReport) error {
+	fake.writeReportMutex.Lock()
+	ret, specificReturn := fake.writeReportReturnsOnCall[len(fake.writeReportArgsForCall)]
+	fake.writeReportArgsForCall = append(fake.writeReportArgsForCall, struct {
+		arg1 *release.RunReport
+	}{arg1})
+	stub := fake.WriteReportStub
+	fakeReturns := fake.writeReportReturns
+	fake.recordInvocation("WriteReport", []interface{}{arg1})
+	fake.writeReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageClient) WriteReportCallCount() int {
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
+	return len(fake.writeReportArgsForCall)
+}
+
+func (fake *FakeStageClient) WriteReportCalls(stub func(*release.RunReport) error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = stub
+}
+
+func (fake *FakeStageClient) WriteReportArgsForCall(i int) *release.RunReport {
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
+	argsForCall := fake.writeReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageClient) WriteReportReturns(result1 error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = nil
+	fake.writeReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageClient) WriteReportReturnsOnCall(i int, result1 error) {
+	fake.writeReportMutex.Lock()
+	defer fake.writeReportMutex.Unlock()
+	fake.WriteReportStub = nil
+	if fake.writeReportReturnsOnCall == nil {
+		fake.writeReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -897,14 +1241,22 @@ func (fake *FakeStageClient) Invocations() map[string][][]interface{} {
 	defer fake.generateChangelogMutex.RUnlock()
 	fake.generateReleaseVersionMutex.RLock()
 	defer fake.generateReleaseVersionMutex.RUnlock()
+	fake.initCheckpointMutex.RLock()
+	defer fake.initCheckpointMutex.RUnlock()
 	fake.initLogFileMutex.RLock()
 	defer fake.initLogFileMutex.RUnlock()
 	fake.initStateMutex.RLock()
 	defer fake.initStateMutex.RUnlock()
 	fake.prepareWorkspaceMutex.RLock()
 	defer fake.prepareWorkspaceMutex.RUnlock()
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	fake.saveCheckpointMutex.RLock()
+	defer fake.saveCheckpointMutex.RUnlock()
 	fake.stageArtifactsMutex.RLock()
 	defer fake.stageArtifactsMutex.RUnlock()
+	fake.stepCompletedMutex.RLock()
+	defer fake.stepCompletedMutex.RUnlock()
 	fake.submitMutex.RLock()
 	defer fake.submitMutex.RUnlock()
 	fake.tagRepositoryMutex.RLock()
@@ -913,6 +1265,8 @@ func (fake *FakeStageClient) Invocations() map[string][][]interface{} {
 	defer fake.validateOptionsMutex.RUnlock()
 	fake.verifyArtifactsMutex.RLock()
 	defer fake.verifyArtifactsMutex.RUnlock()
+	fake.writeReportMutex.RLock()
+	defer fake.writeReportMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
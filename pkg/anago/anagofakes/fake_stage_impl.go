@@ -370,16 +370,18 @@ type FakeStageImpl struct {
 	pushAttestationReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PushContainerImagesStub        func(*build.Options) error
+	PushContainerImagesStub        func(*build.Options) (map[string]string, error)
 	pushContainerImagesMutex       sync.RWMutex
 	pushContainerImagesArgsForCall []struct {
 		arg1 *build.Options
 	}
 	pushContainerImagesReturns struct {
-		result1 error
+		result1 map[string]string
+		result2 error
 	}
 	pushContainerImagesReturnsOnCall map[int]struct {
-		result1 error
+		result1 map[string]string
+		result2 error
 	}
 	PushReleaseArtifactsStub        func(*build.Options, string, string) error
 	pushReleaseArtifactsMutex       sync.RWMutex
@@ -394,6 +396,30 @@ type FakeStageImpl struct {
 	pushReleaseArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReadCheckpointStub        func(string) (*anago.Checkpoint, error)
+	readCheckpointMutex       sync.RWMutex
+	readCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	readCheckpointReturns struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
+	readCheckpointReturnsOnCall map[int]struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}
+	RemoveCheckpointStub        func(string) error
+	removeCheckpointMutex       sync.RWMutex
+	removeCheckpointArgsForCall []struct {
+		arg1 string
+	}
+	removeCheckpointReturns struct {
+		result1 error
+	}
+	removeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RevParseStub        func(*git.Repo, string) (string, error)
 	revParseMutex       sync.RWMutex
 	revParseArgsForCall []struct {
@@ -492,6 +518,30 @@ type FakeStageImpl struct {
 	verifyArtifactsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteCheckpointStub        func(string, *anago.Checkpoint) error
+	writeCheckpointMutex       sync.RWMutex
+	writeCheckpointArgsForCall []struct {
+		arg1 string
+		arg2 *anago.Checkpoint
+	}
+	writeCheckpointReturns struct {
+		result1 error
+	}
+	writeCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteRunReportStub        func(*release.RunReport, string) error
+	writeRunReportMutex       sync.RWMutex
+	writeRunReportArgsForCall []struct {
+		arg1 *release.RunReport
+		arg2 string
+	}
+	writeRunReportReturns struct {
+		result1 error
+	}
+	writeRunReportReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WriteSourceBOMStub        func(*spdx.Document, string) error
 	writeSourceBOMMutex       sync.RWMutex
 	writeSourceBOMArgsForCall []struct {
@@ -2159,7 +2209,7 @@ func (fake *FakeStageImpl) PushAttestationReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeStageImpl) PushContainerImages(arg1 *build.Options) error {
+func (fake *FakeStageImpl) PushContainerImages(arg1 *build.Options) (map[string]string, error) {
 	fake.pushContainerImagesMutex.Lock()
 	ret, specificReturn := fake.pushContainerImagesReturnsOnCall[len(fake.pushContainerImagesArgsForCall)]
 	fake.pushContainerImagesArgsForCall = append(fake.pushContainerImagesArgsForCall, struct {
@@ -2173,9 +2223,9 @@ func (fake *FakeStageImpl) PushContainerImages(arg1 *build.Options) error {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeStageImpl) PushContainerImagesCallCount() int {
@@ -2184,7 +2234,7 @@ func (fake *FakeStageImpl) PushContainerImagesCallCount() int {
 	return len(fake.pushContainerImagesArgsForCall)
 }
 
-func (fake *FakeStageImpl) PushContainerImagesCalls(stub func(*build.Options) error) {
+func (fake *FakeStageImpl) PushContainerImagesCalls(stub func(*build.Options) (map[string]string, error)) {
 	fake.pushContainerImagesMutex.Lock()
 	defer fake.pushContainerImagesMutex.Unlock()
 	fake.PushContainerImagesStub = stub
@@ -2197,27 +2247,30 @@ func (fake *FakeStageImpl) PushContainerImagesArgsForCall(i int) *build.Options
 	return argsForCall.arg1
 }
 
-func (fake *FakeStageImpl) PushContainerImagesReturns(result1 error) {
+func (fake *FakeStageImpl) PushContainerImagesReturns(result1 map[string]string, result2 error) {
 	fake.pushContainerImagesMutex.Lock()
 	defer fake.pushContainerImagesMutex.Unlock()
 	fake.PushContainerImagesStub = nil
 	fake.pushContainerImagesReturns = struct {
-		result1 error
-	}{result1}
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStageImpl) PushContainerImagesReturnsOnCall(i int, result1 error) {
+func (fake *FakeStageImpl) PushContainerImagesReturnsOnCall(i int, result1 map[string]string, result2 error) {
 	fake.pushContainerImagesMutex.Lock()
 	defer fake.pushContainerImagesMutex.Unlock()
 	fake.PushContainerImagesStub = nil
 	if fake.pushContainerImagesReturnsOnCall == nil {
 		fake.pushContainerImagesReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 map[string]string
+			result2 error
 		})
 	}
 	fake.pushContainerImagesReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeStageImpl) PushReleaseArtifacts(arg1 *build.Options, arg2 string, arg3 string) error {
@@ -2283,6 +2336,131 @@ func (fake *FakeStageImpl) PushReleaseArtifactsReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeStageImpl) ReadCheckpoint(arg1 string) (*anago.Checkpoint, error) {
+	fake.readCheckpointMutex.Lock()
+	ret, specificReturn := fake.readCheckpointReturnsOnCall[len(fake.readCheckpointArgsForCall)]
+	fake.readCheckpointArgsForCall = append(fake.readCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadCheckpointStub
+	fakeReturns := fake.readCheckpointReturns
+	fake.recordInvocation("ReadCheckpoint", []interface{}{arg1})
+	fake.readCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStageImpl) ReadCheckpointCallCount() int {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	return len(fake.readCheckpointArgsForCall)
+}
+
+func (fake *FakeStageImpl) ReadCheckpointCalls(stub func(string) (*anago.Checkpoint, error)) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = stub
+}
+
+func (fake *FakeStageImpl) ReadCheckpointArgsForCall(i int) string {
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	argsForCall := fake.readCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageImpl) ReadCheckpointReturns(result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	fake.readCheckpointReturns = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) ReadCheckpointReturnsOnCall(i int, result1 *anago.Checkpoint, result2 error) {
+	fake.readCheckpointMutex.Lock()
+	defer fake.readCheckpointMutex.Unlock()
+	fake.ReadCheckpointStub = nil
+	if fake.readCheckpointReturnsOnCall == nil {
+		fake.readCheckpointReturnsOnCall = make(map[int]struct {
+			result1 *anago.Checkpoint
+			result2 error
+		})
+	}
+	fake.readCheckpointReturnsOnCall[i] = struct {
+		result1 *anago.Checkpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStageImpl) RemoveCheckpoint(arg1 string) error {
+	fake.removeCheckpointMutex.Lock()
+	ret, specificReturn := fake.removeCheckpointReturnsOnCall[len(fake.removeCheckpointArgsForCall)]
+	fake.removeCheckpointArgsForCall = append(fake.removeCheckpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RemoveCheckpointStub
+	fakeReturns := fake.removeCheckpointReturns
+	fake.recordInvocation("RemoveCheckpoint", []interface{}{arg1})
+	fake.removeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) RemoveCheckpointCallCount() int {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	return len(fake.removeCheckpointArgsForCall)
+}
+
+func (fake *FakeStageImpl) RemoveCheckpointCalls(stub func(string) error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = stub
+}
+
+func (fake *FakeStageImpl) RemoveCheckpointArgsForCall(i int) string {
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
+	argsForCall := fake.removeCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStageImpl) RemoveCheckpointReturns(result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	fake.removeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) RemoveCheckpointReturnsOnCall(i int, result1 error) {
+	fake.removeCheckpointMutex.Lock()
+	defer fake.removeCheckpointMutex.Unlock()
+	fake.RemoveCheckpointStub = nil
+	if fake.removeCheckpointReturnsOnCall == nil {
+		fake.removeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageImpl) RevParse(arg1 *git.Repo, arg2 string) (string, error) {
 	fake.revParseMutex.Lock()
 	ret, specificReturn := fake.revParseReturnsOnCall[len(fake.revParseArgsForCall)]
@@ -2788,6 +2966,130 @@ func (fake *FakeStageImpl) VerifyArtifactsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStageImpl) WriteCheckpoint(arg1 string, arg2 *anago.Checkpoint) error {
+	fake.writeCheckpointMutex.Lock()
+	ret, specificReturn := fake.writeCheckpointReturnsOnCall[len(fake.writeCheckpointArgsForCall)]
+	fake.writeCheckpointArgsForCall = append(fake.writeCheckpointArgsForCall, struct {
+		arg1 string
+		arg2 *anago.Checkpoint
+	}{arg1, arg2})
+	stub := fake.WriteCheckpointStub
+	fakeReturns := fake.writeCheckpointReturns
+	fake.recordInvocation("WriteCheckpoint", []interface{}{arg1, arg2})
+	fake.writeCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) WriteCheckpointCallCount() int {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	return len(fake.writeCheckpointArgsForCall)
+}
+
+func (fake *FakeStageImpl) WriteCheckpointCalls(stub func(string, *anago.Checkpoint) error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = stub
+}
+
+func (fake *FakeStageImpl) WriteCheckpointArgsForCall(i int) (string, *anago.Checkpoint) {
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	argsForCall := fake.writeCheckpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStageImpl) WriteCheckpointReturns(result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	fake.writeCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteCheckpointReturnsOnCall(i int, result1 error) {
+	fake.writeCheckpointMutex.Lock()
+	defer fake.writeCheckpointMutex.Unlock()
+	fake.WriteCheckpointStub = nil
+	if fake.writeCheckpointReturnsOnCall == nil {
+		fake.writeCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteRunReport(arg1 *release.RunReport, arg2 string) error {
+	fake.writeRunReportMutex.Lock()
+	ret, specificReturn := fake.writeRunReportReturnsOnCall[len(fake.writeRunReportArgsForCall)]
+	fake.writeRunReportArgsForCall = append(fake.writeRunReportArgsForCall, struct {
+		arg1 *release.RunReport
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WriteRunReportStub
+	fakeReturns := fake.writeRunReportReturns
+	fake.recordInvocation("WriteRunReport", []interface{}{arg1, arg2})
+	fake.writeRunReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStageImpl) WriteRunReportCallCount() int {
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
+	return len(fake.writeRunReportArgsForCall)
+}
+
+func (fake *FakeStageImpl) WriteRunReportCalls(stub func(*release.RunReport, string) error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = stub
+}
+
+func (fake *FakeStageImpl) WriteRunReportArgsForCall(i int) (*release.RunReport, string) {
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
+	argsForCall := fake.writeRunReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStageImpl) WriteRunReportReturns(result1 error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = nil
+	fake.writeRunReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStageImpl) WriteRunReportReturnsOnCall(i int, result1 error) {
+	fake.writeRunReportMutex.Lock()
+	defer fake.writeRunReportMutex.Unlock()
+	fake.WriteRunReportStub = nil
+	if fake.writeRunReportReturnsOnCall == nil {
+		fake.writeRunReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeRunReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStageImpl) WriteSourceBOM(arg1 *spdx.Document, arg2 string) error {
 	fake.writeSourceBOMMutex.Lock()
 	ret, specificReturn := fake.writeSourceBOMReturnsOnCall[len(fake.writeSourceBOMArgsForCall)]
@@ -2909,6 +3211,10 @@ func (fake *FakeStageImpl) Invocations() map[string][][]interface{} {
 	defer fake.pushContainerImagesMutex.RUnlock()
 	fake.pushReleaseArtifactsMutex.RLock()
 	defer fake.pushReleaseArtifactsMutex.RUnlock()
+	fake.readCheckpointMutex.RLock()
+	defer fake.readCheckpointMutex.RUnlock()
+	fake.removeCheckpointMutex.RLock()
+	defer fake.removeCheckpointMutex.RUnlock()
 	fake.revParseMutex.RLock()
 	defer fake.revParseMutex.RUnlock()
 	fake.revParseTagMutex.RLock()
@@ -2925,6 +3231,10 @@ func (fake *FakeStageImpl) Invocations() map[string][][]interface{} {
 	defer fake.toFileMutex.RUnlock()
 	fake.verifyArtifactsMutex.RLock()
 	defer fake.verifyArtifactsMutex.RUnlock()
+	fake.writeCheckpointMutex.RLock()
+	defer fake.writeCheckpointMutex.RUnlock()
+	fake.writeRunReportMutex.RLock()
+	defer fake.writeRunReportMutex.RUnlock()
 	fake.writeSourceBOMMutex.RLock()
 	defer fake.writeSourceBOMMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	"k8s.io/release/pkg/release"
+)
+
+const (
+	// stageCheckpointFile is the file where the stage state gets persisted
+	// after every completed step.
+	stageCheckpointFile = workspaceDir + "/stage-checkpoint.json"
+
+	// releaseCheckpointFile is the file where the release state gets
+	// persisted after every completed step.
+	releaseCheckpointFile = workspaceDir + "/release-checkpoint.json"
+)
+
+// Checkpoint is the serializable representation of the `State`, which gets
+// persisted after every completed step to be able to resume an interrupted
+// stage or release run.
+type Checkpoint struct {
+	// NoMock, ReleaseType, ReleaseBranch and BuildVersion are the options of
+	// the run which created the checkpoint.
+	NoMock        bool   `json:"noMock"`
+	ReleaseType   string `json:"releaseType"`
+	ReleaseBranch string `json:"releaseBranch"`
+	BuildVersion  string `json:"buildVersion"`
+
+	// CompletedSteps contains the names of all successfully finished steps.
+	CompletedSteps []string `json:"completedSteps"`
+
+	// SemverBuildVersion is the parsed build version.
+	SemverBuildVersion semver.Version `json:"semverBuildVersion"`
+
+	// Versions are the release versions generated by the run.
+	Versions *release.Versions `json:"versions,omitempty"`
+
+	// CreateReleaseBranch indicates if a new release branch gets created.
+	CreateReleaseBranch bool `json:"createReleaseBranch"`
+
+	// StartTime is the time when the initial run started.
+	StartTime time.Time `json:"startTime"`
+
+	// ImageDigests are the digests of the pushed container images per
+	// release version.
+	ImageDigests map[string]map[string]string `json:"imageDigests,omitempty"`
+}
+
+// readCheckpoint reads a `Checkpoint` from the provided JSON file.
+func readCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoint file: %w", err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("unmarshal checkpoint: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+// writeCheckpoint writes the `Checkpoint` into the provided JSON file.
+func writeCheckpoint(path string, checkpoint *Checkpoint) error {
+	data, err := json.MarshalIndent(checkpoint, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+
+	// Write to a temporary file first to not end up with a corrupted
+	// checkpoint if the process gets interrupted.
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write checkpoint file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename checkpoint file: %w", err)
+	}
+
+	return nil
+}
+
+// removeCheckpoint deletes the provided checkpoint file. A non existing file
+// is not considered an error.
+func removeCheckpoint(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove checkpoint file: %w", err)
+	}
+
+	return nil
+}
+
+// checkpoint returns the current `State` as `Checkpoint`.
+func (s *State) checkpoint(options *Options) *Checkpoint {
+	return &Checkpoint{
+		NoMock:              options.NoMock,
+		ReleaseType:         options.ReleaseType,
+		ReleaseBranch:       options.ReleaseBranch,
+		BuildVersion:        options.BuildVersion,
+		CompletedSteps:      s.completedSteps,
+		SemverBuildVersion:  s.semverBuildVersion,
+		Versions:            s.versions,
+		CreateReleaseBranch: s.createReleaseBranch,
+		StartTime:           s.startTime,
+		ImageDigests:        s.imageDigests,
+	}
+}
+
+// restore sets the `State` to the provided `Checkpoint`. It errors if the
+// checkpoint has been created by a run using different options.
+func (s *State) restore(checkpoint *Checkpoint, options *Options) error {
+	if checkpoint.NoMock != options.NoMock ||
+		checkpoint.ReleaseType != options.ReleaseType ||
+		checkpoint.ReleaseBranch != options.ReleaseBranch ||
+		checkpoint.BuildVersion != options.BuildVersion {
+		return fmt.Errorf(
+			"checkpoint options (NoMock: %v, ReleaseType: %q, "+
+				"BuildVersion: %q, ReleaseBranch: %q) do not match: %s",
+			checkpoint.NoMock, checkpoint.ReleaseType,
+			checkpoint.BuildVersion, checkpoint.ReleaseBranch,
+			options.String(),
+		)
+	}
+
+	s.completedSteps = checkpoint.CompletedSteps
+	s.semverBuildVersion = checkpoint.SemverBuildVersion
+	s.versions = checkpoint.Versions
+	s.createReleaseBranch = checkpoint.CreateReleaseBranch
+	s.startTime = checkpoint.StartTime
+	s.imageDigests = checkpoint.ImageDigests
+	s.resumed = true
+
+	return nil
+}
+
+// stepCompleted returns true if the step has been recorded as completed.
+func (s *State) stepCompleted(step string) bool {
+	return slices.Contains(s.completedSteps, step)
+}
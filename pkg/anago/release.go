@@ -17,7 +17,9 @@ limitations under the License.
 package anago
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/release-sdk/git"
+	githubsdk "sigs.k8s.io/release-sdk/github"
 	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/log"
 	"sigs.k8s.io/release-utils/util"
@@ -33,6 +36,7 @@ import (
 	"k8s.io/release/pkg/announce/github"
 	"k8s.io/release/pkg/build"
 	"k8s.io/release/pkg/gcp/gcb"
+	"k8s.io/release/pkg/objectstore"
 	"k8s.io/release/pkg/release"
 )
 
@@ -49,6 +53,22 @@ type releaseClient interface {
 	// InitLogFile sets up the log file target.
 	InitLogFile() error
 
+	// InitCheckpoint restores the state from the checkpoint file if the run
+	// should be resumed.
+	InitCheckpoint() error
+
+	// StepCompleted returns true if the provided step has been completed by
+	// a previous run.
+	StepCompleted(step string) bool
+
+	// SaveCheckpoint marks the provided step as completed and persists the
+	// current state into the checkpoint file.
+	SaveCheckpoint(step string) error
+
+	// RemoveCheckpoint deletes the checkpoint file after all steps have
+	// been completed.
+	RemoveCheckpoint() error
+
 	// Validate if the provided `ReleaseOptions` are correctly set.
 	ValidateOptions() error
 
@@ -90,6 +110,10 @@ type releaseClient interface {
 	// UpdateGitHubPage updates the GitHub release page to with the source code
 	// and release information.
 	UpdateGitHubPage() error
+
+	// WriteReport completes the run report with the current state, writes
+	// it to disk and uploads it to the staging bucket.
+	WriteReport(report *release.RunReport) error
 }
 
 // DefaultRelease is the default staging implementation used in production.
@@ -124,6 +148,9 @@ type defaultReleaseImpl struct{}
 type releaseImpl interface {
 	Submit(options *gcb.Options) error
 	ToFile(fileName string) error
+	ReadCheckpoint(path string) (*Checkpoint, error)
+	WriteCheckpoint(path string, checkpoint *Checkpoint) error
+	RemoveCheckpoint(path string) error
 	CheckPrerequisites() error
 	BranchNeedsCreation(
 		branch, releaseType string, buildVersion semver.Version,
@@ -136,7 +163,7 @@ type releaseImpl interface {
 	CopyStagedFromGCS(
 		options *build.Options, stagedBucket, buildVersion string,
 	) error
-	ValidateImages(registry, version, buildPath string) error
+	ValidateImages(registry, version, buildPath string, maxWorkers int) error
 	PublishVersion(
 		buildType, version, buildDir, bucket, gcsRoot string,
 		versionMarkers []string,
@@ -146,17 +173,21 @@ type releaseImpl interface {
 		options *announce.Options,
 	) error
 	UpdateGitHubPage(options *github.Options) error
+	GitHubReleaseExists(owner, repo, tag string) (bool, error)
+	HasRemoteTag(pusher *release.GitObjectPusher, tag string) (bool, error)
 	PushTags(pusher *release.GitObjectPusher, tagList []string) error
 	PushBranches(pusher *release.GitObjectPusher, branchList []string) error
 	PushMainBranch(pusher *release.GitObjectPusher) error
 	NewGitPusher(opts *release.GitObjectPusherOptions) (*release.GitObjectPusher, error)
 	NormalizePath(store object.Store, pathParts ...string) (string, error)
+	PathExists(store object.Store, gcsPath string) (bool, error)
 	CopyToRemote(store object.Store, src, gcsPath string) error
 	PublishReleaseNotesIndex(
 		gcsIndexRootPath, gcsReleaseNotesPath, version string,
 	) error
 	CreatePubBotBranchIssue(string) error
 	CheckStageProvenance(string, string, *release.Versions) error
+	WriteRunReport(report *release.RunReport, path string) error
 }
 
 func (d *defaultReleaseImpl) Submit(options *gcb.Options) error {
@@ -167,6 +198,18 @@ func (d *defaultReleaseImpl) ToFile(fileName string) error {
 	return log.ToFile(fileName)
 }
 
+func (d *defaultReleaseImpl) ReadCheckpoint(path string) (*Checkpoint, error) {
+	return readCheckpoint(path)
+}
+
+func (d *defaultReleaseImpl) WriteCheckpoint(path string, checkpoint *Checkpoint) error {
+	return writeCheckpoint(path, checkpoint)
+}
+
+func (d *defaultReleaseImpl) RemoveCheckpoint(path string) error {
+	return removeCheckpoint(path)
+}
+
 func (d *defaultReleaseImpl) CheckPrerequisites() error {
 	return release.NewPrerequisitesChecker().Run(workspaceDir)
 }
@@ -213,9 +256,12 @@ func (d *defaultReleaseImpl) CopyStagedFromGCS(
 }
 
 func (d *defaultReleaseImpl) ValidateImages(
-	registry, version, buildPath string,
+	registry, version, buildPath string, maxWorkers int,
 ) error {
-	return release.NewImages().Validate(registry, version, buildPath)
+	images := release.NewImages()
+	images.SetMaxWorkers(maxWorkers)
+
+	return images.Validate(registry, version, buildPath)
 }
 
 func (d *defaultReleaseImpl) PublishVersion(
@@ -269,6 +315,29 @@ func (d *defaultReleaseImpl) UpdateGitHubPage(options *github.Options) error {
 	return github.NewGitHub(options).UpdateGitHubPage()
 }
 
+// GitHubReleaseExists returns true if the GitHub release page for the tag
+// already exists.
+func (d *defaultReleaseImpl) GitHubReleaseExists(owner, repo, tag string) (bool, error) {
+	_, resp, err := githubsdk.New().Client().GetReleaseByTag(
+		context.Background(), owner, repo, tag,
+	)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("getting GitHub release for tag %s: %w", tag, err)
+	}
+
+	return true, nil
+}
+
+func (d *defaultReleaseImpl) HasRemoteTag(
+	pusher *release.GitObjectPusher, tag string,
+) (bool, error) {
+	return pusher.HasRemoteTag(tag)
+}
+
 func (d *defaultReleaseImpl) PushTags(
 	pusher *release.GitObjectPusher, tagList []string,
 ) error {
@@ -295,6 +364,12 @@ func (d *defaultReleaseImpl) NormalizePath(
 	return store.NormalizePath(pathParts...)
 }
 
+func (d *defaultReleaseImpl) PathExists(
+	store object.Store, gcsPath string,
+) (bool, error) {
+	return store.PathExists(gcsPath)
+}
+
 func (d *defaultReleaseImpl) CopyToRemote(
 	store object.Store, src, gcsPath string,
 ) error {
@@ -309,6 +384,10 @@ func (d *defaultReleaseImpl) PublishReleaseNotesIndex(
 	)
 }
 
+func (d *defaultReleaseImpl) WriteRunReport(report *release.RunReport, path string) error {
+	return report.Write(path)
+}
+
 func (d *defaultReleaseImpl) CreatePubBotBranchIssue(branchName string) error {
 	return release.CreatePubBotBranchIssue(branchName)
 }
@@ -325,6 +404,52 @@ func (d *defaultReleaseImpl) NewGitPusher(
 	return pusher, nil
 }
 
+func (d *DefaultRelease) InitCheckpoint() error {
+	if !d.options.Resume {
+		return nil
+	}
+
+	checkpoint, err := d.impl.ReadCheckpoint(releaseCheckpointFile)
+	if err != nil {
+		return fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	if err := d.state.restore(checkpoint, d.options.Options); err != nil {
+		return fmt.Errorf("restore checkpoint: %w", err)
+	}
+
+	logrus.Infof(
+		"Resuming release from %s, completed steps: %v",
+		releaseCheckpointFile, d.state.completedSteps,
+	)
+
+	return nil
+}
+
+func (d *DefaultRelease) StepCompleted(step string) bool {
+	return d.state.stepCompleted(step)
+}
+
+func (d *DefaultRelease) SaveCheckpoint(step string) error {
+	d.state.completedSteps = append(d.state.completedSteps, step)
+
+	if err := d.impl.WriteCheckpoint(
+		releaseCheckpointFile, d.state.checkpoint(d.options.Options),
+	); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultRelease) RemoveCheckpoint() error {
+	if err := d.impl.RemoveCheckpoint(releaseCheckpointFile); err != nil {
+		return fmt.Errorf("remove checkpoint: %w", err)
+	}
+
+	return nil
+}
+
 func (d *DefaultRelease) ValidateOptions() error {
 	if err := d.options.Validate(d.state.State); err != nil {
 		return fmt.Errorf("validating options: %w", err)
@@ -381,7 +506,40 @@ func (d *DefaultRelease) PrepareWorkspace() error {
 func (d *DefaultRelease) PushArtifacts() error {
 	const gcsRoot = "release"
 
+	objStore := objectstore.New()
+	objStore.SetOptions(objStore.WithNoClobber(false))
+
+	gcsReleaseRootPath, err := d.impl.NormalizePath(
+		objStore, d.options.Bucket(), gcsRoot,
+	)
+	if err != nil {
+		return fmt.Errorf("get GCS release root path: %w", err)
+	}
+
+	// A resumed run may have already published some of the versions before
+	// it got interrupted.
+	published := map[string]bool{}
+
+	if d.state.resumed {
+		for _, version := range d.state.versions.Ordered() {
+			exists, err := d.impl.PathExists(
+				objStore, gcsReleaseRootPath+fmt.Sprintf("/%s/provenance.json", version),
+			)
+			if err != nil {
+				return fmt.Errorf("check if version %s is already published: %w", version, err)
+			}
+
+			published[version] = exists
+		}
+	}
+
 	for _, version := range d.state.versions.Ordered() {
+		if published[version] {
+			logrus.Infof("Artifacts for version %s already published, skipping", version)
+
+			continue
+		}
+
 		logrus.Infof("Pushing artifacts for version %s", version)
 		buildDir := filepath.Join(
 			gitRoot, fmt.Sprintf("%s-%s", release.BuildDir, version),
@@ -396,6 +554,7 @@ func (d *DefaultRelease) PushArtifacts() error {
 			Version:                    version,
 			AllowDup:                   true,
 			ValidateRemoteImageDigests: true,
+			MaxImageWorkers:            d.options.MaxImageWorkers,
 		}
 		if err := d.impl.CheckReleaseBucket(pushBuildOptions); err != nil {
 			return fmt.Errorf("check release bucket access: %w", err)
@@ -418,7 +577,7 @@ func (d *DefaultRelease) PushArtifacts() error {
 		// Image promotion has been done on nomock stage, verify that the
 		// images are available.
 		if err := d.impl.ValidateImages(
-			targetRegistry, version, buildDir,
+			targetRegistry, version, buildDir, d.options.MaxImageWorkers,
 		); err != nil {
 			return fmt.Errorf("validate container images: %w", err)
 		}
@@ -432,16 +591,6 @@ func (d *DefaultRelease) PushArtifacts() error {
 
 	logrus.Info("Publishing release notes JSON and announcement")
 
-	objStore := object.NewGCS()
-	objStore.SetOptions(objStore.WithNoClobber(false))
-
-	gcsReleaseRootPath, err := d.impl.NormalizePath(
-		objStore, d.options.Bucket(), gcsRoot,
-	)
-	if err != nil {
-		return fmt.Errorf("get GCS release root path: %w", err)
-	}
-
 	gcsReleaseNotesPath := gcsReleaseRootPath + fmt.Sprintf(
 		"/%s/release-notes.json", d.state.versions.Prime(),
 	)
@@ -460,6 +609,10 @@ func (d *DefaultRelease) PushArtifacts() error {
 	}
 
 	for _, version := range d.state.versions.Ordered() {
+		if published[version] {
+			continue
+		}
+
 		if err := d.impl.CopyToRemote(
 			objStore,
 			filepath.Join(os.TempDir(), fmt.Sprintf("provenance-%s.json", version)),
@@ -500,7 +653,28 @@ func (d *DefaultRelease) PushGitObjects() error {
 	// The list of tags to be pushed to the remote repository.
 	// These come from the versions object created during
 	// GenerateReleaseVersion()
-	if err := d.impl.PushTags(pusher, d.state.versions.Ordered()); err != nil {
+	tagList := []string{}
+
+	for _, tag := range d.state.versions.Ordered() {
+		// A resumed run may have already pushed some of the tags before it
+		// got interrupted.
+		if d.state.resumed {
+			exists, err := d.impl.HasRemoteTag(pusher, tag)
+			if err != nil {
+				return fmt.Errorf("checking if tag %s exists in remote: %w", tag, err)
+			}
+
+			if exists {
+				logrus.Infof("Tag %s already exists in remote, skipping", tag)
+
+				continue
+			}
+		}
+
+		tagList = append(tagList, tag)
+	}
+
+	if err := d.impl.PushTags(pusher, tagList); err != nil {
 		return fmt.Errorf("pushing release tags: %w", err)
 	}
 
@@ -524,7 +698,7 @@ func (d *DefaultRelease) PushGitObjects() error {
 
 	logrus.Infof(
 		"Git objects push complete (%d branches, %d tags & main branch)",
-		len(d.state.versions.Ordered()), len(branchList),
+		len(branchList), len(tagList),
 	)
 
 	return nil
@@ -597,6 +771,26 @@ func (d *DefaultRelease) CreateAnnouncement() error {
 // UpdateGitHubPage Update the GitHub release page, uploading the
 // source code.
 func (d *DefaultRelease) UpdateGitHubPage() error {
+	// A resumed run may have already created the release page before it got
+	// interrupted.
+	if d.state.resumed && d.options.NoMock {
+		exists, err := d.impl.GitHubReleaseExists(
+			git.DefaultGithubOrg, git.DefaultGithubRepo, d.state.versions.Prime(),
+		)
+		if err != nil {
+			return fmt.Errorf("checking if GitHub release page exists: %w", err)
+		}
+
+		if exists {
+			logrus.Infof(
+				"GitHub release page for %s already exists, skipping",
+				d.state.versions.Prime(),
+			)
+
+			return nil
+		}
+	}
+
 	// URL to the changelog:
 	changelogURL := fmt.Sprintf(
 		"https://github.com/kubernetes/kubernetes/blob/master/CHANGELOG/CHANGELOG-%d.%d.md",
@@ -651,3 +845,36 @@ func (d *defaultReleaseImpl) CheckStageProvenance(bucket, buildVersion string, v
 
 	return nil
 }
+
+func (d *DefaultRelease) WriteReport(report *release.RunReport) error {
+	report.NoMock = d.options.NoMock
+	report.ReleaseType = d.options.ReleaseType
+	report.ReleaseBranch = d.options.ReleaseBranch
+	report.BuildVersion = d.options.BuildVersion
+	report.Versions = d.state.versions
+
+	if err := d.impl.WriteRunReport(report, releaseReportFile); err != nil {
+		return fmt.Errorf("writing release report: %w", err)
+	}
+
+	logrus.Infof("Release report written to %s", releaseReportFile)
+
+	objStore := objectstore.New()
+	objStore.SetOptions(objStore.WithNoClobber(false))
+
+	gcsReportPath, err := d.impl.NormalizePath(
+		objStore, d.options.Bucket(), release.StagePath,
+		d.options.BuildVersion, release.ReleaseReportFilename,
+	)
+	if err != nil {
+		return fmt.Errorf("get GCS release report path: %w", err)
+	}
+
+	if err := d.impl.CopyToRemote(
+		objStore, releaseReportFile, gcsReportPath,
+	); err != nil {
+		return fmt.Errorf("copy release report to bucket: %w", err)
+	}
+
+	return nil
+}
@@ -18,10 +18,13 @@ limitations under the License.
 package anago_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"sigs.k8s.io/release-sdk/object"
+
 	"k8s.io/release/pkg/anago"
 	"k8s.io/release/pkg/anago/anagofakes"
 	"k8s.io/release/pkg/release"
@@ -267,6 +270,26 @@ func TestPushArtifacts(t *testing.T) {
 	}
 }
 
+func TestPushArtifactsMaxImageWorkers(t *testing.T) {
+	opts := anago.DefaultReleaseOptions()
+	opts.MaxImageWorkers = 10
+	sut := anago.NewDefaultRelease(opts)
+	sut.SetState(
+		generateTestingReleaseState(&testStateParameters{versionsTag: &testVersionTag}),
+	)
+
+	mock := &anagofakes.FakeReleaseImpl{}
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.PushArtifacts())
+	require.Equal(t, 1, mock.ValidateImagesCallCount())
+
+	_, _, _, maxWorkers := mock.ValidateImagesArgsForCall(0)
+	require.Equal(t, 10, maxWorkers)
+
+	require.Equal(t, 10, mock.CheckReleaseBucketArgsForCall(0).MaxImageWorkers)
+}
+
 func TestPrepareWorkspaceRelease(t *testing.T) {
 	for _, tc := range []struct {
 		prepare     func(*anagofakes.FakeReleaseImpl)
@@ -486,3 +509,137 @@ func TestCheckProvenance(t *testing.T) {
 		}
 	}
 }
+
+func newResumedTestRelease(
+	t *testing.T, opts *anago.ReleaseOptions, mock *anagofakes.FakeReleaseImpl,
+) *anago.DefaultRelease {
+	t.Helper()
+
+	opts.Resume = true
+	sut := anago.NewDefaultRelease(opts)
+	sut.InitState()
+	sut.SetImpl(mock)
+
+	mock.ReadCheckpointReturns(&anago.Checkpoint{
+		NoMock:         opts.NoMock,
+		ReleaseType:    opts.ReleaseType,
+		ReleaseBranch:  opts.ReleaseBranch,
+		BuildVersion:   opts.BuildVersion,
+		CompletedSteps: []string{anago.StepCheckProvenance},
+		Versions: release.NewReleaseVersions(
+			"v1.21.0-rc.0", testVersionTag, "v1.21.0-rc.0", "", "",
+		),
+	}, nil)
+	require.NoError(t, sut.InitCheckpoint())
+
+	return sut
+}
+
+func TestPushArtifactsResume(t *testing.T) {
+	mock := &anagofakes.FakeReleaseImpl{}
+	mock.PathExistsCalls(func(_ object.Store, gcsPath string) (bool, error) {
+		return strings.Contains(gcsPath, "/"+testVersionTag+"/"), nil
+	})
+	sut := newResumedTestRelease(t, anago.DefaultReleaseOptions(), mock)
+
+	require.NoError(t, sut.PushArtifacts())
+
+	// Only the version which has not been published yet gets pushed
+	require.Equal(t, 1, mock.PublishVersionCallCount())
+	_, version, _, _, _, _, _, _ := mock.PublishVersionArgsForCall(0)
+	require.Equal(t, "v1.21.0-rc.0", version)
+
+	// Release notes, announcement and the provenance of the missing version
+	require.Equal(t, 3, mock.CopyToRemoteCallCount())
+	require.Equal(t, 1, mock.PublishReleaseNotesIndexCallCount())
+
+	// PathExists fails
+	mock = &anagofakes.FakeReleaseImpl{}
+	mock.PathExistsReturns(false, err)
+	sut = newResumedTestRelease(t, anago.DefaultReleaseOptions(), mock)
+	require.Error(t, sut.PushArtifacts())
+	require.Zero(t, mock.PublishVersionCallCount())
+}
+
+func TestPushGitObjectsResume(t *testing.T) {
+	mock := &anagofakes.FakeReleaseImpl{}
+	mock.HasRemoteTagCalls(func(_ *release.GitObjectPusher, tag string) (bool, error) {
+		return tag == testVersionTag, nil
+	})
+	sut := newResumedTestRelease(t, anago.DefaultReleaseOptions(), mock)
+
+	require.NoError(t, sut.PushGitObjects())
+
+	// Only the tag which does not exist in the remote gets pushed
+	require.Equal(t, 2, mock.HasRemoteTagCallCount())
+	require.Equal(t, 1, mock.PushTagsCallCount())
+	_, tags := mock.PushTagsArgsForCall(0)
+	require.Equal(t, []string{"v1.21.0-rc.0"}, tags)
+
+	// HasRemoteTag fails
+	mock = &anagofakes.FakeReleaseImpl{}
+	mock.HasRemoteTagReturns(false, err)
+	sut = newResumedTestRelease(t, anago.DefaultReleaseOptions(), mock)
+	require.Error(t, sut.PushGitObjects())
+	require.Zero(t, mock.PushTagsCallCount())
+
+	// Remote tags are not checked without resume
+	mock = &anagofakes.FakeReleaseImpl{}
+	sut = anago.NewDefaultRelease(anago.DefaultReleaseOptions())
+	sut.SetState(
+		generateTestingReleaseState(&testStateParameters{versionsTag: &testVersionTag}),
+	)
+	sut.SetImpl(mock)
+	require.NoError(t, sut.PushGitObjects())
+	require.Zero(t, mock.HasRemoteTagCallCount())
+}
+
+func TestUpdateGitHubPageResume(t *testing.T) {
+	for _, tc := range []struct {
+		prepare      func(*anagofakes.FakeReleaseImpl)
+		shouldUpdate bool
+		shouldError  bool
+	}{
+		{ // release page already exists
+			prepare: func(mock *anagofakes.FakeReleaseImpl) {
+				mock.GitHubReleaseExistsReturns(true, nil)
+			},
+			shouldUpdate: false,
+		},
+		{ // release page does not exist yet
+			prepare: func(mock *anagofakes.FakeReleaseImpl) {
+				mock.GitHubReleaseExistsReturns(false, nil)
+			},
+			shouldUpdate: true,
+		},
+		{ // GitHubReleaseExists fails
+			prepare: func(mock *anagofakes.FakeReleaseImpl) {
+				mock.GitHubReleaseExistsReturns(false, err)
+			},
+			shouldError: true,
+		},
+	} {
+		opts := anago.DefaultReleaseOptions()
+		opts.NoMock = true
+
+		mock := &anagofakes.FakeReleaseImpl{}
+		tc.prepare(mock)
+		sut := newResumedTestRelease(t, opts, mock)
+
+		err := sut.UpdateGitHubPage()
+		if tc.shouldError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+
+		_, _, tag := mock.GitHubReleaseExistsArgsForCall(0)
+		require.Equal(t, "v1.21.0-rc.0", tag)
+
+		if tc.shouldUpdate {
+			require.Equal(t, 1, mock.UpdateGitHubPageCallCount())
+		} else {
+			require.Zero(t, mock.UpdateGitHubPageCallCount())
+		}
+	}
+}
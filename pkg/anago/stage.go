@@ -53,6 +53,22 @@ type stageClient interface {
 	// InitLogFile sets up the log file target.
 	InitLogFile() error
 
+	// InitCheckpoint restores the state from the checkpoint file if the run
+	// should be resumed.
+	InitCheckpoint() error
+
+	// StepCompleted returns true if the provided step has been completed by
+	// a previous run.
+	StepCompleted(step string) bool
+
+	// SaveCheckpoint marks the provided step as completed and persists the
+	// current state into the checkpoint file.
+	SaveCheckpoint(step string) error
+
+	// RemoveCheckpoint deletes the checkpoint file after all steps have
+	// been completed.
+	RemoveCheckpoint() error
+
 	// Validate if the provided `StageOptions` are correctly set.
 	ValidateOptions() error
 
@@ -97,6 +113,10 @@ type stageClient interface {
 
 	// StageArtifacts copies the build artifacts to a Google Cloud Bucket.
 	StageArtifacts() error
+
+	// WriteReport completes the run report with the current state, writes
+	// it to disk and uploads it to the staging bucket.
+	WriteReport(report *release.RunReport) error
 }
 
 // DefaultStage is the default staging implementation used in production.
@@ -136,6 +156,9 @@ type defaultStageImpl struct{}
 type stageImpl interface {
 	Submit(options *gcb.Options) error
 	ToFile(fileName string) error
+	ReadCheckpoint(path string) (*Checkpoint, error)
+	WriteCheckpoint(path string, checkpoint *Checkpoint) error
+	RemoveCheckpoint(path string) error
 	CheckPrerequisites() error
 	BranchNeedsCreation(
 		branch, releaseType string, buildVersion semver.Version,
@@ -164,13 +187,14 @@ type stageImpl interface {
 	PushReleaseArtifacts(
 		options *build.Options, srcPath, gcsPath string,
 	) error
-	PushContainerImages(options *build.Options) error
+	PushContainerImages(options *build.Options) (map[string]string, error)
 	GenerateVersionArtifactsBOM(string) error
 	GenerateSourceTreeBOM(options *spdx.DocGenerateOptions) (*spdx.Document, error)
 	WriteSourceBOM(spdxDoc *spdx.Document, version string) error
 	ListBinaries(version string) ([]struct{ Path, Platform, Arch string }, error)
 	ListImageArchives(string) ([]string, error)
 	ListTarballs(version string) ([]string, error)
+	WriteRunReport(report *release.RunReport, path string) error
 	BuildBaseArtifactsSBOM(*spdx.DocGenerateOptions) (*spdx.Document, error)
 	AddBinariesToSBOM(*spdx.Document, string) error
 	AddTarfilesToSBOM(*spdx.Document, string) error
@@ -189,6 +213,18 @@ func (d *defaultStageImpl) ToFile(fileName string) error {
 	return log.ToFile(fileName)
 }
 
+func (d *defaultStageImpl) ReadCheckpoint(path string) (*Checkpoint, error) {
+	return readCheckpoint(path)
+}
+
+func (d *defaultStageImpl) WriteCheckpoint(path string, checkpoint *Checkpoint) error {
+	return writeCheckpoint(path, checkpoint)
+}
+
+func (d *defaultStageImpl) RemoveCheckpoint(path string) error {
+	return removeCheckpoint(path)
+}
+
 func (d *defaultStageImpl) CheckPrerequisites() error {
 	return release.NewPrerequisitesChecker().Run(workspaceDir)
 }
@@ -291,8 +327,8 @@ func (d *defaultStageImpl) PushReleaseArtifacts(
 
 func (d *defaultStageImpl) PushContainerImages(
 	options *build.Options,
-) error {
-	return build.NewInstance(options).PushContainerImages()
+) (map[string]string, error) {
+	return build.NewInstance(options).PublishContainerImages()
 }
 
 func (d *DefaultStage) Submit(stream bool) error {
@@ -323,6 +359,10 @@ func (d *defaultStageImpl) ListTarballs(version string) ([]string, error) {
 	return release.ListBuildTarballs(gitRoot, version)
 }
 
+func (d *defaultStageImpl) WriteRunReport(report *release.RunReport, path string) error {
+	return report.Write(path)
+}
+
 // VerifyArtifacts check the artifacts produced are correct.
 func (d *defaultStageImpl) VerifyArtifacts(versions []string) error {
 	// Create a new artifact checker to verify the consistency of
@@ -362,6 +402,52 @@ func (d *DefaultStage) InitState() {
 	d.state = &StageState{DefaultState()}
 }
 
+func (d *DefaultStage) InitCheckpoint() error {
+	if !d.options.Resume {
+		return nil
+	}
+
+	checkpoint, err := d.impl.ReadCheckpoint(stageCheckpointFile)
+	if err != nil {
+		return fmt.Errorf("read checkpoint: %w", err)
+	}
+
+	if err := d.state.restore(checkpoint, d.options.Options); err != nil {
+		return fmt.Errorf("restore checkpoint: %w", err)
+	}
+
+	logrus.Infof(
+		"Resuming stage from %s, completed steps: %v",
+		stageCheckpointFile, d.state.completedSteps,
+	)
+
+	return nil
+}
+
+func (d *DefaultStage) StepCompleted(step string) bool {
+	return d.state.stepCompleted(step)
+}
+
+func (d *DefaultStage) SaveCheckpoint(step string) error {
+	d.state.completedSteps = append(d.state.completedSteps, step)
+
+	if err := d.impl.WriteCheckpoint(
+		stageCheckpointFile, d.state.checkpoint(d.options.Options),
+	); err != nil {
+		return fmt.Errorf("write checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultStage) RemoveCheckpoint() error {
+	if err := d.impl.RemoveCheckpoint(stageCheckpointFile); err != nil {
+		return fmt.Errorf("remove checkpoint: %w", err)
+	}
+
+	return nil
+}
+
 func (d *DefaultStage) ValidateOptions() error {
 	if err := d.options.Validate(d.state.State); err != nil {
 		return fmt.Errorf("validating options: %w", err)
@@ -424,6 +510,14 @@ func (d *DefaultStage) TagRepository() error {
 
 		// Ensure that the tag not already exists
 		if _, err := d.impl.RevParseTag(repo, version); err == nil {
+			// A resumed run may have tagged the version before it got
+			// interrupted.
+			if d.state.resumed {
+				logrus.Infof("Tag %s already exists, skipping", version)
+
+				continue
+			}
+
 			return fmt.Errorf("tag %s already exists", version)
 		}
 
@@ -856,6 +950,7 @@ func (d *DefaultStage) StageArtifacts() error {
 		Registry:                   d.options.ContainerRegistry(),
 		AllowDup:                   true,
 		ValidateRemoteImageDigests: true,
+		MaxImageWorkers:            d.options.MaxImageWorkers,
 	}
 	if err := d.impl.CheckReleaseBucket(pushBuildOptions); err != nil {
 		return fmt.Errorf("check release bucket access: %w", err)
@@ -917,10 +1012,13 @@ func (d *DefaultStage) StageArtifacts() error {
 		}
 
 		// Push container images into registry
-		if err := d.impl.PushContainerImages(pushBuildOptions); err != nil {
+		digests, err := d.impl.PushContainerImages(pushBuildOptions)
+		if err != nil {
 			return fmt.Errorf("pushing container images: %w", err)
 		}
 
+		d.state.setImageDigests(version, digests)
+
 		// Add artifacts to the attestation, this should get both release-images
 		// and gcs-stage directories in one call.
 		subjects, err = d.impl.GetOutputDirSubjects(
@@ -1078,3 +1176,87 @@ func (d *defaultStageImpl) GetProvenanceSubjects(
 		WorkspaceDir: workspaceDir,
 	}).GetStagingSubjects(path)
 }
+
+func (d *DefaultStage) WriteReport(report *release.RunReport) error {
+	report.NoMock = d.options.NoMock
+	report.ReleaseType = d.options.ReleaseType
+	report.ReleaseBranch = d.options.ReleaseBranch
+	report.BuildVersion = d.options.BuildVersion
+	report.Versions = d.state.versions
+
+	if d.state.versions != nil && d.state.stepCompleted(StepBuild) {
+		report.Artifacts = map[string]*release.ArtifactsReport{}
+
+		for _, version := range d.state.versions.Ordered() {
+			artifacts, err := d.artifactsReport(version)
+			if err != nil {
+				logrus.Warnf(
+					"Unable to collect artifacts of version %s for the stage report: %v",
+					version, err,
+				)
+
+				continue
+			}
+
+			report.Artifacts[version] = artifacts
+		}
+	}
+
+	if err := d.impl.WriteRunReport(report, stageReportFile); err != nil {
+		return fmt.Errorf("writing stage report: %w", err)
+	}
+
+	logrus.Infof("Stage report written to %s", stageReportFile)
+
+	if d.options.BuildVersion == "" {
+		return nil
+	}
+
+	pushBuildOptions := &build.Options{
+		Bucket:   d.options.Bucket(),
+		AllowDup: true,
+	}
+	if err := d.impl.CheckReleaseBucket(pushBuildOptions); err != nil {
+		return fmt.Errorf("check release bucket access: %w", err)
+	}
+
+	if err := d.impl.PushReleaseArtifacts(
+		pushBuildOptions,
+		stageReportFile,
+		filepath.Join(
+			d.options.Bucket(), release.StagePath, d.options.BuildVersion,
+			release.StageReportFilename,
+		),
+	); err != nil {
+		return fmt.Errorf("pushing stage report: %w", err)
+	}
+
+	return nil
+}
+
+// artifactsReport collects the produced artifacts of a single version.
+func (d *DefaultStage) artifactsReport(version string) (*release.ArtifactsReport, error) {
+	res := &release.ArtifactsReport{}
+
+	binaries, err := d.impl.ListBinaries(version)
+	if err != nil {
+		return nil, fmt.Errorf("listing binaries: %w", err)
+	}
+
+	for _, binary := range binaries {
+		res.Binaries = append(res.Binaries, binary.Path)
+	}
+
+	if res.Tarballs, err = d.impl.ListTarballs(version); err != nil {
+		return nil, fmt.Errorf("listing tarballs: %w", err)
+	}
+
+	if res.ImageArchives, err = d.impl.ListImageArchives(version); err != nil {
+		return nil, fmt.Errorf("listing image archives: %w", err)
+	}
+
+	// Image digests are only available after staging the artifacts
+	res.ImageDigests = d.state.imageDigests[version]
+
+	return res, nil
+}
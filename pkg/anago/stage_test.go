@@ -81,6 +81,197 @@ func TestInitLogFileStage(t *testing.T) {
 	}
 }
 
+func TestInitCheckpointStage(t *testing.T) {
+	for _, tc := range []struct {
+		resume      bool
+		prepare     func(*anagofakes.FakeStageImpl)
+		assert      func(*anago.DefaultStage, *anagofakes.FakeStageImpl)
+		shouldError bool
+	}{
+		{ // success without resume
+			resume:  false,
+			prepare: func(*anagofakes.FakeStageImpl) {},
+			assert: func(sut *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				require.Zero(t, mock.ReadCheckpointCallCount())
+				require.False(t, sut.StepCompleted("build"))
+			},
+			shouldError: false,
+		},
+		{ // success with resume
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				opts := anago.DefaultStageOptions()
+				mock.ReadCheckpointReturns(&anago.Checkpoint{
+					ReleaseType:    opts.ReleaseType,
+					ReleaseBranch:  opts.ReleaseBranch,
+					CompletedSteps: []string{"build"},
+					Versions:       release.NewReleaseVersions("", testVersionTag, "", "", ""),
+				}, nil)
+			},
+			assert: func(sut *anago.DefaultStage, _ *anagofakes.FakeStageImpl) {
+				require.True(t, sut.StepCompleted("build"))
+				require.False(t, sut.StepCompleted("stage-artifacts"))
+			},
+			shouldError: false,
+		},
+		{ // ReadCheckpoint fails
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(nil, err)
+			},
+			shouldError: true,
+		},
+		{ // checkpoint options do not match
+			resume: true,
+			prepare: func(mock *anagofakes.FakeStageImpl) {
+				mock.ReadCheckpointReturns(&anago.Checkpoint{
+					ReleaseType:   release.ReleaseTypeRC,
+					ReleaseBranch: "release-1.20",
+				}, nil)
+			},
+			shouldError: true,
+		},
+	} {
+		opts := anago.DefaultStageOptions()
+		opts.Resume = tc.resume
+		sut := anago.NewDefaultStage(opts)
+		sut.SetState(anago.DefaultStageState())
+
+		mock := &anagofakes.FakeStageImpl{}
+		tc.prepare(mock)
+		sut.SetImpl(mock)
+
+		err := sut.InitCheckpoint()
+		if tc.shouldError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			tc.assert(sut, mock)
+		}
+	}
+}
+
+func TestSaveCheckpointStage(t *testing.T) {
+	opts := anago.DefaultStageOptions()
+	sut := anago.NewDefaultStage(opts)
+	sut.SetState(
+		generateTestingStageState(&testStateParameters{versionsTag: &testVersionTag}),
+	)
+
+	mock := &anagofakes.FakeStageImpl{}
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.SaveCheckpoint("build"))
+	require.True(t, sut.StepCompleted("build"))
+	require.Equal(t, 1, mock.WriteCheckpointCallCount())
+
+	_, checkpoint := mock.WriteCheckpointArgsForCall(0)
+	require.Equal(t, []string{"build"}, checkpoint.CompletedSteps)
+	require.Equal(t, testVersionTag, checkpoint.Versions.Official())
+	require.Equal(t, opts.ReleaseBranch, checkpoint.ReleaseBranch)
+
+	mock.WriteCheckpointReturns(err)
+	require.Error(t, sut.SaveCheckpoint("stage-artifacts"))
+}
+
+func TestRemoveCheckpointStage(t *testing.T) {
+	sut := anago.NewDefaultStage(anago.DefaultStageOptions())
+	mock := &anagofakes.FakeStageImpl{}
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.RemoveCheckpoint())
+	require.Equal(t, 1, mock.RemoveCheckpointCallCount())
+	require.Contains(t, mock.RemoveCheckpointArgsForCall(0), "stage-checkpoint.json")
+
+	mock.RemoveCheckpointReturns(err)
+	require.Error(t, sut.RemoveCheckpoint())
+}
+
+func TestWriteReportStage(t *testing.T) {
+	for _, tc := range []struct {
+		prepare       func(*anago.DefaultStage, *anagofakes.FakeStageImpl)
+		withArtifacts bool
+		imageDigests  map[string]string
+		shouldError   bool
+	}{
+		{ // success without build
+			prepare: func(*anago.DefaultStage, *anagofakes.FakeStageImpl) {},
+		},
+		{ // success with artifacts
+			prepare: func(sut *anago.DefaultStage, _ *anagofakes.FakeStageImpl) {
+				require.NoError(t, sut.SaveCheckpoint(anago.StepBuild))
+			},
+			withArtifacts: true,
+		},
+		{ // success with image digests of the pushed images
+			prepare: func(sut *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				mock.GenerateAttestationReturns(provenance.NewSLSAStatement(), nil)
+				mock.PushContainerImagesReturns(map[string]string{
+					"registry.k8s.io/kube-apiserver:v1.20.0": "sha256:123",
+				}, nil)
+				require.NoError(t, sut.SaveCheckpoint(anago.StepBuild))
+				require.NoError(t, sut.StageArtifacts())
+				require.NoError(t, sut.SaveCheckpoint(anago.StepStageArtifacts))
+			},
+			withArtifacts: true,
+			imageDigests: map[string]string{
+				"registry.k8s.io/kube-apiserver:v1.20.0": "sha256:123",
+			},
+		},
+		{ // collecting artifacts fails
+			prepare: func(sut *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				require.NoError(t, sut.SaveCheckpoint(anago.StepBuild))
+				mock.ListBinariesReturns(nil, err)
+			},
+		},
+		{ // WriteRunReport fails
+			prepare: func(_ *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				mock.WriteRunReportReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // PushReleaseArtifacts fails
+			prepare: func(_ *anago.DefaultStage, mock *anagofakes.FakeStageImpl) {
+				mock.PushReleaseArtifactsReturns(err)
+			},
+			shouldError: true,
+		},
+	} {
+		opts := anago.DefaultStageOptions()
+		opts.BuildVersion = "v1.20.0-beta.1.203+8f6ffb24df9896"
+		sut := anago.NewDefaultStage(opts)
+		sut.SetState(
+			generateTestingStageState(&testStateParameters{versionsTag: &testVersionTag}),
+		)
+
+		mock := &anagofakes.FakeStageImpl{}
+		sut.SetImpl(mock)
+		tc.prepare(sut, mock)
+		pushes := mock.PushReleaseArtifactsCallCount()
+
+		report := release.NewRunReport(release.RunTypeStage)
+		err := sut.WriteReport(report)
+
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, opts.BuildVersion, report.BuildVersion)
+		require.Equal(t, testVersionTag, report.Versions.Official())
+		require.Equal(t, pushes+1, mock.PushReleaseArtifactsCallCount())
+
+		if tc.withArtifacts {
+			require.Contains(t, report.Artifacts, testVersionTag)
+			require.Equal(t, tc.imageDigests, report.Artifacts[testVersionTag].ImageDigests)
+		} else {
+			require.Empty(t, report.Artifacts)
+		}
+	}
+}
+
 func TestCheckPrerequisitesStage(t *testing.T) {
 	for _, tc := range []struct {
 		prepare     func(*anagofakes.FakeStageImpl)
@@ -517,7 +708,7 @@ func TestStageArtifacts(t *testing.T) {
 		},
 		{ // PushContainerImages fails
 			prepare: func(mock *anagofakes.FakeStageImpl) {
-				mock.PushContainerImagesReturns(err)
+				mock.PushContainerImagesReturns(nil, err)
 			},
 			shouldError: true,
 		},
@@ -576,6 +767,23 @@ func TestStageArtifacts(t *testing.T) {
 	}
 }
 
+func TestStageArtifactsMaxImageWorkers(t *testing.T) {
+	opts := anago.DefaultStageOptions()
+	opts.MaxImageWorkers = 10
+	sut := anago.NewDefaultStage(opts)
+	sut.SetState(
+		generateTestingStageState(&testStateParameters{versionsTag: &testVersionTag}),
+	)
+
+	mock := &anagofakes.FakeStageImpl{}
+	mock.GenerateAttestationReturns(provenance.NewSLSAStatement(), nil)
+	sut.SetImpl(mock)
+
+	require.NoError(t, sut.StageArtifacts())
+	require.Equal(t, 1, mock.PushContainerImagesCallCount())
+	require.Equal(t, 10, mock.PushContainerImagesArgsForCall(0).MaxImageWorkers)
+}
+
 func TestSubmitStageImpl(t *testing.T) {
 	for _, tc := range []struct {
 		prepare     func(*anagofakes.FakeStageImpl)
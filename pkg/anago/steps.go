@@ -0,0 +1,272 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-utils/log"
+	"sigs.k8s.io/release-utils/version"
+
+	"k8s.io/release/pkg/release"
+)
+
+// Names of the default stage and release steps.
+const (
+	StepValidateOptions         = "validate-options"
+	StepCheckPrerequisites      = "check-prerequisites"
+	StepCheckReleaseBranchState = "check-release-branch-state"
+	StepGenerateReleaseVersion  = "generate-release-version"
+	StepPrepareWorkspace        = "prepare-workspace"
+	StepTagRepository           = "tag-repository"
+	StepBuild                   = "build"
+	StepGenerateChangelog       = "generate-changelog"
+	StepVerifyArtifacts         = "verify-artifacts"
+	StepGenerateBillOfMaterials = "generate-bill-of-materials"
+	StepStageArtifacts          = "stage-artifacts"
+	StepCheckProvenance         = "check-provenance"
+	StepCreateAnnouncement      = "create-announcement"
+	StepPushArtifacts           = "push-artifacts"
+	StepPushGitObjects          = "push-git-objects"
+	StepUpdateGitHubPage        = "update-github-page"
+)
+
+// Step is a single named unit of work of the stage or release process.
+type Step struct {
+	// Name is the unique identifier of the step.
+	Name string
+
+	// Description is logged when the step starts.
+	Description string
+
+	// DependsOn contains the names of the steps which have to be completed
+	// before this step can run.
+	DependsOn []string
+
+	// Idempotent steps run on every invocation, even if they have been
+	// completed by a previous run. They are not recorded in the checkpoint.
+	Idempotent bool
+
+	// Run executes the step.
+	Run func() error
+}
+
+// Steps is an ordered registry of steps.
+type Steps struct {
+	steps []*Step
+}
+
+// NewSteps creates a new `Steps` registry from the provided steps.
+func NewSteps(steps ...*Step) (*Steps, error) {
+	s := &Steps{}
+	for _, step := range steps {
+		if err := s.Add(step); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// List returns all registered steps in execution order.
+func (s *Steps) List() []*Step {
+	return slices.Clone(s.steps)
+}
+
+// Names returns the names of all registered steps in execution order.
+func (s *Steps) Names() []string {
+	names := make([]string, 0, len(s.steps))
+	for _, step := range s.steps {
+		names = append(names, step.Name)
+	}
+
+	return names
+}
+
+// Add appends a step to the end of the registry.
+func (s *Steps) Add(step *Step) error {
+	return s.insert(len(s.steps), step)
+}
+
+// InsertBefore adds a step right before the step with the provided name.
+func (s *Steps) InsertBefore(name string, step *Step) error {
+	i := s.index(name)
+	if i < 0 {
+		return fmt.Errorf("step %s not found", name)
+	}
+
+	return s.insert(i, step)
+}
+
+// InsertAfter adds a step right after the step with the provided name.
+func (s *Steps) InsertAfter(name string, step *Step) error {
+	i := s.index(name)
+	if i < 0 {
+		return fmt.Errorf("step %s not found", name)
+	}
+
+	return s.insert(i+1, step)
+}
+
+func (s *Steps) index(name string) int {
+	return slices.IndexFunc(s.steps, func(step *Step) bool {
+		return step.Name == name
+	})
+}
+
+func (s *Steps) insert(i int, step *Step) error {
+	if step.Name == "" {
+		return errors.New("step name is empty")
+	}
+
+	if step.Run == nil {
+		return fmt.Errorf("step %s has no run function", step.Name)
+	}
+
+	if s.index(step.Name) >= 0 {
+		return fmt.Errorf("step %s already registered", step.Name)
+	}
+
+	// Dependencies have to be registered before the step
+	for _, dep := range step.DependsOn {
+		j := s.index(dep)
+		if j < 0 || j >= i {
+			return fmt.Errorf(
+				"dependency %s of step %s has to be registered before it",
+				dep, step.Name,
+			)
+		}
+	}
+
+	s.steps = slices.Insert(s.steps, i, step)
+
+	return nil
+}
+
+// Filter returns the steps selected by `only` and not excluded by `skip`.
+// Both lists are optional, while an empty `only` selects all steps.
+func (s *Steps) Filter(only, skip []string) ([]*Step, error) {
+	for _, name := range slices.Concat(only, skip) {
+		if s.index(name) < 0 {
+			return nil, fmt.Errorf(
+				"unknown step %q, available steps are: %v", name, s.Names(),
+			)
+		}
+	}
+
+	res := []*Step{}
+
+	for _, step := range s.steps {
+		if len(only) > 0 && !slices.Contains(only, step.Name) {
+			continue
+		}
+
+		if slices.Contains(skip, step.Name) {
+			continue
+		}
+
+		res = append(res, step)
+	}
+
+	return res, nil
+}
+
+// run executes the selected steps in order. Non idempotent steps which have
+// already been completed are skipped and every other successful step gets
+// checkpointed. The checkpoint gets removed once all steps are completed, so
+// that a later resumed run does not skip them. The outcome of each step is
+// recorded in the report.
+func (s *Steps) run(
+	c checkpointer, report *release.RunReport, only, skip []string,
+) error {
+	selected, err := s.Filter(only, skip)
+	if err != nil {
+		return fmt.Errorf("filter steps: %w", err)
+	}
+
+	logger := log.NewStepLogger(uint(len(selected))) //nolint:gosec // length is never negative
+	v := version.GetVersionInfo()
+	logger.Infof("Using krel version: %s", v.GitVersion)
+	report.KrelVersion = v.GitVersion
+
+	finished := map[string]bool{}
+
+	for _, step := range selected {
+		logger.WithStep().Info(step.Description)
+
+		for _, dep := range step.DependsOn {
+			if !finished[dep] && !c.StepCompleted(dep) {
+				return fmt.Errorf(
+					"step %s depends on %s, which has not been completed",
+					step.Name, dep,
+				)
+			}
+		}
+
+		stepReport := report.StartStep(step.Name)
+
+		if !step.Idempotent && c.StepCompleted(step.Name) {
+			logrus.Infof("Skipping step %s which has been completed previously", step.Name)
+			stepReport.Finish(release.StepOutcomeSkipped, nil)
+
+			continue
+		}
+
+		if err := step.Run(); err != nil {
+			stepReport.Finish(release.StepOutcomeFailed, err)
+
+			return fmt.Errorf("%s: %w", step.Name, err)
+		}
+
+		stepReport.Finish(release.StepOutcomeSucceeded, nil)
+		finished[step.Name] = true
+
+		if step.Idempotent {
+			continue
+		}
+
+		if err := c.SaveCheckpoint(step.Name); err != nil {
+			return fmt.Errorf("save checkpoint after %s: %w", step.Name, err)
+		}
+	}
+
+	for _, step := range s.steps {
+		if !step.Idempotent && !finished[step.Name] && !c.StepCompleted(step.Name) {
+			logrus.Infof("Keeping checkpoint, step %s has not been completed", step.Name)
+
+			return nil
+		}
+	}
+
+	if err := c.RemoveCheckpoint(); err != nil {
+		return fmt.Errorf("remove checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// checkpointer is the part of the stage and release clients used to persist
+// the progress of a run.
+type checkpointer interface {
+	StepCompleted(step string) bool
+	SaveCheckpoint(step string) error
+	RemoveCheckpoint() error
+}
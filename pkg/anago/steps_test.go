@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anago_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/anago"
+	"k8s.io/release/pkg/anago/anagofakes"
+)
+
+func noop() error { return nil }
+
+func TestStepsRegistry(t *testing.T) {
+	steps, err := anago.NewSteps(
+		&anago.Step{Name: "a", Run: noop},
+		&anago.Step{Name: "c", DependsOn: []string{"a"}, Run: noop},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, steps.InsertAfter("a", &anago.Step{
+		Name: "b", DependsOn: []string{"a"}, Run: noop,
+	}))
+	require.NoError(t, steps.InsertBefore("a", &anago.Step{Name: "0", Run: noop}))
+	require.Equal(t, []string{"0", "a", "b", "c"}, steps.Names())
+
+	// Duplicate names
+	require.Error(t, steps.Add(&anago.Step{Name: "a", Run: noop}))
+
+	// Dependency registered after the step
+	require.Error(t, steps.InsertBefore("a", &anago.Step{
+		Name: "x", DependsOn: []string{"c"}, Run: noop,
+	}))
+
+	// Unknown dependency, anchor and missing run function
+	require.Error(t, steps.Add(&anago.Step{
+		Name: "x", DependsOn: []string{"unknown"}, Run: noop,
+	}))
+	require.Error(t, steps.InsertAfter("unknown", &anago.Step{Name: "x", Run: noop}))
+	require.Error(t, steps.Add(&anago.Step{Name: "x"}))
+	require.Error(t, steps.Add(&anago.Step{Run: noop}))
+}
+
+func TestStepsFilter(t *testing.T) {
+	steps, err := anago.NewSteps(
+		&anago.Step{Name: "a", Run: noop},
+		&anago.Step{Name: "b", Run: noop},
+		&anago.Step{Name: "c", Run: noop},
+	)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		only, skip  []string
+		expected    []string
+		shouldError bool
+	}{
+		{ // all steps
+			expected: []string{"a", "b", "c"},
+		},
+		{ // only steps
+			only:     []string{"c", "a"},
+			expected: []string{"a", "c"},
+		},
+		{ // skip steps
+			skip:     []string{"b"},
+			expected: []string{"a", "c"},
+		},
+		{ // only and skip steps
+			only:     []string{"a", "b"},
+			skip:     []string{"a"},
+			expected: []string{"b"},
+		},
+		{ // unknown step
+			skip:        []string{"d"},
+			shouldError: true,
+		},
+	} {
+		res, err := steps.Filter(tc.only, tc.skip)
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, step := range res {
+			names = append(names, step.Name)
+		}
+
+		require.Equal(t, tc.expected, names)
+	}
+}
+
+func TestRunStageSteps(t *testing.T) {
+	for _, tc := range []struct {
+		prepare     func(*anago.StageOptions, *anago.Stage, *anagofakes.FakeStageClient)
+		assert      func(*anagofakes.FakeStageClient)
+		shouldError bool
+	}{
+		{ // custom step
+			prepare: func(_ *anago.StageOptions, sut *anago.Stage, mock *anagofakes.FakeStageClient) {
+				require.NoError(t, sut.Steps().InsertAfter(anago.StepBuild, &anago.Step{
+					Name:      "scan",
+					DependsOn: []string{anago.StepBuild},
+					Run:       func() error { return mock.Submit(false) },
+				}))
+			},
+			assert: func(mock *anagofakes.FakeStageClient) {
+				require.Equal(t, 1, mock.SubmitCallCount())
+				require.Equal(t, "scan", mock.SaveCheckpointArgsForCall(4))
+				require.Equal(t, 1, mock.RemoveCheckpointCallCount())
+			},
+		},
+		{ // all steps completed previously
+			prepare: func(_ *anago.StageOptions, _ *anago.Stage, mock *anagofakes.FakeStageClient) {
+				mock.StepCompletedReturns(true)
+			},
+			assert: func(mock *anagofakes.FakeStageClient) {
+				require.Zero(t, mock.BuildCallCount())
+				require.Zero(t, mock.SaveCheckpointCallCount())
+				require.Equal(t, 1, mock.RemoveCheckpointCallCount())
+			},
+		},
+		{ // RemoveCheckpoint fails
+			prepare: func(_ *anago.StageOptions, _ *anago.Stage, mock *anagofakes.FakeStageClient) {
+				mock.RemoveCheckpointReturns(err)
+			},
+			shouldError: true,
+		},
+		{ // custom step fails
+			prepare: func(_ *anago.StageOptions, sut *anago.Stage, _ *anagofakes.FakeStageClient) {
+				require.NoError(t, sut.Steps().Add(&anago.Step{
+					Name: "scan",
+					Run:  func() error { return err },
+				}))
+			},
+			shouldError: true,
+		},
+		{ // skip steps
+			prepare: func(opts *anago.StageOptions, _ *anago.Stage, _ *anagofakes.FakeStageClient) {
+				opts.SkipSteps = []string{anago.StepVerifyArtifacts, anago.StepStageArtifacts}
+			},
+			assert: func(mock *anagofakes.FakeStageClient) {
+				require.Zero(t, mock.VerifyArtifactsCallCount())
+				require.Zero(t, mock.StageArtifactsCallCount())
+				require.Equal(t, 1, mock.GenerateBillOfMaterialsCallCount())
+				require.Zero(t, mock.RemoveCheckpointCallCount())
+			},
+		},
+		{ // only steps with dependencies completed previously
+			prepare: func(opts *anago.StageOptions, _ *anago.Stage, mock *anagofakes.FakeStageClient) {
+				opts.OnlySteps = []string{anago.StepGenerateChangelog}
+				mock.StepCompletedCalls(func(step string) bool {
+					return step == anago.StepBuild
+				})
+			},
+			assert: func(mock *anagofakes.FakeStageClient) {
+				require.Equal(t, 1, mock.GenerateChangelogCallCount())
+				require.Zero(t, mock.BuildCallCount())
+				require.Zero(t, mock.ValidateOptionsCallCount())
+				require.Zero(t, mock.RemoveCheckpointCallCount())
+			},
+		},
+		{ // only steps with missing dependencies
+			prepare: func(opts *anago.StageOptions, _ *anago.Stage, _ *anagofakes.FakeStageClient) {
+				opts.OnlySteps = []string{anago.StepBuild}
+			},
+			shouldError: true,
+		},
+		{ // unknown step
+			prepare: func(opts *anago.StageOptions, _ *anago.Stage, _ *anagofakes.FakeStageClient) {
+				opts.OnlySteps = []string{"unknown"}
+			},
+			shouldError: true,
+		},
+	} {
+		opts := anago.DefaultStageOptions()
+		sut := anago.NewStage(opts)
+		mock := &anagofakes.FakeStageClient{}
+		sut.SetClient(mock)
+		tc.prepare(opts, sut, mock)
+
+		err := sut.Run()
+		if tc.shouldError {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			tc.assert(mock)
+		}
+	}
+}
+
+func TestReleaseSteps(t *testing.T) {
+	sut := anago.NewRelease(anago.DefaultReleaseOptions())
+	require.Equal(t, []string{
+		anago.StepValidateOptions,
+		anago.StepCheckPrerequisites,
+		anago.StepCheckReleaseBranchState,
+		anago.StepGenerateReleaseVersion,
+		anago.StepPrepareWorkspace,
+		anago.StepCheckProvenance,
+		anago.StepCreateAnnouncement,
+		anago.StepPushArtifacts,
+		anago.StepPushGitObjects,
+		anago.StepUpdateGitHubPage,
+	}, sut.Steps().Names())
+}
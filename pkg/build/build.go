@@ -21,8 +21,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/object"
-
+	"k8s.io/release/pkg/objectstore"
 	"k8s.io/release/pkg/release"
 )
 
@@ -31,7 +30,7 @@ var DefaultExtraVersionMarkers = []string{}
 // Instance is the main structure for creating and pushing builds.
 type Instance struct {
 	opts     *Options
-	objStore object.GCS
+	objStore *objectstore.Store
 }
 
 // NewInstance can be used to create a new build `Instance`.
@@ -39,7 +38,7 @@ type Instance struct {
 func NewInstance(opts *Options) *Instance {
 	instance := &Instance{
 		opts:     opts,
-		objStore: *object.NewGCS(),
+		objStore: objectstore.New(),
 	}
 
 	instance.setBuildType()
@@ -52,6 +51,8 @@ func NewInstance(opts *Options) *Instance {
 // Options are the main options to pass to `Instance`.
 type Options struct {
 	// Specify an alternate bucket for pushes (normally 'devel' or 'ci').
+	// Buckets prefixed with `file://` are directories on the local file
+	// system, while `s3://` refers to S3 compatible object stores.
 	Bucket string
 
 	// Specify an alternate build directory (relative to RepoRoot). Will be automatically determined
@@ -121,6 +122,10 @@ type Options struct {
 	// Validate that the remote image digests exists.
 	ValidateRemoteImageDigests bool
 
+	// The maximum amount of container images to be pushed or validated in
+	// parallel. Defaults to `release.DefaultMaxImageWorkers` if not set.
+	MaxImageWorkers int
+
 	// Stage additional files defined by `ExtraGcpStageFiles` and
 	// `ExtraWindowsStageFiles`, otherwise they will be skipped.
 	StageExtraFiles bool
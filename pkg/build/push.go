@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/release-utils/tar"
 	"sigs.k8s.io/release-utils/util"
 
+	"k8s.io/release/pkg/objectstore"
 	"k8s.io/release/pkg/release"
 )
 
@@ -249,6 +250,14 @@ func setupBuildDir(bi *Instance) {
 func (bi *Instance) CheckReleaseBucket() error {
 	logrus.Infof("Checking bucket %s for write permissions", bi.opts.Bucket)
 
+	if !objectstore.IsGCS(bi.opts.Bucket) {
+		if err := bi.objStore.CheckBucket(bi.opts.Bucket); err != nil {
+			return fmt.Errorf("check bucket: %w", err)
+		}
+
+		return nil
+	}
+
 	client, err := storage.NewClient(context.Background())
 	if err != nil {
 		return fmt.Errorf(
@@ -428,35 +437,49 @@ func (bi *Instance) PushReleaseArtifacts(srcPath, gcsPath string) error {
 // `Registry`. It also validates if the remove manifests are correct,
 // which can be turned of by setting `ValidateRemoteImageDigests` to `false`.
 func (bi *Instance) PushContainerImages() error {
+	_, err := bi.PublishContainerImages()
+
+	return err
+}
+
+// PublishContainerImages works like PushContainerImages, but returns the
+// digests of the published images.
+func (bi *Instance) PublishContainerImages() (map[string]string, error) {
 	if bi.opts.Registry == "" {
 		logrus.Info("Registry is not set, will not publish container images")
 
-		return nil
+		return nil, nil
 	}
 
 	images := release.NewImages()
+	images.SetMaxWorkers(bi.opts.MaxImageWorkers)
 
 	logrus.Infof("Publishing container images for %s", bi.opts.Version)
 
-	if err := images.Publish(
+	digests, err := images.Publish(
 		bi.opts.Registry, bi.opts.Version, bi.opts.BuildDir,
-	); err != nil {
-		return fmt.Errorf("publish container images: %w", err)
+	)
+	if err != nil {
+		return nil, fmt.Errorf("publish container images: %w", err)
+	}
+
+	for image, digest := range digests {
+		logrus.Infof("Published %s@%s", image, digest)
 	}
 
 	if !bi.opts.ValidateRemoteImageDigests {
 		logrus.Info("Will not validate remote image digests")
 
-		return nil
+		return digests, nil
 	}
 
 	if err := images.Validate(
 		bi.opts.Registry, bi.opts.Version, bi.opts.BuildDir,
 	); err != nil {
-		return fmt.Errorf("validate container images: %w", err)
+		return nil, fmt.Errorf("validate container images: %w", err)
 	}
 
-	return nil
+	return digests, nil
 }
 
 // CopyStagedFromGCS copies artifacts from GCS and between buckets as needed.
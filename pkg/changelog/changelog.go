@@ -30,7 +30,6 @@ import (
 
 	"sigs.k8s.io/release-sdk/git"
 	"sigs.k8s.io/release-sdk/github"
-	"sigs.k8s.io/release-utils/util"
 
 	"k8s.io/release/pkg/notes/options"
 )
@@ -50,6 +49,10 @@ type Options struct {
 	CVEDataDir   string
 	CloneCVEMaps bool
 	Dependencies bool
+
+	// Profile contains the repository conventions, defaults to the
+	// kubernetes/kubernetes profile if not set.
+	Profile *Profile
 }
 
 // Changelog can be used to generate the changelog for a release.
@@ -60,6 +63,10 @@ type Changelog struct {
 
 // New creates a new Changelog instance.
 func New(opts *Options) *Changelog {
+	if opts.Profile == nil {
+		opts.Profile = DefaultProfile()
+	}
+
 	return &Changelog{
 		options: opts,
 		impl:    &defaultImpl{},
@@ -73,7 +80,9 @@ func (c *Changelog) SetImpl(impl impl) {
 
 // Run starts the changelog generation.
 func (c *Changelog) Run() error {
-	tag, err := c.TagStringToSemver(c.options.Tag)
+	profile := c.options.Profile
+
+	tag, err := c.TagStringToSemver(profile.TrimTagPrefix(c.options.Tag))
 	if err != nil {
 		return fmt.Errorf("parse tag %s: %w", c.options.Tag, err)
 	}
@@ -81,7 +90,10 @@ func (c *Changelog) Run() error {
 	// Automatically set the branch to a release branch if not provided
 	branch := c.options.Branch
 	if branch == "" {
-		branch = fmt.Sprintf("release-%d.%d", tag.Major, tag.Minor)
+		branch, err = profile.Branch(tag)
+		if err != nil {
+			return fmt.Errorf("get release branch: %w", err)
+		}
 	}
 
 	logrus.Infof("Using release branch %s", branch)
@@ -110,32 +122,40 @@ func (c *Changelog) Run() error {
 
 	if tag.Patch == 0 {
 		if len(tag.Pre) == 0 { //nolint:gocritic // a switch case would not make it better
-			// Still create the downloads table
-			downloadsTable := &bytes.Buffer{}
-			startTag := util.SemverToTagString(semver.Version{
-				Major: tag.Major, Minor: tag.Minor - 1, Patch: 0,
-			})
+			startRev, err = c.previousMinorTag(repo, tag)
+			if err != nil {
+				return err
+			}
 
-			startRev = startTag
 			endRev = head
 
-			if err := c.CreateDownloadsTable(
-				downloadsTable, c.options.Bucket, c.options.Tars,
-				c.options.Images, startRev, c.options.Tag,
-			); err != nil {
-				return fmt.Errorf("create downloads table: %w", err)
-			}
+			// New final minor versions should have remote release notes, if the
+			// profile provides a draft source. Generated release notes already
+			// contain the downloads table.
+			if profile.DraftSource == "" {
+				markdown, jsonStr, err = c.generateReleaseNotes(branch, startRev, endRev)
+			} else {
+				// Still create the downloads table
+				downloadsTable := &bytes.Buffer{}
+				if err := c.CreateDownloadsTable(
+					downloadsTable, c.options.Bucket, c.options.Tars,
+					c.options.Images, startRev, c.options.Tag,
+				); err != nil {
+					return fmt.Errorf("create downloads table: %w", err)
+				}
 
-			// New final minor versions should have remote release notes
-			markdown, jsonStr, err = c.lookupRemoteReleaseNotes(branch)
-			markdown = downloadsTable.String() + markdown
+				markdown, jsonStr, err = c.lookupRemoteReleaseNotes(branch)
+				markdown = downloadsTable.String() + markdown
+			}
 		} else if tag.Pre[0].String() == "alpha" && tag.Pre[1].VersionNum == 1 {
 			// v1.x.0-alpha.1 releases use the previous minor as start commit.
 			// Those are usually the first releases being cut on master after
 			// the previous final has been released.
-			startRev = util.SemverToTagString(semver.Version{
-				Major: tag.Major, Minor: tag.Minor - 1, Patch: 0,
-			})
+			startRev, err = c.previousMinorTag(repo, tag)
+			if err != nil {
+				return err
+			}
+
 			logrus.Infof("Using previous minor %s as start tag", startRev)
 
 			// The end tag does not yet exist which means that we stick to
@@ -149,11 +169,21 @@ func (c *Changelog) Run() error {
 			if c.options.ReplayDir != "" {
 				// Do not access the API on replay
 				latestTags = github.TagsPerBranch{branch: c.options.Tag}
-			} else {
+			} else if profile.IsKubernetes() {
 				latestTags, err = c.LatestGitHubTagsPerBranch()
 				if err != nil {
 					return fmt.Errorf("get latest GitHub tags: %w", err)
 				}
+			} else {
+				tags, err := c.Tags(repo)
+				if err != nil {
+					return fmt.Errorf("get repository tags: %w", err)
+				}
+
+				latestTags, err = profile.LatestTagsPerBranch(tags)
+				if err != nil {
+					return fmt.Errorf("get latest tags: %w", err)
+				}
 			}
 
 			if startTag, ok := latestTags[branch]; ok {
@@ -182,7 +212,7 @@ func (c *Changelog) Run() error {
 		}
 
 		// A patch version, let’s just use the previous patch
-		startTag := util.SemverToTagString(semver.Version{
+		startTag := profile.TagString(semver.Version{
 			Major: tag.Major, Minor: tag.Minor, Patch: tag.Patch - 1,
 		})
 
@@ -199,12 +229,23 @@ func (c *Changelog) Run() error {
 	if c.options.Dependencies {
 		logrus.Info("Generating dependency changes")
 
-		deps, err := c.DependencyChanges(startRev, endRev)
+		deps, err := c.DependencyChanges(
+			git.GetRepoURL(profile.GithubOrg, profile.GithubRepo, false), startRev, endRev,
+		)
 		if err != nil {
 			return fmt.Errorf("generate dependency changes: %w", err)
 		}
 
 		markdown += strings.Repeat(nl, 2) + deps
+
+		report, err := c.DependencyReport(c.options.RepoPath, startRev, endRev)
+		if err != nil {
+			return fmt.Errorf("generate dependency report: %w", err)
+		}
+
+		if licenseChanges := report.LicenseChangesMarkdown(); licenseChanges != "" {
+			markdown = strings.TrimRight(markdown, nl) + strings.Repeat(nl, 2) + licenseChanges
+		}
 	}
 
 	logrus.Info("Generating TOC")
@@ -228,10 +269,10 @@ func (c *Changelog) Run() error {
 		}()
 	}
 
-	logrus.Infof("Checking out %s branch", git.DefaultBranch)
+	logrus.Infof("Checking out %s branch", profile.MainBranch)
 
-	if err := c.Checkout(repo, git.DefaultBranch); err != nil {
-		return fmt.Errorf("checkout %s branch: %w", git.DefaultBranch, err)
+	if err := c.Checkout(repo, profile.MainBranch); err != nil {
+		return fmt.Errorf("checkout %s branch: %w", profile.MainBranch, err)
 	}
 
 	logrus.Info("Writing markdown")
@@ -261,6 +302,29 @@ func (c *Changelog) Run() error {
 	return nil
 }
 
+// previousMinorTag returns the tag of the previous minor release, which is
+// the start revision of new minor releases. The repository tags are only
+// required for the first minor release of a new major version.
+func (c *Changelog) previousMinorTag(repo *git.Repo, tag semver.Version) (string, error) {
+	tags := []string{}
+
+	if tag.Minor == 0 {
+		repoTags, err := c.Tags(repo)
+		if err != nil {
+			return "", fmt.Errorf("get repository tags: %w", err)
+		}
+
+		tags = repoTags
+	}
+
+	previous, err := c.options.Profile.PreviousMinorTag(tag, tags)
+	if err != nil {
+		return "", fmt.Errorf("get previous minor release: %w", err)
+	}
+
+	return previous, nil
+}
+
 func (c *Changelog) generateReleaseNotes(
 	branch, startRev, endRev string,
 ) (markdown, jsonStr string, err error) {
@@ -271,6 +335,8 @@ func (c *Changelog) generateReleaseNotes(
 	notesOptions.StartRev = startRev
 	notesOptions.EndSHA = endRev
 	notesOptions.RepoPath = c.options.RepoPath
+	notesOptions.GithubOrg = c.options.Profile.GithubOrg
+	notesOptions.GithubRepo = c.options.Profile.GithubRepo
 	notesOptions.ReleaseBucket = c.options.Bucket
 	notesOptions.ReleaseTars = c.options.Tars
 	notesOptions.Debug = logrus.StandardLogger().Level >= logrus.DebugLevel
@@ -318,10 +384,12 @@ func (c *Changelog) generateReleaseNotes(
 func (c *Changelog) writeMarkdown(
 	repo *git.Repo, toc, markdown string, tag semver.Version,
 ) error {
-	changelogPath := filepath.Join(
-		c.RepoDir(repo),
-		markdownChangelogFilename(tag),
-	)
+	changelogFilename, err := c.options.Profile.MarkdownChangelogFilename(tag)
+	if err != nil {
+		return fmt.Errorf("get changelog file name: %w", err)
+	}
+
+	changelogPath := filepath.Join(c.RepoDir(repo), changelogFilename)
 	writeFile := func(t, m string) error {
 		return c.WriteFile(
 			changelogPath,
@@ -373,32 +441,20 @@ func (c *Changelog) writeMarkdown(
 	return nil
 }
 
-func (c *Changelog) htmlChangelogFilename(tag semver.Version) string {
+func (c *Changelog) htmlChangelogFilename(tag semver.Version) (string, error) {
 	if c.options.HTMLFile != "" {
-		return c.options.HTMLFile
+		return c.options.HTMLFile, nil
 	}
 
-	return changelogFilename(tag, "html")
+	return c.options.Profile.ChangelogFilename(tag, "html")
 }
 
-func (c *Changelog) jsonChangelogFilename(tag semver.Version) string {
+func (c *Changelog) jsonChangelogFilename(tag semver.Version) (string, error) {
 	if c.options.JSONFile != "" {
-		return c.options.JSONFile
+		return c.options.JSONFile, nil
 	}
 
-	return changelogFilename(tag, "json")
-}
-
-func markdownChangelogReadme() string {
-	return filepath.Join(RepoChangelogDir, "README.md")
-}
-
-func markdownChangelogFilename(tag semver.Version) string {
-	return filepath.Join(RepoChangelogDir, changelogFilename(tag, "md"))
-}
-
-func changelogFilename(tag semver.Version, ext string) string {
-	return fmt.Sprintf("CHANGELOG-%d.%d.%s", tag.Major, tag.Minor, ext)
+	return c.options.Profile.ChangelogFilename(tag, "json")
 }
 
 func addTocMarkers(toc string) string {
@@ -419,11 +475,16 @@ func (c *Changelog) writeHTML(tag semver.Version, markdown string) error {
 	output := bytes.Buffer{}
 	if err := c.TemplateExecute(t, &output, struct {
 		Title, Content string
-	}{util.SemverToTagString(tag), content.String()}); err != nil {
+	}{c.options.Profile.TagString(tag), content.String()}); err != nil {
 		return fmt.Errorf("execute HTML template: %w", err)
 	}
 
-	absOutputPath, err := c.Abs(c.htmlChangelogFilename(tag))
+	filename, err := c.htmlChangelogFilename(tag)
+	if err != nil {
+		return fmt.Errorf("get HTML file name: %w", err)
+	}
+
+	absOutputPath, err := c.Abs(filename)
 	if err != nil {
 		return fmt.Errorf("get absolute file path: %w", err)
 	}
@@ -438,7 +499,12 @@ func (c *Changelog) writeHTML(tag semver.Version, markdown string) error {
 }
 
 func (c *Changelog) writeJSON(tag semver.Version, jsonStr string) error {
-	absOutputPath, err := c.Abs(c.jsonChangelogFilename(tag))
+	filename, err := c.jsonChangelogFilename(tag)
+	if err != nil {
+		return fmt.Errorf("get JSON file name: %w", err)
+	}
+
+	absOutputPath, err := c.Abs(filename)
 	if err != nil {
 		return fmt.Errorf("get absolute file path: %w", err)
 	}
@@ -457,10 +523,10 @@ func (c *Changelog) lookupRemoteReleaseNotes(
 ) (markdownStr, jsonStr string, err error) {
 	logrus.Info("Assuming new minor release, fetching remote release notes")
 
-	remoteBase := fmt.Sprintf(
-		"https://raw.githubusercontent.com/kubernetes/sig-release/%s/"+
-			"releases/%s/release-notes/", git.DefaultBranch, branch,
-	)
+	remoteBase, err := c.options.Profile.DraftURL(branch)
+	if err != nil {
+		return "", "", fmt.Errorf("get release notes draft URL: %w", err)
+	}
 
 	// Retrieve the markdown version
 	remoteMarkdown := remoteBase + "release-notes-draft.md"
@@ -493,13 +559,17 @@ func (c *Changelog) lookupRemoteReleaseNotes(
 func (c *Changelog) commitChanges(
 	repo *git.Repo, branch string, tag semver.Version,
 ) error {
+	profile := c.options.Profile
+
 	// main branch modifications
-	releaseChangelog := markdownChangelogFilename(tag)
-	changelogReadme := markdownChangelogReadme()
+	releaseChangelog, err := profile.MarkdownChangelogFilename(tag)
+	if err != nil {
+		return fmt.Errorf("get changelog file name: %w", err)
+	}
 
-	changelogFiles := []string{
-		releaseChangelog,
-		changelogReadme,
+	changelogFiles := []string{releaseChangelog}
+	if changelogReadme := profile.MarkdownChangelogReadme(); changelogReadme != "" {
+		changelogFiles = append(changelogFiles, changelogReadme)
 	}
 
 	for _, filename := range changelogFiles {
@@ -513,12 +583,12 @@ func (c *Changelog) commitChanges(
 	logrus.Info("Committing changes to main branch in repository")
 
 	if err := c.Commit(repo, fmt.Sprintf(
-		"CHANGELOG: Update directory for %s release", util.SemverToTagString(tag),
+		"CHANGELOG: Update directory for %s release", profile.TagString(tag),
 	)); err != nil {
 		return fmt.Errorf("committing changes into repository: %w", err)
 	}
 
-	if branch != git.DefaultBranch {
+	if branch != profile.MainBranch {
 		logrus.Infof("Checking out %s branch", branch)
 		// Release branch modifications
 		if err := c.Checkout(repo, branch); err != nil {
@@ -527,7 +597,11 @@ func (c *Changelog) commitChanges(
 
 		// Remove all other changelog files if we’re on the the first official release
 		if tag.Patch == 0 && len(tag.Pre) == 0 {
-			pattern := filepath.Join(RepoChangelogDir, "CHANGELOG-*.md")
+			pattern, err := profile.MarkdownChangelogGlob()
+			if err != nil {
+				return fmt.Errorf("get changelog files pattern: %w", err)
+			}
+
 			logrus.Infof("Removing unnecessary %s files", pattern)
 
 			if err := c.Rm(repo, true, pattern); err != nil {
@@ -538,7 +612,7 @@ func (c *Changelog) commitChanges(
 		logrus.Info("Checking out changelog from main branch")
 
 		if err := c.Checkout(
-			repo, git.DefaultBranch, releaseChangelog,
+			repo, profile.MainBranch, releaseChangelog,
 		); err != nil {
 			return fmt.Errorf("check out main branch changelog: %w", err)
 		}
@@ -546,7 +620,7 @@ func (c *Changelog) commitChanges(
 		logrus.Info("Committing changes to release branch in repository")
 
 		if err := c.Commit(repo, fmt.Sprintf(
-			"Update %s for %s", releaseChangelog, util.SemverToTagString(tag),
+			"Update %s for %s", releaseChangelog, profile.TagString(tag),
 		)); err != nil {
 			return fmt.Errorf("committing changes into repository: %w", err)
 		}
@@ -558,14 +632,22 @@ func (c *Changelog) commitChanges(
 func (c *Changelog) adaptChangelogReadmeFile(
 	repo *git.Repo, tag semver.Version,
 ) error {
-	targetFile := filepath.Join(repo.Dir(), RepoChangelogDir, "README.md")
+	changelogReadme := c.options.Profile.MarkdownChangelogReadme()
+	if changelogReadme == "" {
+		return nil
+	}
+
+	targetFile := filepath.Join(repo.Dir(), changelogReadme)
 
 	readme, err := c.ReadFile(targetFile)
 	if err != nil {
-		return fmt.Errorf("read changelog README.md: %w", err)
+		return fmt.Errorf("read changelog %s: %w", changelogReadme, err)
 	}
 
-	cf := filepath.Base(markdownChangelogFilename(tag))
+	cf, err := c.options.Profile.ChangelogFilename(tag, "md")
+	if err != nil {
+		return fmt.Errorf("get changelog file name: %w", err)
+	}
 
 	const listPrefix = "- "
 
@@ -587,7 +669,7 @@ func (c *Changelog) adaptChangelogReadmeFile(
 
 	if err := c.WriteFile(
 		targetFile, []byte(strings.Join(res, nl)+nl), os.FileMode(0o644)); err != nil {
-		return fmt.Errorf("write changelog README.md: %w", err)
+		return fmt.Errorf("write changelog %s: %w", changelogReadme, err)
 	}
 
 	return nil
@@ -35,6 +35,7 @@ func TestRun(t *testing.T) {
 
 	for _, tc := range []struct {
 		prepare   func(*changelogfakes.FakeImpl, *changelog.Options)
+		assert    func(*changelogfakes.FakeImpl)
 		shouldErr bool
 	}{
 		{ // success new official
@@ -46,6 +47,8 @@ func TestRun(t *testing.T) {
 		{ // success new first alpha
 			prepare: func(mock *changelogfakes.FakeImpl, _ *changelog.Options) {
 				mock.TagStringToSemverReturns(semver.Version{
+					Major: 1,
+					Minor: 19,
 					Pre: []semver.PRVersion{
 						{VersionStr: "alpha"},
 						{VersionNum: 1},
@@ -86,6 +89,104 @@ func TestRun(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{ // success custom profile new pre release
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{
+					Major: 0,
+					Minor: 5,
+					Pre: []semver.PRVersion{
+						{VersionStr: "rc"},
+						{VersionNum: 1},
+					},
+				}, nil)
+				mock.TagsReturns([]string{"app-0.5.0-rc.0", "app-0.4.0", "v0.5.0"}, nil)
+				mock.ReadFileReturns([]byte(changelog.TocEnd), nil)
+				mock.GatherReleaseNotesReturns(&notes.ReleaseNotes{}, nil)
+			},
+			shouldErr: false,
+		},
+		{ // success custom profile new official without draft source
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{Major: 0, Minor: 5}, nil)
+				mock.ReadFileReturns([]byte(changelog.TocEnd), nil)
+				mock.GatherReleaseNotesReturns(&notes.ReleaseNotes{}, nil)
+			},
+			assert: func(mock *changelogfakes.FakeImpl) {
+				// Generated release notes already contain the downloads table
+				require.Zero(t, mock.CreateDownloadsTableCallCount())
+				require.Zero(t, mock.TagsCallCount())
+			},
+			shouldErr: false,
+		},
+		{ // success custom profile new major
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{Major: 2}, nil)
+				mock.TagsReturns([]string{
+					"app-1.4.0", "app-1.5.0", "app-1.5.1", "app-1.6.0-rc.0", "app-2.0.0-rc.0",
+				}, nil)
+				mock.ReadFileReturns([]byte(changelog.TocEnd), nil)
+				mock.GatherReleaseNotesReturns(&notes.ReleaseNotes{}, nil)
+			},
+			assert: func(mock *changelogfakes.FakeImpl) {
+				_, startRev, _ := mock.NewDocumentArgsForCall(0)
+				require.Equal(t, "app-1.5.0", startRev)
+			},
+			shouldErr: false,
+		},
+		{ // success custom profile new major first alpha
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{
+					Major: 2,
+					Pre: []semver.PRVersion{
+						{VersionStr: "alpha"},
+						{VersionNum: 1},
+					},
+				}, nil)
+				mock.TagsReturns([]string{"app-1.5.0"}, nil)
+				mock.ReadFileReturns([]byte(changelog.TocEnd), nil)
+				mock.GatherReleaseNotesReturns(&notes.ReleaseNotes{}, nil)
+			},
+			assert: func(mock *changelogfakes.FakeImpl) {
+				_, startRev, _ := mock.NewDocumentArgsForCall(0)
+				require.Equal(t, "app-1.5.0", startRev)
+			},
+			shouldErr: false,
+		},
+		{ // no previous minor release for new major
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{Major: 2}, nil)
+				mock.TagsReturns([]string{"app-2.0.0-rc.0"}, nil)
+			},
+			shouldErr: true,
+		},
+		{ // Tags failed for new major
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{Major: 2}, nil)
+				mock.TagsReturns(nil, err)
+			},
+			shouldErr: true,
+		},
+		{ // Tags failed
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Profile = customProfile()
+				mock.TagStringToSemverReturns(semver.Version{
+					Major: 0,
+					Minor: 5,
+					Pre: []semver.PRVersion{
+						{VersionStr: "rc"},
+						{VersionNum: 1},
+					},
+				}, nil)
+				mock.TagsReturns(nil, err)
+			},
+			shouldErr: true,
+		},
 		{ // TagStringToSemver failed
 			prepare: func(mock *changelogfakes.FakeImpl, _ *changelog.Options) {
 				mock.TagStringToSemverReturns(semver.Version{}, err)
@@ -129,6 +230,26 @@ func TestRun(t *testing.T) {
 			},
 			shouldErr: true,
 		},
+		{ // DependencyReport success with license changes
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Dependencies = true
+				mock.ReadFileReturns([]byte(changelog.TocEnd), nil)
+				mock.DependencyReportReturns(&notes.DependencyReport{
+					Updated: []*notes.DependencyChange{{
+						Module: "github.com/example/module", OldVersion: "v1.0.0", NewVersion: "v2.0.0",
+						OldLicense: "MIT", NewLicense: "Apache-2.0",
+					}},
+				}, nil)
+			},
+			shouldErr: false,
+		},
+		{ // DependencyReport failed
+			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
+				opts.Dependencies = true
+				mock.DependencyReportReturns(nil, err)
+			},
+			shouldErr: true,
+		},
 		{ // CurrentBranch failed
 			prepare: func(mock *changelogfakes.FakeImpl, opts *changelog.Options) {
 				mock.CurrentBranchReturns("", err)
@@ -297,6 +418,7 @@ func TestRun(t *testing.T) {
 		options := &changelog.Options{}
 		sut := changelog.New(options)
 		mock := &changelogfakes.FakeImpl{}
+		mock.TagStringToSemverReturns(semver.Version{Major: 1, Minor: 19}, nil)
 		tc.prepare(mock, options)
 		sut.SetImpl(mock)
 
@@ -306,5 +428,22 @@ func TestRun(t *testing.T) {
 		} else {
 			require.NoError(t, err)
 		}
+
+		if tc.assert != nil {
+			tc.assert(mock)
+		}
 	}
 }
+
+func customProfile() *changelog.Profile {
+	profile := changelog.DefaultProfile()
+	profile.GithubOrg = "example"
+	profile.GithubRepo = "app"
+	profile.TagPrefix = "app-"
+	profile.BranchPattern = "release/{{.Major}}.{{.Minor}}"
+	profile.ChangelogDir = "docs/changelog"
+	profile.ChangelogReadme = ""
+	profile.DraftSource = ""
+
+	return profile
+}
@@ -19,7 +19,7 @@ package changelogfakes
 
 import (
 	"io"
-	"io/fs"
+	"os"
 	"sync"
 	"text/template"
 
@@ -124,11 +124,12 @@ type FakeImpl struct {
 		result1 string
 		result2 error
 	}
-	DependencyChangesStub        func(string, string) (string, error)
+	DependencyChangesStub        func(string, string, string) (string, error)
 	dependencyChangesMutex       sync.RWMutex
 	dependencyChangesArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 string
 	}
 	dependencyChangesReturns struct {
 		result1 string
@@ -138,6 +139,21 @@ type FakeImpl struct {
 		result1 string
 		result2 error
 	}
+	DependencyReportStub        func(string, string, string) (*notes.DependencyReport, error)
+	dependencyReportMutex       sync.RWMutex
+	dependencyReportArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	dependencyReportReturns struct {
+		result1 *notes.DependencyReport
+		result2 error
+	}
+	dependencyReportReturnsOnCall map[int]struct {
+		result1 *notes.DependencyReport
+		result2 error
+	}
 	GatherReleaseNotesStub        func(*options.Options) (*notes.ReleaseNotes, error)
 	gatherReleaseNotesMutex       sync.RWMutex
 	gatherReleaseNotesArgsForCall []struct {
@@ -177,6 +193,30 @@ type FakeImpl struct {
 		result1 string
 		result2 error
 	}
+	GlobStub        func(string) ([]string, error)
+	globMutex       sync.RWMutex
+	globArgsForCall []struct {
+		arg1 string
+	}
+	globReturns struct {
+		result1 []string
+		result2 error
+	}
+	globReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	HeadURLStub        func(string) error
+	headURLMutex       sync.RWMutex
+	headURLArgsForCall []struct {
+		arg1 string
+	}
+	headURLReturns struct {
+		result1 error
+	}
+	headURLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LatestGitHubTagsPerBranchStub        func() (github.TagsPerBranch, error)
 	latestGitHubTagsPerBranchMutex       sync.RWMutex
 	latestGitHubTagsPerBranchArgsForCall []struct {
@@ -325,17 +365,17 @@ type FakeImpl struct {
 	rmReturnsOnCall map[int]struct {
 		result1 error
 	}
-	StatStub        func(string) (fs.FileInfo, error)
+	StatStub        func(string) (os.FileInfo, error)
 	statMutex       sync.RWMutex
 	statArgsForCall []struct {
 		arg1 string
 	}
 	statReturns struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}
 	statReturnsOnCall map[int]struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}
 	TagStringToSemverStub        func(string) (semver.Version, error)
@@ -351,6 +391,19 @@ type FakeImpl struct {
 		result1 semver.Version
 		result2 error
 	}
+	TagsStub        func(*git.Repo) ([]string, error)
+	tagsMutex       sync.RWMutex
+	tagsArgsForCall []struct {
+		arg1 *git.Repo
+	}
+	tagsReturns struct {
+		result1 []string
+		result2 error
+	}
+	tagsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	TemplateExecuteStub        func(*template.Template, io.Writer, interface{}) error
 	templateExecuteMutex       sync.RWMutex
 	templateExecuteArgsForCall []struct {
@@ -375,12 +428,12 @@ type FakeImpl struct {
 	validateAndFinishReturnsOnCall map[int]struct {
 		result1 error
 	}
-	WriteFileStub        func(string, []byte, fs.FileMode) error
+	WriteFileStub        func(string, []byte, os.FileMode) error
 	writeFileMutex       sync.RWMutex
 	writeFileArgsForCall []struct {
 		arg1 string
 		arg2 []byte
-		arg3 fs.FileMode
+		arg3 os.FileMode
 	}
 	writeFileReturns struct {
 		result1 error
@@ -829,19 +882,20 @@ func (fake *FakeImpl) CurrentBranchReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) DependencyChanges(arg1 string, arg2 string) (string, error) {
+func (fake *FakeImpl) DependencyChanges(arg1 string, arg2 string, arg3 string) (string, error) {
 	fake.dependencyChangesMutex.Lock()
 	ret, specificReturn := fake.dependencyChangesReturnsOnCall[len(fake.dependencyChangesArgsForCall)]
 	fake.dependencyChangesArgsForCall = append(fake.dependencyChangesArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
 	stub := fake.DependencyChangesStub
 	fakeReturns := fake.dependencyChangesReturns
-	fake.recordInvocation("DependencyChanges", []interface{}{arg1, arg2})
+	fake.recordInvocation("DependencyChanges", []interface{}{arg1, arg2, arg3})
 	fake.dependencyChangesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -855,17 +909,17 @@ func (fake *FakeImpl) DependencyChangesCallCount() int {
 	return len(fake.dependencyChangesArgsForCall)
 }
 
-func (fake *FakeImpl) DependencyChangesCalls(stub func(string, string) (string, error)) {
+func (fake *FakeImpl) DependencyChangesCalls(stub func(string, string, string) (string, error)) {
 	fake.dependencyChangesMutex.Lock()
 	defer fake.dependencyChangesMutex.Unlock()
 	fake.DependencyChangesStub = stub
 }
 
-func (fake *FakeImpl) DependencyChangesArgsForCall(i int) (string, string) {
+func (fake *FakeImpl) DependencyChangesArgsForCall(i int) (string, string, string) {
 	fake.dependencyChangesMutex.RLock()
 	defer fake.dependencyChangesMutex.RUnlock()
 	argsForCall := fake.dependencyChangesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeImpl) DependencyChangesReturns(result1 string, result2 error) {
@@ -894,6 +948,72 @@ func (fake *FakeImpl) DependencyChangesReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) DependencyReport(arg1 string, arg2 string, arg3 string) (*notes.DependencyReport, error) {
+	fake.dependencyReportMutex.Lock()
+	ret, specificReturn := fake.dependencyReportReturnsOnCall[len(fake.dependencyReportArgsForCall)]
+	fake.dependencyReportArgsForCall = append(fake.dependencyReportArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DependencyReportStub
+	fakeReturns := fake.dependencyReportReturns
+	fake.recordInvocation("DependencyReport", []interface{}{arg1, arg2, arg3})
+	fake.dependencyReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) DependencyReportCallCount() int {
+	fake.dependencyReportMutex.RLock()
+	defer fake.dependencyReportMutex.RUnlock()
+	return len(fake.dependencyReportArgsForCall)
+}
+
+func (fake *FakeImpl) DependencyReportCalls(stub func(string, string, string) (*notes.DependencyReport, error)) {
+	fake.dependencyReportMutex.Lock()
+	defer fake.dependencyReportMutex.Unlock()
+	fake.DependencyReportStub = stub
+}
+
+func (fake *FakeImpl) DependencyReportArgsForCall(i int) (string, string, string) {
+	fake.dependencyReportMutex.RLock()
+	defer fake.dependencyReportMutex.RUnlock()
+	argsForCall := fake.dependencyReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeImpl) DependencyReportReturns(result1 *notes.DependencyReport, result2 error) {
+	fake.dependencyReportMutex.Lock()
+	defer fake.dependencyReportMutex.Unlock()
+	fake.DependencyReportStub = nil
+	fake.dependencyReportReturns = struct {
+		result1 *notes.DependencyReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) DependencyReportReturnsOnCall(i int, result1 *notes.DependencyReport, result2 error) {
+	fake.dependencyReportMutex.Lock()
+	defer fake.dependencyReportMutex.Unlock()
+	fake.DependencyReportStub = nil
+	if fake.dependencyReportReturnsOnCall == nil {
+		fake.dependencyReportReturnsOnCall = make(map[int]struct {
+			result1 *notes.DependencyReport
+			result2 error
+		})
+	}
+	fake.dependencyReportReturnsOnCall[i] = struct {
+		result1 *notes.DependencyReport
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) GatherReleaseNotes(arg1 *options.Options) (*notes.ReleaseNotes, error) {
 	fake.gatherReleaseNotesMutex.Lock()
 	ret, specificReturn := fake.gatherReleaseNotesReturnsOnCall[len(fake.gatherReleaseNotesArgsForCall)]
@@ -1086,6 +1206,131 @@ func (fake *FakeImpl) GetURLResponseReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) Glob(arg1 string) ([]string, error) {
+	fake.globMutex.Lock()
+	ret, specificReturn := fake.globReturnsOnCall[len(fake.globArgsForCall)]
+	fake.globArgsForCall = append(fake.globArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GlobStub
+	fakeReturns := fake.globReturns
+	fake.recordInvocation("Glob", []interface{}{arg1})
+	fake.globMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) GlobCallCount() int {
+	fake.globMutex.RLock()
+	defer fake.globMutex.RUnlock()
+	return len(fake.globArgsForCall)
+}
+
+func (fake *FakeImpl) GlobCalls(stub func(string) ([]string, error)) {
+	fake.globMutex.Lock()
+	defer fake.globMutex.Unlock()
+	fake.GlobStub = stub
+}
+
+func (fake *FakeImpl) GlobArgsForCall(i int) string {
+	fake.globMutex.RLock()
+	defer fake.globMutex.RUnlock()
+	argsForCall := fake.globArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) GlobReturns(result1 []string, result2 error) {
+	fake.globMutex.Lock()
+	defer fake.globMutex.Unlock()
+	fake.GlobStub = nil
+	fake.globReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) GlobReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.globMutex.Lock()
+	defer fake.globMutex.Unlock()
+	fake.GlobStub = nil
+	if fake.globReturnsOnCall == nil {
+		fake.globReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.globReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) HeadURL(arg1 string) error {
+	fake.headURLMutex.Lock()
+	ret, specificReturn := fake.headURLReturnsOnCall[len(fake.headURLArgsForCall)]
+	fake.headURLArgsForCall = append(fake.headURLArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.HeadURLStub
+	fakeReturns := fake.headURLReturns
+	fake.recordInvocation("HeadURL", []interface{}{arg1})
+	fake.headURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImpl) HeadURLCallCount() int {
+	fake.headURLMutex.RLock()
+	defer fake.headURLMutex.RUnlock()
+	return len(fake.headURLArgsForCall)
+}
+
+func (fake *FakeImpl) HeadURLCalls(stub func(string) error) {
+	fake.headURLMutex.Lock()
+	defer fake.headURLMutex.Unlock()
+	fake.HeadURLStub = stub
+}
+
+func (fake *FakeImpl) HeadURLArgsForCall(i int) string {
+	fake.headURLMutex.RLock()
+	defer fake.headURLMutex.RUnlock()
+	argsForCall := fake.headURLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) HeadURLReturns(result1 error) {
+	fake.headURLMutex.Lock()
+	defer fake.headURLMutex.Unlock()
+	fake.HeadURLStub = nil
+	fake.headURLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImpl) HeadURLReturnsOnCall(i int, result1 error) {
+	fake.headURLMutex.Lock()
+	defer fake.headURLMutex.Unlock()
+	fake.HeadURLStub = nil
+	if fake.headURLReturnsOnCall == nil {
+		fake.headURLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.headURLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImpl) LatestGitHubTagsPerBranch() (github.TagsPerBranch, error) {
 	fake.latestGitHubTagsPerBranchMutex.Lock()
 	ret, specificReturn := fake.latestGitHubTagsPerBranchReturnsOnCall[len(fake.latestGitHubTagsPerBranchArgsForCall)]
@@ -1785,7 +2030,7 @@ func (fake *FakeImpl) RmReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) Stat(arg1 string) (fs.FileInfo, error) {
+func (fake *FakeImpl) Stat(arg1 string) (os.FileInfo, error) {
 	fake.statMutex.Lock()
 	ret, specificReturn := fake.statReturnsOnCall[len(fake.statArgsForCall)]
 	fake.statArgsForCall = append(fake.statArgsForCall, struct {
@@ -1810,7 +2055,7 @@ func (fake *FakeImpl) StatCallCount() int {
 	return len(fake.statArgsForCall)
 }
 
-func (fake *FakeImpl) StatCalls(stub func(string) (fs.FileInfo, error)) {
+func (fake *FakeImpl) StatCalls(stub func(string) (os.FileInfo, error)) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = stub
@@ -1823,28 +2068,28 @@ func (fake *FakeImpl) StatArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeImpl) StatReturns(result1 fs.FileInfo, result2 error) {
+func (fake *FakeImpl) StatReturns(result1 os.FileInfo, result2 error) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = nil
 	fake.statReturns = struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeImpl) StatReturnsOnCall(i int, result1 fs.FileInfo, result2 error) {
+func (fake *FakeImpl) StatReturnsOnCall(i int, result1 os.FileInfo, result2 error) {
 	fake.statMutex.Lock()
 	defer fake.statMutex.Unlock()
 	fake.StatStub = nil
 	if fake.statReturnsOnCall == nil {
 		fake.statReturnsOnCall = make(map[int]struct {
-			result1 fs.FileInfo
+			result1 os.FileInfo
 			result2 error
 		})
 	}
 	fake.statReturnsOnCall[i] = struct {
-		result1 fs.FileInfo
+		result1 os.FileInfo
 		result2 error
 	}{result1, result2}
 }
@@ -1913,6 +2158,70 @@ func (fake *FakeImpl) TagStringToSemverReturnsOnCall(i int, result1 semver.Versi
 	}{result1, result2}
 }
 
+func (fake *FakeImpl) Tags(arg1 *git.Repo) ([]string, error) {
+	fake.tagsMutex.Lock()
+	ret, specificReturn := fake.tagsReturnsOnCall[len(fake.tagsArgsForCall)]
+	fake.tagsArgsForCall = append(fake.tagsArgsForCall, struct {
+		arg1 *git.Repo
+	}{arg1})
+	stub := fake.TagsStub
+	fakeReturns := fake.tagsReturns
+	fake.recordInvocation("Tags", []interface{}{arg1})
+	fake.tagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImpl) TagsCallCount() int {
+	fake.tagsMutex.RLock()
+	defer fake.tagsMutex.RUnlock()
+	return len(fake.tagsArgsForCall)
+}
+
+func (fake *FakeImpl) TagsCalls(stub func(*git.Repo) ([]string, error)) {
+	fake.tagsMutex.Lock()
+	defer fake.tagsMutex.Unlock()
+	fake.TagsStub = stub
+}
+
+func (fake *FakeImpl) TagsArgsForCall(i int) *git.Repo {
+	fake.tagsMutex.RLock()
+	defer fake.tagsMutex.RUnlock()
+	argsForCall := fake.tagsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeImpl) TagsReturns(result1 []string, result2 error) {
+	fake.tagsMutex.Lock()
+	defer fake.tagsMutex.Unlock()
+	fake.TagsStub = nil
+	fake.tagsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImpl) TagsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.tagsMutex.Lock()
+	defer fake.tagsMutex.Unlock()
+	fake.TagsStub = nil
+	if fake.tagsReturnsOnCall == nil {
+		fake.tagsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.tagsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImpl) TemplateExecute(arg1 *template.Template, arg2 io.Writer, arg3 interface{}) error {
 	fake.templateExecuteMutex.Lock()
 	ret, specificReturn := fake.templateExecuteReturnsOnCall[len(fake.templateExecuteArgsForCall)]
@@ -2037,7 +2346,7 @@ func (fake *FakeImpl) ValidateAndFinishReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 fs.FileMode) error {
+func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 os.FileMode) error {
 	var arg2Copy []byte
 	if arg2 != nil {
 		arg2Copy = make([]byte, len(arg2))
@@ -2048,7 +2357,7 @@ func (fake *FakeImpl) WriteFile(arg1 string, arg2 []byte, arg3 fs.FileMode) erro
 	fake.writeFileArgsForCall = append(fake.writeFileArgsForCall, struct {
 		arg1 string
 		arg2 []byte
-		arg3 fs.FileMode
+		arg3 os.FileMode
 	}{arg1, arg2Copy, arg3})
 	stub := fake.WriteFileStub
 	fakeReturns := fake.writeFileReturns
@@ -2069,13 +2378,13 @@ func (fake *FakeImpl) WriteFileCallCount() int {
 	return len(fake.writeFileArgsForCall)
 }
 
-func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, fs.FileMode) error) {
+func (fake *FakeImpl) WriteFileCalls(stub func(string, []byte, os.FileMode) error) {
 	fake.writeFileMutex.Lock()
 	defer fake.writeFileMutex.Unlock()
 	fake.WriteFileStub = stub
 }
 
-func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, fs.FileMode) {
+func (fake *FakeImpl) WriteFileArgsForCall(i int) (string, []byte, os.FileMode) {
 	fake.writeFileMutex.RLock()
 	defer fake.writeFileMutex.RUnlock()
 	argsForCall := fake.writeFileArgsForCall[i]
@@ -2124,12 +2433,18 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.currentBranchMutex.RUnlock()
 	fake.dependencyChangesMutex.RLock()
 	defer fake.dependencyChangesMutex.RUnlock()
+	fake.dependencyReportMutex.RLock()
+	defer fake.dependencyReportMutex.RUnlock()
 	fake.gatherReleaseNotesMutex.RLock()
 	defer fake.gatherReleaseNotesMutex.RUnlock()
 	fake.generateTOCMutex.RLock()
 	defer fake.generateTOCMutex.RUnlock()
 	fake.getURLResponseMutex.RLock()
 	defer fake.getURLResponseMutex.RUnlock()
+	fake.globMutex.RLock()
+	defer fake.globMutex.RUnlock()
+	fake.headURLMutex.RLock()
+	defer fake.headURLMutex.RUnlock()
 	fake.latestGitHubTagsPerBranchMutex.RLock()
 	defer fake.latestGitHubTagsPerBranchMutex.RUnlock()
 	fake.markdownToHTMLMutex.RLock()
@@ -2156,6 +2471,8 @@ func (fake *FakeImpl) Invocations() map[string][][]interface{} {
 	defer fake.statMutex.RUnlock()
 	fake.tagStringToSemverMutex.RLock()
 	defer fake.tagStringToSemverMutex.RUnlock()
+	fake.tagsMutex.RLock()
+	defer fake.tagsMutex.RUnlock()
 	fake.templateExecuteMutex.RLock()
 	defer fake.templateExecuteMutex.RUnlock()
 	fake.validateAndFinishMutex.RLock()
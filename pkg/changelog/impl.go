@@ -58,8 +58,10 @@ type impl interface {
 	) error
 	LatestGitHubTagsPerBranch() (github.TagsPerBranch, error)
 	GenerateTOC(markdown string) (string, error)
-	DependencyChanges(from, to string) (string, error)
+	DependencyChanges(url, from, to string) (string, error)
+	DependencyReport(repoPath, from, to string) (*notes.DependencyReport, error)
 	Checkout(repo *git.Repo, rev string, args ...string) error
+	Tags(repo *git.Repo) ([]string, error)
 
 	// Used in `generateReleaseNotes()`
 	ValidateAndFinish(opts *options.Options) error
@@ -95,6 +97,10 @@ type impl interface {
 	Commit(repo *git.Repo, msg string) error
 	Rm(repo *git.Repo, force bool, files ...string) error
 	CloneCVEData() (cveDir string, err error)
+
+	// Used in `Verifier.Verify()`
+	Glob(pattern string) ([]string, error)
+	HeadURL(url string) error
 }
 
 type defaultImpl struct{}
@@ -137,14 +143,22 @@ func (*defaultImpl) GenerateTOC(markdown string) (string, error) {
 	})
 }
 
-func (*defaultImpl) DependencyChanges(from, to string) (string, error) {
-	return notes.NewDependencies().Changes(from, to)
+func (*defaultImpl) DependencyChanges(url, from, to string) (string, error) {
+	return notes.NewDependencies().ChangesForURL(url, from, to)
+}
+
+func (*defaultImpl) DependencyReport(repoPath, from, to string) (*notes.DependencyReport, error) {
+	return notes.NewDependencies().Report(repoPath, from, to)
 }
 
 func (*defaultImpl) Checkout(repo *git.Repo, rev string, args ...string) error {
 	return repo.Checkout(rev, args...)
 }
 
+func (*defaultImpl) Tags(repo *git.Repo) ([]string, error) {
+	return repo.Tags()
+}
+
 func (*defaultImpl) ValidateAndFinish(opts *options.Options) error {
 	return opts.ValidateAndFinish()
 }
@@ -216,6 +230,16 @@ func (*defaultImpl) GetURLResponse(url string) (string, error) {
 	return string(content), nil
 }
 
+func (*defaultImpl) HeadURL(url string) error {
+	_, err := http.NewAgent().WithFailOnHTTPError(true).Head(url)
+
+	return err
+}
+
+func (*defaultImpl) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
 func (*defaultImpl) Add(repo *git.Repo, filename string) error {
 	return repo.Add(filename)
 }
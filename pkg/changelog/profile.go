@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/git"
+	"sigs.k8s.io/release-sdk/github"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultBranchPattern is the release branch pattern of Kubernetes.
+	DefaultBranchPattern = "release-{{.Major}}.{{.Minor}}"
+
+	// DefaultChangelogFilePattern is the changelog file pattern of Kubernetes
+	// without the file extension.
+	DefaultChangelogFilePattern = "CHANGELOG-{{.Major}}.{{.Minor}}"
+
+	// DefaultDraftSource is the location of the release notes drafts of
+	// Kubernetes in kubernetes/sig-release.
+	DefaultDraftSource = "https://raw.githubusercontent.com/kubernetes/sig-release/master/" +
+		"releases/{{.Branch}}/release-notes/"
+)
+
+// Profile contains the repository conventions used for generating the
+// changelog. The default profile matches kubernetes/kubernetes, a custom one
+// can be loaded from a YAML file to use the changelog for any repository using
+// the same release notes pipeline.
+//
+// The patterns are Go templates, which can access the `.Major`, `.Minor` and
+// `.Patch` version of the release. The draft source can access the release
+// `.Branch`.
+type Profile struct {
+	// GithubOrg is the GitHub organization of the repository.
+	GithubOrg string `json:"githubOrg"`
+
+	// GithubRepo is the GitHub repository name.
+	GithubRepo string `json:"githubRepo"`
+
+	// TagPrefix is the prefix of the release tags before the semantic
+	// version, like `v` for `v1.30.0`.
+	TagPrefix string `json:"tagPrefix"`
+
+	// MainBranch is the development branch of the repository, which
+	// contains the changelogs of all releases.
+	MainBranch string `json:"mainBranch"`
+
+	// BranchPattern is the pattern of the release branches.
+	BranchPattern string `json:"branchPattern"`
+
+	// ChangelogDir is the directory of the changelog files in the
+	// repository.
+	ChangelogDir string `json:"changelogDir"`
+
+	// ChangelogFilePattern is the pattern of the changelog file names
+	// without extension, which is used for the markdown, HTML and JSON
+	// output.
+	ChangelogFilePattern string `json:"changelogFilePattern"`
+
+	// ChangelogReadme is the file inside the changelog directory which lists
+	// all changelog files. An empty value disables the update of the file.
+	ChangelogReadme string `json:"changelogReadme"`
+
+	// DraftSource is the base URL of the release notes drafts
+	// `release-notes-draft.md` and `release-notes-draft.json`, which are
+	// used for new minor releases. An empty value generates the notes of new
+	// minor releases from the previous minor release.
+	DraftSource string `json:"draftSource"`
+}
+
+// DefaultProfile returns the profile for kubernetes/kubernetes.
+func DefaultProfile() *Profile {
+	return &Profile{
+		GithubOrg:            git.DefaultGithubOrg,
+		GithubRepo:           git.DefaultGithubRepo,
+		TagPrefix:            "v",
+		MainBranch:           git.DefaultBranch,
+		BranchPattern:        DefaultBranchPattern,
+		ChangelogDir:         RepoChangelogDir,
+		ChangelogFilePattern: DefaultChangelogFilePattern,
+		ChangelogReadme:      "README.md",
+		DraftSource:          DefaultDraftSource,
+	}
+}
+
+// LoadProfile reads a profile from a YAML file. Fields which are not set in
+// the file keep the values of the default profile.
+func LoadProfile(path string) (*Profile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read profile: %w", err)
+	}
+
+	profile := DefaultProfile()
+	if err := yaml.UnmarshalStrict(content, profile); err != nil {
+		return nil, fmt.Errorf("unmarshal profile %s: %w", path, err)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("validate profile %s: %w", path, err)
+	}
+
+	return profile, nil
+}
+
+// Validate checks if all required fields are set and the patterns are valid.
+func (p *Profile) Validate() error {
+	for _, field := range []struct{ name, value string }{
+		{"githubOrg", p.GithubOrg},
+		{"githubRepo", p.GithubRepo},
+		{"mainBranch", p.MainBranch},
+		{"branchPattern", p.BranchPattern},
+		{"changelogFilePattern", p.ChangelogFilePattern},
+	} {
+		if field.value == "" {
+			return fmt.Errorf("%s must not be empty", field.name)
+		}
+	}
+
+	for _, pattern := range []string{p.BranchPattern, p.ChangelogFilePattern, p.DraftSource} {
+		if _, err := executePattern(pattern, patternData{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsKubernetes returns true if the profile targets kubernetes/kubernetes.
+func (p *Profile) IsKubernetes() bool {
+	return p.GithubOrg == git.DefaultGithubOrg && p.GithubRepo == git.DefaultGithubRepo
+}
+
+// patternData is the data passed to the profile patterns.
+type patternData struct {
+	Major, Minor, Patch, Branch string
+}
+
+func newPatternData(tag semver.Version) patternData {
+	return patternData{
+		Major: strconv.FormatUint(tag.Major, 10),
+		Minor: strconv.FormatUint(tag.Minor, 10),
+		Patch: strconv.FormatUint(tag.Patch, 10),
+	}
+}
+
+func executePattern(pattern string, data patternData) (string, error) {
+	tpl, err := template.New("pattern").Option("missingkey=error").Parse(pattern)
+	if err != nil {
+		return "", fmt.Errorf("parse pattern %q: %w", pattern, err)
+	}
+
+	res := &bytes.Buffer{}
+	if err := tpl.Execute(res, data); err != nil {
+		return "", fmt.Errorf("execute pattern %q: %w", pattern, err)
+	}
+
+	return res.String(), nil
+}
+
+// TagString converts a version into a tag name.
+func (p *Profile) TagString(tag semver.Version) string {
+	return p.TagPrefix + tag.String()
+}
+
+// TrimTagPrefix removes the tag prefix from a tag name. The result can be
+// parsed as semantic version.
+func (p *Profile) TrimTagPrefix(tag string) string {
+	return strings.TrimPrefix(tag, p.TagPrefix)
+}
+
+// Branch returns the release branch of a version.
+func (p *Profile) Branch(tag semver.Version) (string, error) {
+	return executePattern(p.BranchPattern, newPatternData(tag))
+}
+
+// ChangelogFilename returns the changelog file name of a version with the
+// provided extension.
+func (p *Profile) ChangelogFilename(tag semver.Version, ext string) (string, error) {
+	name, err := executePattern(p.ChangelogFilePattern, newPatternData(tag))
+	if err != nil {
+		return "", err
+	}
+
+	return name + "." + ext, nil
+}
+
+// MarkdownChangelogFilename returns the path of the markdown changelog of a
+// version relative to the repository root.
+func (p *Profile) MarkdownChangelogFilename(tag semver.Version) (string, error) {
+	name, err := p.ChangelogFilename(tag, "md")
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.ChangelogDir, name), nil
+}
+
+// MarkdownChangelogGlob returns the pattern matching the markdown changelogs
+// of all versions relative to the repository root.
+func (p *Profile) MarkdownChangelogGlob() (string, error) {
+	name, err := executePattern(p.ChangelogFilePattern, patternData{Major: "*", Minor: "*", Patch: "*"})
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(p.ChangelogDir, name+".md"), nil
+}
+
+// MarkdownChangelogReadme returns the path of the changelog readme relative
+// to the repository root or an empty string if disabled.
+func (p *Profile) MarkdownChangelogReadme() string {
+	if p.ChangelogReadme == "" {
+		return ""
+	}
+
+	return filepath.Join(p.ChangelogDir, p.ChangelogReadme)
+}
+
+// DraftURL returns the base URL of the release notes drafts for a release
+// branch or an empty string if no draft source is configured.
+func (p *Profile) DraftURL(branch string) (string, error) {
+	if p.DraftSource == "" {
+		return "", nil
+	}
+
+	return executePattern(p.DraftSource, patternData{Branch: branch})
+}
+
+// LatestTagsPerBranch returns the latest tag for each branch from a list of
+// tags, following the same association as
+// github.GitHub.LatestGitHubTagsPerBranch:
+//
+// - x.y.0-alpha.z and x.y.0-beta.z releases are only associated with the main
+// branch
+// - x.y.0-rc.z releases are only associated with their release branch
+// - x.y.0 final releases are associated with the main and the release branch.
+func (p *Profile) LatestTagsPerBranch(tags []string) (github.TagsPerBranch, error) {
+	versions := p.versions(tags)
+
+	releases := github.TagsPerBranch{}
+	add := func(branch string, version semver.Version) {
+		if _, ok := releases[branch]; !ok {
+			releases[branch] = p.TagString(version)
+		}
+	}
+
+	for _, version := range versions {
+		tag := p.TagString(version)
+		if strings.Contains(tag, "beta") || strings.Contains(tag, "alpha") {
+			add(p.MainBranch, version)
+
+			continue
+		}
+
+		if len(version.Pre) == 0 {
+			add(p.MainBranch, version)
+		}
+
+		branch, err := p.Branch(version)
+		if err != nil {
+			return nil, fmt.Errorf("get branch of %s: %w", tag, err)
+		}
+
+		add(branch, version)
+	}
+
+	return releases, nil
+}
+
+// PreviousMinorTag returns the tag of the minor release preceding the
+// provided version. The first minor release of a new major version is
+// preceded by the latest final minor release of the tags.
+func (p *Profile) PreviousMinorTag(version semver.Version, tags []string) (string, error) {
+	if version.Minor > 0 {
+		return p.TagString(semver.Version{
+			Major: version.Major, Minor: version.Minor - 1, Patch: 0,
+		}), nil
+	}
+
+	for _, previous := range p.versions(tags) {
+		if len(previous.Pre) == 0 && previous.Patch == 0 && previous.LT(version) {
+			return p.TagString(previous), nil
+		}
+	}
+
+	return "", fmt.Errorf("no previous minor release found for %s", p.TagString(version))
+}
+
+// versions returns the semantic versions of all tags using the tag prefix,
+// sorted from the highest to the lowest version.
+func (p *Profile) versions(tags []string) []semver.Version {
+	versions := []semver.Version{}
+
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, p.TagPrefix) {
+			continue
+		}
+
+		version, err := semver.Parse(p.TrimTagPrefix(tag))
+		if err != nil {
+			logrus.Debugf("Skipping tag %s because it is not valid semver", tag)
+
+			continue
+		}
+
+		versions = append(versions, version)
+	}
+
+	slices.SortFunc(versions, func(a, b semver.Version) int {
+		return b.Compare(a)
+	})
+
+	return versions
+}
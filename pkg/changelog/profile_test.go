@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/release-sdk/github"
+
+	"k8s.io/release/pkg/changelog"
+)
+
+func TestDefaultProfile(t *testing.T) {
+	t.Parallel()
+
+	profile := changelog.DefaultProfile()
+	require.NoError(t, profile.Validate())
+	require.True(t, profile.IsKubernetes())
+
+	tag := semver.MustParse("1.30.2")
+	require.Equal(t, "v1.30.2", profile.TagString(tag))
+	require.Equal(t, "1.30.2", profile.TrimTagPrefix("v1.30.2"))
+
+	branch, err := profile.Branch(tag)
+	require.NoError(t, err)
+	require.Equal(t, "release-1.30", branch)
+
+	filename, err := profile.MarkdownChangelogFilename(tag)
+	require.NoError(t, err)
+	require.Equal(t, "CHANGELOG/CHANGELOG-1.30.md", filename)
+
+	filename, err = profile.ChangelogFilename(tag, "json")
+	require.NoError(t, err)
+	require.Equal(t, "CHANGELOG-1.30.json", filename)
+
+	glob, err := profile.MarkdownChangelogGlob()
+	require.NoError(t, err)
+	require.Equal(t, "CHANGELOG/CHANGELOG-*.*.md", glob)
+	require.Equal(t, "CHANGELOG/README.md", profile.MarkdownChangelogReadme())
+
+	url, err := profile.DraftURL("release-1.30")
+	require.NoError(t, err)
+	require.Equal(t,
+		"https://raw.githubusercontent.com/kubernetes/sig-release/master/releases/release-1.30/release-notes/",
+		url,
+	)
+}
+
+func TestLoadProfile(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		content   string
+		shouldErr bool
+		assert    func(*changelog.Profile)
+	}{
+		{
+			name: "custom profile",
+			content: `githubOrg: example
+githubRepo: app
+tagPrefix: app-
+mainBranch: main
+branchPattern: release/{{.Major}}.{{.Minor}}.x
+changelogDir: docs/changelog
+changelogFilePattern: "{{.Major}}.{{.Minor}}"
+changelogReadme: ""
+draftSource: ""
+`,
+			assert: func(profile *changelog.Profile) {
+				require.False(t, profile.IsKubernetes())
+
+				tag := semver.MustParse("0.5.1")
+				require.Equal(t, "app-0.5.1", profile.TagString(tag))
+
+				branch, err := profile.Branch(tag)
+				require.NoError(t, err)
+				require.Equal(t, "release/0.5.x", branch)
+
+				filename, err := profile.MarkdownChangelogFilename(tag)
+				require.NoError(t, err)
+				require.Equal(t, "docs/changelog/0.5.md", filename)
+				require.Empty(t, profile.MarkdownChangelogReadme())
+
+				url, err := profile.DraftURL(branch)
+				require.NoError(t, err)
+				require.Empty(t, url)
+			},
+		},
+		{
+			name:    "partial profile keeps defaults",
+			content: "githubOrg: example\ngithubRepo: app\n",
+			assert: func(profile *changelog.Profile) {
+				defaults := changelog.DefaultProfile()
+				require.Equal(t, "example", profile.GithubOrg)
+				require.Equal(t, defaults.BranchPattern, profile.BranchPattern)
+				require.Equal(t, defaults.DraftSource, profile.DraftSource)
+			},
+		},
+		{
+			name:      "unknown field",
+			content:   "githubOrganization: example\n",
+			shouldErr: true,
+		},
+		{
+			name:      "empty required field",
+			content:   "mainBranch: \"\"\n",
+			shouldErr: true,
+		},
+		{
+			name:      "invalid pattern",
+			content:   "branchPattern: release-{{.Major\n",
+			shouldErr: true,
+		},
+		{
+			name:      "unknown pattern field",
+			content:   "branchPattern: release-{{.Version}}\n",
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "profile.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			profile, err := changelog.LoadProfile(path)
+			if tc.shouldErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tc.assert(profile)
+		})
+	}
+
+	_, err := changelog.LoadProfile(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestLatestTagsPerBranch(t *testing.T) {
+	t.Parallel()
+
+	profile := changelog.DefaultProfile()
+	profile.TagPrefix = "app-"
+	profile.MainBranch = "main"
+
+	res, err := profile.LatestTagsPerBranch([]string{
+		"app-0.4.0",
+		"app-0.4.1",
+		"app-0.5.0-alpha.1",
+		"app-0.5.0-beta.0",
+		"app-0.5.0-rc.0",
+		"app-0.6.0-alpha.0",
+		"app-invalid",
+		"v1.0.0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, github.TagsPerBranch{
+		"main":        "app-0.6.0-alpha.0",
+		"release-0.5": "app-0.5.0-rc.0",
+		"release-0.4": "app-0.4.1",
+	}, res)
+
+	res, err = profile.LatestTagsPerBranch(nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestPreviousMinorTag(t *testing.T) {
+	t.Parallel()
+
+	profile := changelog.DefaultProfile()
+	profile.TagPrefix = "app-"
+
+	tags := []string{
+		"app-1.4.0",
+		"app-1.5.0",
+		"app-1.5.1",
+		"app-1.6.0-rc.0",
+		"app-2.0.0-rc.0",
+		"app-invalid",
+		"v1.7.0",
+	}
+
+	for _, tc := range []struct {
+		version   string
+		tags      []string
+		expected  string
+		shouldErr bool
+	}{
+		{version: "1.31.0", expected: "app-1.30.0"},
+		{version: "1.31.0-alpha.1", expected: "app-1.30.0"},
+		{version: "2.0.0", tags: tags, expected: "app-1.5.0"},
+		{version: "2.0.0-alpha.1", tags: tags, expected: "app-1.5.0"},
+		{version: "1.0.0", tags: tags, shouldErr: true},
+		{version: "2.0.0", shouldErr: true},
+	} {
+		res, err := profile.PreviousMinorTag(semver.MustParse(tc.version), tc.tags)
+		if tc.shouldErr {
+			require.Error(t, err, tc.version)
+
+			continue
+		}
+
+		require.NoError(t, err, tc.version)
+		require.Equal(t, tc.expected, res, tc.version)
+	}
+}
@@ -0,0 +1,548 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/git"
+)
+
+// IssueType is the kind of drift found by the changelog verification.
+type IssueType string
+
+const (
+	// IssueMissingTOCMarker indicates that the table of contents start or
+	// end marker is missing, duplicated or in the wrong order.
+	IssueMissingTOCMarker IssueType = "missing-toc-marker"
+
+	// IssueOutdatedTOC indicates that the table of contents does not match
+	// the headings of the changelog.
+	IssueOutdatedTOC IssueType = "outdated-toc"
+
+	// IssueMissingChangelog indicates that a released minor version has no
+	// changelog file.
+	IssueMissingChangelog IssueType = "missing-changelog"
+
+	// IssueMissingHeading indicates that a released tag has no heading in
+	// its changelog file.
+	IssueMissingHeading IssueType = "missing-heading"
+
+	// IssueUntaggedHeading indicates that a changelog heading has no
+	// corresponding tag.
+	IssueUntaggedHeading IssueType = "untagged-heading"
+
+	// IssueDuplicateHeading indicates that a version heading exists multiple
+	// times in a changelog file.
+	IssueDuplicateHeading IssueType = "duplicate-heading"
+
+	// IssueMisplacedHeading indicates that a version heading is part of the
+	// changelog file of another minor version.
+	IssueMisplacedHeading IssueType = "misplaced-heading"
+
+	// IssueInvalidDownloadLink indicates that a downloads table link is not
+	// a valid URL, does not match the file name or points to another release.
+	IssueInvalidDownloadLink IssueType = "invalid-download-link"
+
+	// IssueUnreachableDownload indicates that a downloads table link cannot
+	// be reached.
+	IssueUnreachableDownload IssueType = "unreachable-download"
+
+	// IssueInvalidChecksum indicates that a downloads table entry has no
+	// valid SHA512 checksum.
+	IssueInvalidChecksum IssueType = "invalid-checksum"
+)
+
+var (
+	// releaseHeadingRE matches the level one version headings of the
+	// changelog, like `# v1.30.0`.
+	releaseHeadingRE = regexp.MustCompile(`^# (\S+)\s*$`)
+
+	// downloadRE matches a row of a downloads table, like
+	// "[kubernetes.tar.gz](https://dl.k8s.io/v1.30.0/kubernetes.tar.gz) | `abc`".
+	downloadRE = regexp.MustCompile("^\\[([^\\]]+)\\]\\(([^)]+)\\) \\| `?([^`\\s]*)`?\\s*$")
+
+	sha512RE = regexp.MustCompile(`^[0-9a-f]{128}$`)
+)
+
+const downloadsTableHeader = "filename | sha512 hash"
+
+// VerifyOptions are the settings for verifying the changelog files.
+type VerifyOptions struct {
+	// RepoPath is the path to the local repository.
+	RepoPath string
+
+	// GitHubTags enables the cross check of the latest GitHub tags per
+	// branch in addition to the local git tags.
+	GitHubTags bool
+
+	// CheckLinks enables checking if the downloads table links can be
+	// reached.
+	CheckLinks bool
+
+	// Profile contains the repository conventions, defaults to the
+	// kubernetes/kubernetes profile if not set.
+	Profile *Profile
+}
+
+// VerifyReport is the result of the changelog verification.
+type VerifyReport struct {
+	// Files are the verified changelog files relative to the repository.
+	Files []string `json:"files"`
+
+	// Tags are the released tags which have been cross checked.
+	Tags []string `json:"tags"`
+
+	// Issues are all found inconsistencies.
+	Issues []*VerifyIssue `json:"issues"`
+}
+
+// VerifyIssue is a single inconsistency of the changelog.
+type VerifyIssue struct {
+	Type    IssueType `json:"type"`
+	File    string    `json:"file,omitempty"`
+	Line    int       `json:"line,omitempty"`
+	Tag     string    `json:"tag,omitempty"`
+	Message string    `json:"message"`
+}
+
+// Verifier can be used to verify the consistency of the changelog files.
+type Verifier struct {
+	options *VerifyOptions
+	impl
+}
+
+// NewVerifier creates a new Verifier instance.
+func NewVerifier(opts *VerifyOptions) *Verifier {
+	if opts.Profile == nil {
+		opts.Profile = DefaultProfile()
+	}
+
+	return &Verifier{
+		options: opts,
+		impl:    &defaultImpl{},
+	}
+}
+
+// SetImpl can be used to set the internal implementation.
+func (v *Verifier) SetImpl(impl impl) {
+	v.impl = impl
+}
+
+// changelogHeading is a version heading of a changelog file.
+type changelogHeading struct {
+	file    string
+	line    int
+	version semver.Version
+}
+
+// Verify walks all markdown changelog files and reports their drift from the
+// released tags, the table of contents and the downloads tables.
+func (v *Verifier) Verify() (*VerifyReport, error) {
+	profile := v.options.Profile
+
+	repo, err := v.OpenRepo(v.options.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("open repository: %w", err)
+	}
+
+	repoDir := v.RepoDir(repo)
+
+	glob, err := profile.MarkdownChangelogGlob()
+	if err != nil {
+		return nil, fmt.Errorf("get changelog files pattern: %w", err)
+	}
+
+	paths, err := v.Glob(filepath.Join(repoDir, glob))
+	if err != nil {
+		return nil, fmt.Errorf("find changelog files: %w", err)
+	}
+
+	report := &VerifyReport{
+		Files:  []string{},
+		Tags:   []string{},
+		Issues: []*VerifyIssue{},
+	}
+	headings := map[string]*changelogHeading{}
+
+	for _, p := range paths {
+		file, err := filepath.Rel(repoDir, p)
+		if err != nil {
+			return nil, fmt.Errorf("get relative path of %s: %w", p, err)
+		}
+
+		content, err := v.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("read changelog file: %w", err)
+		}
+
+		logrus.Infof("Verifying changelog %s", file)
+		report.Files = append(report.Files, file)
+
+		fileHeadings, err := v.verifyFile(report, file, content)
+		if err != nil {
+			return nil, fmt.Errorf("verify %s: %w", file, err)
+		}
+
+		for _, heading := range fileHeadings {
+			tag := profile.TagString(heading.version)
+			if existing, ok := headings[tag]; ok {
+				report.add(IssueDuplicateHeading, heading.file, heading.line, tag,
+					fmt.Sprintf("heading already exists in %s:%d", existing.file, existing.line),
+				)
+
+				continue
+			}
+
+			headings[tag] = heading
+		}
+	}
+
+	tags, err := v.releasedTags(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.verifyTags(report, tags, headings); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// verifyFile checks the table of contents and downloads tables of a single
+// changelog file and returns its version headings.
+func (v *Verifier) verifyFile(
+	report *VerifyReport, file string, content []byte,
+) ([]*changelogHeading, error) {
+	profile := v.options.Profile
+
+	if err := v.verifyTOC(report, file, content); err != nil {
+		return nil, err
+	}
+
+	headings := []*changelogHeading{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+
+	var (
+		lineNumber  int
+		inCodeBlock bool
+		inDownloads bool
+		currentTag  string
+	)
+
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCodeBlock = !inCodeBlock
+
+			continue
+		}
+
+		if inCodeBlock {
+			continue
+		}
+
+		if match := releaseHeadingRE.FindStringSubmatch(line); match != nil {
+			version, err := semver.Parse(profile.TrimTagPrefix(match[1]))
+			if err != nil || !strings.HasPrefix(match[1], profile.TagPrefix) {
+				logrus.Debugf("Skipping non version heading %q in %s", line, file)
+
+				continue
+			}
+
+			currentTag = match[1]
+			headings = append(headings, &changelogHeading{
+				file: file, line: lineNumber, version: version,
+			})
+
+			expectedFile, err := profile.MarkdownChangelogFilename(version)
+			if err != nil {
+				return nil, fmt.Errorf("get changelog file name: %w", err)
+			}
+
+			if expectedFile != file {
+				report.add(IssueMisplacedHeading, file, lineNumber, currentTag,
+					"heading belongs to "+expectedFile,
+				)
+			}
+
+			continue
+		}
+
+		switch {
+		case line == downloadsTableHeader:
+			inDownloads = true
+		case strings.TrimSpace(line) == "":
+			inDownloads = false
+		case inDownloads:
+			v.verifyDownload(report, file, lineNumber, currentTag, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan changelog: %w", err)
+	}
+
+	return headings, nil
+}
+
+// verifyTOC checks that the table of contents markers written by
+// `addTocMarkers` exist exactly once and that the table of contents matches
+// the changelog content.
+func (v *Verifier) verifyTOC(report *VerifyReport, file string, content []byte) error {
+	startCount := bytes.Count(content, []byte(tocStart))
+	endCount := bytes.Count(content, []byte(TocEnd))
+
+	if startCount != 1 || endCount != 1 {
+		report.add(IssueMissingTOCMarker, file, 0, "", fmt.Sprintf(
+			"expected one table of contents start and end marker, found %d start and %d end markers",
+			startCount, endCount,
+		))
+
+		return nil
+	}
+
+	startIndex := bytes.Index(content, []byte(tocStart))
+	endIndex := bytes.Index(content, []byte(TocEnd))
+
+	if startIndex > endIndex {
+		report.add(IssueMissingTOCMarker, file, 0, "",
+			"table of contents end marker is before the start marker",
+		)
+
+		return nil
+	}
+
+	toc := strings.TrimSpace(string(content[startIndex+len(tocStart) : endIndex]))
+	markdown := string(content[endIndex+len(TocEnd):])
+
+	expectedTOC, err := v.GenerateTOC(markdown)
+	if err != nil {
+		return fmt.Errorf("generate table of contents: %w", err)
+	}
+
+	if toc != strings.TrimSpace(expectedTOC) {
+		report.add(IssueOutdatedTOC, file, 0, "",
+			"table of contents does not match the changelog headings",
+		)
+	}
+
+	return nil
+}
+
+// verifyDownload checks a single downloads table row of a release.
+func (v *Verifier) verifyDownload(
+	report *VerifyReport, file string, lineNumber int, tag, line string,
+) {
+	if strings.HasPrefix(line, "---") {
+		return
+	}
+
+	match := downloadRE.FindStringSubmatch(line)
+	if match == nil {
+		report.add(IssueInvalidDownloadLink, file, lineNumber, tag,
+			fmt.Sprintf("unable to parse downloads table row %q", line),
+		)
+
+		return
+	}
+
+	name, link, checksum := match[1], match[2], match[3]
+
+	u, err := url.Parse(link)
+
+	switch {
+	case err != nil || u.Scheme != "https" || u.Host == "":
+		report.add(IssueInvalidDownloadLink, file, lineNumber, tag,
+			fmt.Sprintf("link %q of %s is not a valid HTTPS URL", link, name),
+		)
+	case path.Base(u.Path) != name:
+		report.add(IssueInvalidDownloadLink, file, lineNumber, tag,
+			fmt.Sprintf("link %q does not match file name %s", link, name),
+		)
+	case tag != "" && !strings.Contains(u.Path, "/"+tag+"/"):
+		report.add(IssueInvalidDownloadLink, file, lineNumber, tag,
+			fmt.Sprintf("link %q does not point to release %s", link, tag),
+		)
+	case v.options.CheckLinks:
+		if err := v.HeadURL(link); err != nil {
+			report.add(IssueUnreachableDownload, file, lineNumber, tag,
+				fmt.Sprintf("link %q is not reachable: %v", link, err),
+			)
+		}
+	}
+
+	if !sha512RE.MatchString(checksum) {
+		report.add(IssueInvalidChecksum, file, lineNumber, tag,
+			fmt.Sprintf("%q of %s is not a valid SHA512 checksum", checksum, name),
+		)
+	}
+}
+
+// releasedTags returns the released tags of the repository from the local
+// git tags and optionally the latest GitHub tags per branch.
+func (v *Verifier) releasedTags(repo *git.Repo) ([]semver.Version, error) {
+	profile := v.options.Profile
+
+	tags, err := v.Tags(repo)
+	if err != nil {
+		return nil, fmt.Errorf("get repository tags: %w", err)
+	}
+
+	if v.options.GitHubTags {
+		latestTags, err := v.LatestGitHubTagsPerBranch()
+		if err != nil {
+			return nil, fmt.Errorf("get latest GitHub tags: %w", err)
+		}
+
+		for _, tag := range latestTags {
+			tags = append(tags, tag)
+		}
+	}
+
+	versions := []semver.Version{}
+
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, profile.TagPrefix) {
+			continue
+		}
+
+		version, err := semver.Parse(profile.TrimTagPrefix(tag))
+		if err != nil {
+			logrus.Debugf("Skipping tag %s because it is not valid semver", tag)
+
+			continue
+		}
+
+		// The x.y.0-alpha.0 tags mark the start of a development cycle and
+		// are never released.
+		if isAlphaZero(version) {
+			continue
+		}
+
+		if !slices.ContainsFunc(versions, version.Equals) {
+			versions = append(versions, version)
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b semver.Version) int {
+		return a.Compare(b)
+	})
+
+	return versions, nil
+}
+
+// verifyTags cross checks the released tags against the changelog headings.
+// Tags older than the oldest changelog file are ignored.
+func (v *Verifier) verifyTags(
+	report *VerifyReport, tags []semver.Version, headings map[string]*changelogHeading,
+) error {
+	profile := v.options.Profile
+
+	var oldest *semver.Version
+
+	for _, heading := range headings {
+		minor := semver.Version{Major: heading.version.Major, Minor: heading.version.Minor}
+		if oldest == nil || minor.LT(*oldest) {
+			oldest = &minor
+		}
+	}
+
+	files := map[string]bool{}
+	for _, file := range report.Files {
+		files[file] = true
+	}
+
+	released := map[string]bool{}
+	missingFiles := map[string]bool{}
+
+	for _, version := range tags {
+		if oldest != nil && version.LT(*oldest) {
+			continue
+		}
+
+		tag := profile.TagString(version)
+		released[tag] = true
+		report.Tags = append(report.Tags, tag)
+
+		if _, ok := headings[tag]; ok {
+			continue
+		}
+
+		file, err := profile.MarkdownChangelogFilename(version)
+		if err != nil {
+			return fmt.Errorf("get changelog file name: %w", err)
+		}
+
+		if !files[file] {
+			if !missingFiles[file] {
+				missingFiles[file] = true
+				report.add(IssueMissingChangelog, file, 0, tag,
+					"changelog file does not exist for released tag "+tag,
+				)
+			}
+
+			continue
+		}
+
+		report.add(IssueMissingHeading, file, 0, tag, "no heading for released tag "+tag)
+	}
+
+	for _, tag := range slices.Sorted(maps.Keys(headings)) {
+		if !released[tag] {
+			heading := headings[tag]
+			report.add(IssueUntaggedHeading, heading.file, heading.line, tag,
+				"heading has no corresponding tag "+tag,
+			)
+		}
+	}
+
+	return nil
+}
+
+// add appends a new issue to the report.
+func (r *VerifyReport) add(issueType IssueType, file string, line int, tag, message string) {
+	r.Issues = append(r.Issues, &VerifyIssue{
+		Type:    issueType,
+		File:    file,
+		Line:    line,
+		Tag:     tag,
+		Message: message,
+	})
+}
+
+func isAlphaZero(version semver.Version) bool {
+	return len(version.Pre) == 2 &&
+		version.Pre[0].VersionStr == "alpha" &&
+		version.Pre[1].IsNum && version.Pre[1].VersionNum == 0
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package changelog_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/mdtoc/pkg/mdtoc"
+	"sigs.k8s.io/release-sdk/github"
+
+	"k8s.io/release/pkg/changelog"
+	"k8s.io/release/pkg/changelog/changelogfakes"
+)
+
+var testSHA512 = strings.Repeat("ab", 64)
+
+const testChangelog = `# v1.30.1
+
+## Downloads for v1.30.1
+
+### Source Code
+
+filename | sha512 hash
+-------- | -----------
+[kubernetes.tar.gz](https://dl.k8s.io/v1.30.1/kubernetes.tar.gz) | ` + "`SHA512`" + `
+
+## Changelog since v1.30.0
+
+` + "```" + `
+# not-a-release
+` + "```" + `
+
+# v1.30.0
+
+## Changelog since v1.29.0
+`
+
+func generateTOC(markdown string) (string, error) {
+	return mdtoc.GenerateTOC([]byte(markdown), mdtoc.Options{MaxDepth: mdtoc.MaxHeaderDepth})
+}
+
+func writeTestChangelog(t *testing.T, dir, markdown string, withTOC bool) {
+	toc, err := generateTOC(markdown)
+	require.NoError(t, err)
+
+	content := markdown
+	if withTOC {
+		content = "<!-- BEGIN MUNGE: GENERATED_TOC -->\n\n" + toc + "\n" +
+			changelog.TocEnd + "\n\n" + markdown
+	}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, changelog.RepoChangelogDir), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, changelog.RepoChangelogDir, "CHANGELOG-1.30.md"),
+		[]byte(content), 0o600,
+	))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	validMarkdown := strings.ReplaceAll(testChangelog, "SHA512", testSHA512)
+	validTags := []string{"v1.29.5", "v1.30.0", "v1.30.1", "v1.31.0-alpha.0", "other-1.0.0", "v1.30.x"}
+
+	for _, tc := range []struct {
+		name           string
+		prepare        func(*testing.T, string, *changelogfakes.FakeImpl, *changelog.VerifyOptions)
+		shouldErr      bool
+		expectedIssues []changelog.IssueType
+	}{
+		{
+			name: "consistent changelog",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				mock.TagsReturns(validTags, nil)
+			},
+			expectedIssues: []changelog.IssueType{},
+		},
+		{
+			name: "missing headings and changelog files",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, opts *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				opts.GitHubTags = true
+				mock.TagsReturns(append(validTags, "v1.30.2"), nil)
+				mock.LatestGitHubTagsPerBranchReturns(github.TagsPerBranch{
+					"master": "v1.31.0-alpha.1",
+				}, nil)
+			},
+			expectedIssues: []changelog.IssueType{
+				changelog.IssueMissingHeading,
+				changelog.IssueMissingChangelog,
+			},
+		},
+		{
+			name: "untagged heading",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				mock.TagsReturns([]string{"v1.30.0"}, nil)
+			},
+			expectedIssues: []changelog.IssueType{changelog.IssueUntaggedHeading},
+		},
+		{
+			name: "duplicate and misplaced heading",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown+"\n# v1.30.0\n\n# v1.31.0\n", true)
+				mock.TagsReturns([]string{"v1.30.0", "v1.30.1", "v1.31.0"}, nil)
+			},
+			expectedIssues: []changelog.IssueType{
+				changelog.IssueMisplacedHeading,
+				changelog.IssueDuplicateHeading,
+			},
+		},
+		{
+			name: "missing table of contents",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, false)
+				mock.TagsReturns(validTags, nil)
+			},
+			expectedIssues: []changelog.IssueType{changelog.IssueMissingTOCMarker},
+		},
+		{
+			name: "outdated table of contents",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				mock.TagsReturns(validTags, nil)
+				mock.GenerateTOCReturns("- [v1.30.0](#v1300)", nil)
+			},
+			expectedIssues: []changelog.IssueType{changelog.IssueOutdatedTOC},
+		},
+		{
+			name: "invalid downloads table",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				markdown := strings.ReplaceAll(testChangelog, "SHA512", "abc")
+				markdown = strings.ReplaceAll(markdown, "dl.k8s.io/v1.30.1/", "dl.k8s.io/v1.30.0/")
+				writeTestChangelog(t, dir, markdown, true)
+				mock.TagsReturns(validTags, nil)
+			},
+			expectedIssues: []changelog.IssueType{
+				changelog.IssueInvalidDownloadLink,
+				changelog.IssueInvalidChecksum,
+			},
+		},
+		{
+			name: "unreachable download",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, opts *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				opts.CheckLinks = true
+				mock.TagsReturns(validTags, nil)
+				mock.HeadURLReturns(errors.New("404"))
+			},
+			expectedIssues: []changelog.IssueType{changelog.IssueUnreachableDownload},
+		},
+		{
+			name: "Tags failed",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				mock.TagsReturns(nil, errors.New(""))
+			},
+			shouldErr: true,
+		},
+		{
+			name: "LatestGitHubTagsPerBranch failed",
+			prepare: func(t *testing.T, dir string, mock *changelogfakes.FakeImpl, opts *changelog.VerifyOptions) {
+				writeTestChangelog(t, dir, validMarkdown, true)
+				opts.GitHubTags = true
+				mock.LatestGitHubTagsPerBranchReturns(nil, errors.New(""))
+			},
+			shouldErr: true,
+		},
+		{
+			name: "OpenRepo failed",
+			prepare: func(_ *testing.T, _ string, mock *changelogfakes.FakeImpl, _ *changelog.VerifyOptions) {
+				mock.OpenRepoReturns(nil, errors.New(""))
+			},
+			shouldErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			opts := &changelog.VerifyOptions{RepoPath: dir}
+			sut := changelog.NewVerifier(opts)
+
+			mock := &changelogfakes.FakeImpl{}
+			mock.RepoDirReturns(dir)
+			mock.GlobStub = filepath.Glob
+			mock.ReadFileStub = os.ReadFile
+			mock.GenerateTOCStub = generateTOC
+			tc.prepare(t, dir, mock, opts)
+			sut.SetImpl(mock)
+
+			report, err := sut.Verify()
+			if tc.shouldErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []string{"CHANGELOG/CHANGELOG-1.30.md"}, report.Files)
+
+			issues := []changelog.IssueType{}
+			for _, issue := range report.Issues {
+				issues = append(issues, issue.Type)
+			}
+
+			require.Equal(t, tc.expectedIssues, issues, "%+v", report.Issues)
+		})
+	}
+}
+
+func TestVerifyTags(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestChangelog(t, dir, strings.ReplaceAll(testChangelog, "SHA512", testSHA512), true)
+
+	mock := &changelogfakes.FakeImpl{}
+	mock.RepoDirReturns(dir)
+	mock.GlobStub = filepath.Glob
+	mock.ReadFileStub = os.ReadFile
+	mock.GenerateTOCStub = generateTOC
+	mock.TagsReturns([]string{"v1.30.1", "v1.29.5", "v1.30.0", "v1.31.0-alpha.0"}, nil)
+
+	sut := changelog.NewVerifier(&changelog.VerifyOptions{RepoPath: dir})
+	sut.SetImpl(mock)
+
+	report, err := sut.Verify()
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.30.0", "v1.30.1"}, report.Tags)
+	require.Empty(t, report.Issues)
+}
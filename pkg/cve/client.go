@@ -18,10 +18,10 @@ package cve
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
-
-	"sigs.k8s.io/release-sdk/object"
+	"slices"
 
 	"k8s.io/release/pkg/release"
 )
@@ -41,27 +41,65 @@ const (
 
 type Client struct {
 	impl    ClientImplementation
+	storage Storage
 	options ClientOptions
 }
 
 type ClientOptions struct {
+	// Storage is the backend of the CVE maps, one of StorageBackends().
+	// Defaults to the GCS bucket.
+	Storage string
+
+	// Bucket and Directory are the location of the maps in the GCS bucket.
 	Bucket    string
 	Directory string
+
+	// Path is the directory of the maps in the local and git storage.
+	Path string
 }
 
 var cveDefaultOpts = ClientOptions{
+	Storage:   StorageGCS,
 	Bucket:    Bucket,
 	Directory: Directory,
 }
 
+// DefaultClientOptions returns the client options using the release bucket.
+func DefaultClientOptions() *ClientOptions {
+	opts := cveDefaultOpts
+
+	return &opts
+}
+
 func NewClient() *Client {
-	return &Client{
+	client := &Client{
 		impl:    &defaultClientImplementation{},
 		options: cveDefaultOpts,
 	}
+	client.storage = &gcsStorage{impl: client.impl, options: &client.options}
+
+	return client
+}
+
+// NewClientWithOptions creates a new client using the storage backend
+// defined in the options.
+func NewClientWithOptions(opts *ClientOptions) (*Client, error) {
+	client := &Client{
+		impl:    &defaultClientImplementation{},
+		options: *opts,
+	}
+
+	storage, err := newStorage(client.impl, &client.options)
+	if err != nil {
+		return nil, fmt.Errorf("creating CVE storage: %w", err)
+	}
+
+	client.storage = storage
+
+	return client, nil
 }
 
-// Write writes a map to the bucket.
+// Write writes a map to the storage.
 func (c *Client) Write(cve, mapPath string) error {
 	if err := c.impl.CheckID(cve); err != nil {
 		return fmt.Errorf("checking CVE identifier: %w", err)
@@ -72,18 +110,8 @@ func (c *Client) Write(cve, mapPath string) error {
 		return fmt.Errorf("validating CVE data in map file: %w", err)
 	}
 
-	destPath := object.GcsPrefix + filepath.Join(
-		c.options.Bucket, c.options.Directory, cve+".yaml",
-	)
-
-	// Copy the map into the bucket
-	if err := c.impl.CopyFile(
-		mapPath, destPath, &c.options,
-	); err != nil {
-		return fmt.Errorf("writing %s map file to CVE bucket: %w", cve, err)
-	}
-
-	return nil
+	// Copy the map into the storage
+	return c.storage.Write(cve, mapPath)
 }
 
 // CheckID checks a CVE ID to verify it is well formed.
@@ -91,22 +119,44 @@ func (c *Client) CheckID(cve string) error {
 	return c.impl.CheckID(cve)
 }
 
-// Delete removes a CVE entry from the security bucket location.
+// Delete removes a CVE entry from the storage.
 func (c *Client) Delete(cve string) error {
 	if err := c.impl.CheckID(cve); err != nil {
 		return fmt.Errorf("checking CVE identifier: %w", err)
 	}
 
-	return c.impl.DeleteFile(
-		object.GcsPrefix+filepath.Join(
-			c.options.Bucket, c.options.Directory, cve+".yaml",
-		), &c.options,
-	)
+	return c.storage.Delete(cve)
 }
 
 // CopyToTemp copies a CVE entry into a temporary local file.
 func (c *Client) CopyToTemp(cve string) (file *os.File, err error) {
-	return c.impl.CopyToTemp(cve, &c.options)
+	return c.storage.CopyToTemp(cve)
+}
+
+// Read returns the content of the map of a CVE entry.
+func (c *Client) Read(cve string) ([]byte, error) {
+	if err := c.impl.CheckID(cve); err != nil {
+		return nil, fmt.Errorf("checking CVE identifier: %w", err)
+	}
+
+	exists, err := c.storage.Exists(cve)
+	if err != nil {
+		return nil, fmt.Errorf("checking if cve entry exists: %w", err)
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("CVE entry %s not found", cve)
+	}
+
+	file, err := c.storage.CopyToTemp(cve)
+	if err != nil {
+		return nil, fmt.Errorf("copying CVE entry: %w", err)
+	}
+
+	defer os.RemoveAll(filepath.Dir(file.Name()))
+	defer file.Close()
+
+	return io.ReadAll(file)
 }
 
 // CreateEmptyMap creates a new, empty CVE data map.
@@ -114,7 +164,19 @@ func (c *Client) CreateEmptyMap(cve string) (file *os.File, err error) {
 	return c.impl.CreateEmptyFile(cve, &c.options)
 }
 
-// List return a list iof existing CVE entries.
+// EntryExists returns true if a CVE entry already exists.
 func (c *Client) EntryExists(cveID string) (bool, error) {
-	return c.impl.EntryExists(cveID, &c.options)
+	return c.storage.Exists(cveID)
+}
+
+// List returns the IDs of all existing CVE entries.
+func (c *Client) List() ([]string, error) {
+	ids, err := c.storage.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing CVE entries: %w", err)
+	}
+
+	slices.Sort(ids)
+
+	return ids, nil
 }
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+)
+
+// CSAFVersion is the implemented CSAF version, see
+// https://docs.oasis-open.org/csaf/csaf/v2.0/csaf-v2.0.html.
+const CSAFVersion = "2.0"
+
+// CSAFDocument is a CSAF document using the VEX profile.
+type CSAFDocument struct {
+	Document        CSAFDocumentMeta    `json:"document"`
+	ProductTree     CSAFProductTree     `json:"product_tree"`
+	Vulnerabilities []CSAFVulnerability `json:"vulnerabilities"`
+}
+
+// CSAFDocumentMeta contains the document level metadata.
+type CSAFDocumentMeta struct {
+	Category    string        `json:"category"`
+	CSAFVersion string        `json:"csaf_version"`
+	Title       string        `json:"title"`
+	Publisher   CSAFPublisher `json:"publisher"`
+	Tracking    CSAFTracking  `json:"tracking"`
+}
+
+// CSAFPublisher is the publisher of the document.
+type CSAFPublisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// CSAFTracking contains the tracking information of the document.
+type CSAFTracking struct {
+	ID                 string         `json:"id"`
+	Status             string         `json:"status"`
+	Version            string         `json:"version"`
+	InitialReleaseDate string         `json:"initial_release_date"`
+	CurrentReleaseDate string         `json:"current_release_date"`
+	RevisionHistory    []CSAFRevision `json:"revision_history"`
+	Generator          CSAFGenerator  `json:"generator"`
+}
+
+// CSAFRevision is a single entry of the revision history.
+type CSAFRevision struct {
+	Date    string `json:"date"`
+	Number  string `json:"number"`
+	Summary string `json:"summary"`
+}
+
+// CSAFGenerator is the tool which generated the document.
+type CSAFGenerator struct {
+	Engine CSAFEngine `json:"engine"`
+}
+
+// CSAFEngine is the name of the generator.
+type CSAFEngine struct {
+	Name string `json:"name"`
+}
+
+// CSAFProductTree contains all products referenced by the document.
+type CSAFProductTree struct {
+	Branches []CSAFBranch `json:"branches"`
+}
+
+// CSAFBranch is a node of the product tree.
+type CSAFBranch struct {
+	Category string       `json:"category"`
+	Name     string       `json:"name"`
+	Branches []CSAFBranch `json:"branches,omitempty"`
+	Product  *CSAFProduct `json:"product,omitempty"`
+}
+
+// CSAFProduct is a leaf of the product tree.
+type CSAFProduct struct {
+	Name      string `json:"name"`
+	ProductID string `json:"product_id"`
+}
+
+// CSAFVulnerability contains the vulnerability information and the status
+// of the products.
+type CSAFVulnerability struct {
+	CVE           string            `json:"cve"`
+	Title         string            `json:"title,omitempty"`
+	Notes         []CSAFNote        `json:"notes"`
+	Scores        []CSAFScore       `json:"scores,omitempty"`
+	ProductStatus CSAFProductStatus `json:"product_status"`
+	Remediations  []CSAFRemediation `json:"remediations,omitempty"`
+	References    []CSAFReference   `json:"references,omitempty"`
+}
+
+// CSAFNote is a textual note of a vulnerability.
+type CSAFNote struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+	Title    string `json:"title,omitempty"`
+}
+
+// CSAFScore is the CVSS score of the affected products.
+type CSAFScore struct {
+	CVSSV3   *CSAFCVSS `json:"cvss_v3,omitempty"`
+	Products []string  `json:"products"`
+}
+
+// CSAFCVSS is a CVSS score.
+type CSAFCVSS struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float32 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+}
+
+// CSAFProductStatus contains the product IDs per VEX status.
+type CSAFProductStatus struct {
+	Fixed         []string `json:"fixed,omitempty"`
+	KnownAffected []string `json:"known_affected,omitempty"`
+}
+
+// CSAFRemediation describes how to remediate the vulnerability.
+type CSAFRemediation struct {
+	Category   string   `json:"category"`
+	Details    string   `json:"details"`
+	ProductIDs []string `json:"product_ids"`
+	URL        string   `json:"url,omitempty"`
+}
+
+// CSAFReference is an external reference of a vulnerability.
+type CSAFReference struct {
+	Category string `json:"category"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url"`
+}
+
+// CSAF converts a CVE and its fixed versions into a CSAF VEX document. The
+// affected version ranges are `known_affected` and the fixed versions are
+// `fixed` products.
+func (e *Exporter) CSAF(cve *CVE, fixed []semver.Version) *CSAFDocument {
+	ts := e.timestamp()
+	product := e.options.Product
+
+	doc := &CSAFDocument{
+		Document: CSAFDocumentMeta{
+			Category:    "csaf_vex",
+			CSAFVersion: CSAFVersion,
+			Title:       fmt.Sprintf("%s: %s", cve.ID, cve.Title),
+			Publisher: CSAFPublisher{
+				Category:  "vendor",
+				Name:      e.options.Vendor,
+				Namespace: e.options.VendorNamespace,
+			},
+			Tracking: CSAFTracking{
+				ID:                 cve.ID,
+				Status:             "final",
+				Version:            "1",
+				InitialReleaseDate: ts,
+				CurrentReleaseDate: ts,
+				RevisionHistory: []CSAFRevision{{
+					Date: ts, Number: "1", Summary: "Initial version",
+				}},
+				Generator: CSAFGenerator{Engine: CSAFEngine{Name: "krel"}},
+			},
+		},
+	}
+
+	versions := []CSAFBranch{}
+	vuln := CSAFVulnerability{
+		CVE:   cve.ID,
+		Title: cve.Title,
+		Notes: []CSAFNote{{
+			Category: "description", Text: cve.Description, Title: "Description",
+		}},
+		References: []CSAFReference{{
+			Category: "external",
+			Summary:  "CVE record",
+			URL:      "https://www.cve.org/CVERecord?id=" + cve.ID,
+		}},
+	}
+
+	for _, r := range AffectedRanges(fixed) {
+		affected := csafVersionRange(r)
+		affectedID := product + "@" + affected
+		versions = append(versions, CSAFBranch{
+			Category: "product_version_range",
+			Name:     "vers:semver/" + affected,
+			Product:  &CSAFProduct{Name: fmt.Sprintf("%s %s", e.options.Vendor, affected), ProductID: affectedID},
+		})
+		vuln.ProductStatus.KnownAffected = append(vuln.ProductStatus.KnownAffected, affectedID)
+
+		if r.Fixed == "" {
+			vuln.Remediations = append(vuln.Remediations, CSAFRemediation{
+				Category:   "none_available",
+				Details:    "No fixed version is available yet",
+				ProductIDs: []string{affectedID},
+			})
+
+			continue
+		}
+
+		fixedID := product + "@" + r.Fixed
+		versions = append(versions, CSAFBranch{
+			Category: "product_version",
+			Name:     r.Fixed,
+			Product:  &CSAFProduct{Name: fmt.Sprintf("%s %s", e.options.Vendor, r.Fixed), ProductID: fixedID},
+		})
+		vuln.ProductStatus.Fixed = append(vuln.ProductStatus.Fixed, fixedID)
+		vuln.Remediations = append(vuln.Remediations, CSAFRemediation{
+			Category:   "vendor_fix",
+			Details:    fmt.Sprintf("Upgrade to %s or later", r.Fixed),
+			ProductIDs: []string{affectedID},
+		})
+	}
+
+	// CSAF 2.0 does not support CVSS v4.0 scores
+	if version := cvssVersion(cve.CVSSVector); version != CVSSVersion4 {
+		vuln.Scores = []CSAFScore{{
+			CVSSV3: &CSAFCVSS{
+				Version:      version,
+				VectorString: cve.CVSSVector,
+				BaseScore:    cve.CVSSScore,
+				BaseSeverity: strings.ToUpper(cve.CVSSRating),
+			},
+			Products: vuln.ProductStatus.KnownAffected,
+		}}
+	}
+
+	if cve.TrackingIssue != "" {
+		vuln.References = append(vuln.References, CSAFReference{
+			Category: "external", Summary: "Tracking issue", URL: cve.TrackingIssue,
+		})
+	}
+
+	for _, pr := range cve.LinkedPRs {
+		vuln.References = append(vuln.References, CSAFReference{
+			Category: "external", Summary: fmt.Sprintf("Fix #%d", pr), URL: e.prURL(pr),
+		})
+	}
+
+	doc.ProductTree = CSAFProductTree{Branches: []CSAFBranch{{
+		Category: "vendor",
+		Name:     e.options.Vendor,
+		Branches: []CSAFBranch{{
+			Category: "product_name",
+			Name:     product,
+			Branches: versions,
+		}},
+	}}}
+	doc.Vulnerabilities = []CSAFVulnerability{vuln}
+
+	return doc
+}
+
+// csafVersionRange returns the vers constraints of an affected range, like
+// `>=1.30.0|<1.30.2`.
+func csafVersionRange(r AffectedRange) string {
+	switch {
+	case r.Introduced == "0" && r.Fixed == "":
+		return "*"
+	case r.Introduced == "0":
+		return "<" + r.Fixed
+	case r.Fixed == "":
+		return ">=" + r.Introduced
+	default:
+		return fmt.Sprintf(">=%s|<%s", r.Introduced, r.Fixed)
+	}
+}
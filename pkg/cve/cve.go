@@ -19,22 +19,30 @@ package cve
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strings"
+	"time"
 
 	cvss "github.com/goark/go-cvss/v3/metric"
+	gocvss40 "github.com/pandatix/go-cvss/40"
 )
 
+// CVSSVersion4 is the version of CVSS v4.0 vector strings.
+const CVSSVersion4 = "4.0"
+
 // CVE Information of a linked CVE vulnerability.
 type CVE struct {
-	ID            string  `json:"id"                 yaml:"id"`                 // CVE ID, eg CVE-2019-1010260
-	Title         string  `json:"title"              yaml:"title"`              // Title of the vulnerability
-	Description   string  `json:"description"        yaml:"description"`        // Description text of the vulnerability
-	TrackingIssue string  `json:"issue"              yaml:"issue"`              // Link to the vulnerability tracking issue (url, optional)
-	CVSSVector    string  `json:"vector"             yaml:"vector"`             // Full CVSS vector string, CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
-	CVSSScore     float32 `json:"score"              yaml:"score"`              // Numeric CVSS score (eg 6.2)
-	CVSSRating    string  `json:"rating"             yaml:"rating"`             // Severity bucket (eg Medium)
-	CalcLink      string  `json:"calclink,omitempty" yaml:"calclink,omitempty"` // Link to the CVE calculator (automatic)
-	LinkedPRs     []int   `json:"pullrequests"`                                 // List of linked PRs (to remove them from the release notes doc)
+	ID            string  `json:"id"                  yaml:"id"`                  // CVE ID, eg CVE-2019-1010260
+	Title         string  `json:"title"               yaml:"title"`               // Title of the vulnerability
+	Description   string  `json:"description"         yaml:"description"`         // Description text of the vulnerability
+	TrackingIssue string  `json:"issue"               yaml:"issue"`               // Link to the vulnerability tracking issue (url, optional)
+	CVSSVector    string  `json:"vector"              yaml:"vector"`              // Full CVSS v3.x or v4.0 vector string, CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
+	CVSSScore     float32 `json:"score"               yaml:"score"`               // Numeric CVSS score (eg 6.2)
+	CVSSRating    string  `json:"rating"              yaml:"rating"`              // Severity bucket (eg Medium)
+	CalcLink      string  `json:"calclink,omitempty"  yaml:"calclink,omitempty"`  // Link to the CVE calculator (automatic)
+	LinkedPRs     []int   `json:"pullrequests"`                                   // List of linked PRs (to remove them from the release notes doc)
+	Published     string  `json:"published,omitempty" yaml:"published,omitempty"` // Date when the vulnerability got published (optional), eg 2020-05-28
 }
 
 // ReadRawInterface populates the CVE data struct from the raw array
@@ -67,6 +75,10 @@ func (cve *CVE) ReadRawInterface(cvedata interface{}) error {
 	if val, ok := cvedata.(map[interface{}]interface{})["description"].(string); ok {
 		cve.Description = val
 	}
+
+	if val, ok := cvedata.(map[interface{}]interface{})["published"].(string); ok {
+		cve.Published = val
+	}
 	// Linked PRs is a list of the PR IDs
 	if val, ok := cvedata.(map[interface{}]interface{})["linkedPRs"].([]interface{}); ok {
 		cve.LinkedPRs = []int{}
@@ -100,20 +112,49 @@ func (cve *CVE) Validate() (err error) {
 		return errors.New("string CVSS vector missing from CVE data")
 	}
 
-	var bm cvss.Metrics
-	// Parse the vector string to make sure it is well formed
-	if len(cve.CVSSVector) == 44 {
-		bm, err = cvss.NewBase().Decode(cve.CVSSVector)
+	version := cvssVersion(cve.CVSSVector)
+	if version == CVSSVersion4 {
+		// Parse the vector string to make sure it is well formed
+		vector, err := gocvss40.ParseVector(cve.CVSSVector)
+		if err != nil {
+			return fmt.Errorf("parsing CVSS v4.0 vector string: %w", err)
+		}
+
+		// Check the declared rating matches the score of the vector
+		score := vector.Score()
+
+		rating, err := gocvss40.Rating(score)
+		if err != nil {
+			return fmt.Errorf("rating CVSS score %.1f: %w", score, err)
+		}
+
+		if !strings.EqualFold(rating, cve.CVSSRating) {
+			return fmt.Errorf(
+				"CVSS rating %s does not match the rating %s of the vector score %.1f",
+				cve.CVSSRating, rating, score,
+			)
+		}
+
+		// Check the declared score matches the score of the vector
+		if cve.CVSSScore != 0 && math.Round(float64(cve.CVSSScore)*10) != math.Round(score*10) {
+			return fmt.Errorf(
+				"CVSS score %.1f does not match the vector score %.1f",
+				cve.CVSSScore, score,
+			)
+		}
 	} else {
-		bm, err = cvss.NewTemporal().Decode(cve.CVSSVector)
-	}
+		// Parse the vector string to make sure it is well formed, the
+		// temporal metrics also decode vectors without temporal values
+		tm, err := cvss.NewTemporal().Decode(cve.CVSSVector)
+		if err != nil {
+			return fmt.Errorf("parsing CVSS vector string: %w", err)
+		}
 
-	if err != nil {
-		return fmt.Errorf("parsing CVSS vector string: %w", err)
+		version = tm.BaseMetrics().Ver.String()
 	}
 
 	cve.CalcLink = fmt.Sprintf(
-		"https://www.first.org/cvss/calculator/%s#%s", bm.BaseMetrics().Ver.String(), cve.CVSSVector,
+		"https://www.first.org/cvss/calculator/%s#%s", version, cve.CVSSVector,
 	)
 
 	if cve.CVSSScore == 0 {
@@ -137,9 +178,28 @@ func (cve *CVE) Validate() (err error) {
 		return errors.New("missing CVE description from CVE data")
 	}
 
+	if _, err := cve.publishedTime(); err != nil {
+		return fmt.Errorf("parsing published date: %w", err)
+	}
+
 	return nil
 }
 
+// publishedTime returns the parsed published date, which can be either a
+// date like `2020-05-28` or an RFC 3339 timestamp. It returns the zero time
+// if no published date is set.
+func (cve *CVE) publishedTime() (time.Time, error) {
+	if cve.Published == "" {
+		return time.Time{}, nil
+	}
+
+	if published, err := time.Parse(time.DateOnly, cve.Published); err == nil {
+		return published, nil
+	}
+
+	return time.Parse(time.RFC3339, cve.Published)
+}
+
 // ValidateID checks if a CVE IS string is valid.
 func ValidateID(cveID string) error {
 	if cveID == "" {
@@ -153,3 +213,11 @@ func ValidateID(cveID string) error {
 
 	return nil
 }
+
+// cvssVersion returns the version of a CVSS vector string, like `3.1` for
+// `CVSS:3.1/AV:N/...`.
+func cvssVersion(vector string) string {
+	version, _, _ := strings.Cut(strings.TrimPrefix(vector, "CVSS:"), "/")
+
+	return version
+}
@@ -38,6 +38,7 @@ func TestCVEValidation(t *testing.T) {
 
 	// As is, the CVE should validate
 	require.NoError(t, cve.Validate())
+	require.Equal(t, "https://www.first.org/cvss/calculator/3.1#"+cve.CVSSVector, cve.CalcLink)
 
 	// Check each value
 	sut = cve
@@ -52,6 +53,23 @@ func TestCVEValidation(t *testing.T) {
 	sut.Description = ""
 	require.Error(t, sut.Validate(), "checking description")
 
+	sut = cve
+	for _, tc := range []struct {
+		Valid bool
+		Value string
+	}{
+		{true, "2020-07-15"},
+		{true, "2020-07-15T16:00:00Z"},
+		{false, "15.07.2020"},
+	} {
+		sut.Published = tc.Value
+		if tc.Valid {
+			require.NoError(t, sut.Validate(), "checking published date")
+		} else {
+			require.Error(t, sut.Validate(), "checking published date")
+		}
+	}
+
 	sut = cve
 	for _, testVector := range []string{
 		"CVSS:3.1/AV:N/AC:H/P", //  too short
@@ -93,3 +111,78 @@ func TestCVEValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestCVEValidationV4(t *testing.T) {
+	cve := CVE{
+		ID:          "CVE-2024-1234",
+		Title:       "Node escape via crafted volume",
+		Description: "A crafted volume allows escaping to the node.",
+		CVSSVector:  "CVSS:4.0/AV:N/AC:H/AT:P/PR:H/UI:A/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+		CVSSScore:   7.1,
+		CVSSRating:  "High",
+	}
+
+	for _, tc := range []struct {
+		name      string
+		prepare   func(*CVE)
+		shouldErr bool
+	}{
+		{
+			name:    "valid",
+			prepare: func(*CVE) {},
+		},
+		{
+			name: "valid with threat metrics",
+			prepare: func(c *CVE) {
+				c.CVSSVector = "CVSS:4.0/AV:L/AC:L/AT:N/PR:L/UI:N/VC:H/VI:H/VA:H/SC:H/SI:H/SA:H/E:U"
+				c.CVSSScore = 7.4
+			},
+		},
+		{
+			name: "valid critical",
+			prepare: func(c *CVE) {
+				c.CVSSVector = "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+				c.CVSSScore = 9.3
+				c.CVSSRating = "Critical"
+			},
+		},
+		{
+			name:      "rating does not match vector",
+			prepare:   func(c *CVE) { c.CVSSRating = "Medium" },
+			shouldErr: true,
+		},
+		{
+			name:      "score does not match vector",
+			prepare:   func(c *CVE) { c.CVSSScore = 7.5 },
+			shouldErr: true,
+		},
+		{
+			name:      "missing mandatory metric",
+			prepare:   func(c *CVE) { c.CVSSVector = "CVSS:4.0/AV:N/AC:H/AT:P/PR:H/UI:A/VC:H/VI:H/VA:H" },
+			shouldErr: true,
+		},
+		{
+			name:      "invalid metric value",
+			prepare:   func(c *CVE) { c.CVSSVector = "CVSS:4.0/AV:X/AC:H/AT:P/PR:H/UI:A/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N" },
+			shouldErr: true,
+		},
+		{
+			name:      "out of range score",
+			prepare:   func(c *CVE) { c.CVSSScore = 10.1 },
+			shouldErr: true,
+		},
+	} {
+		sut := cve
+		tc.prepare(&sut)
+
+		err := sut.Validate()
+		if tc.shouldErr {
+			require.Error(t, err, tc.name)
+
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, "https://www.first.org/cvss/calculator/4.0#"+sut.CVSSVector, sut.CalcLink, tc.name)
+	}
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/git"
+	"sigs.k8s.io/release-utils/command"
+
+	"k8s.io/release/pkg/notes"
+)
+
+const (
+	// FormatOSV exports the CVEs as Open Source Vulnerability documents.
+	FormatOSV = "osv"
+
+	// FormatCSAF exports the CVEs as CSAF 2.0 VEX documents.
+	FormatCSAF = "csaf"
+
+	gitExecutable = "git"
+)
+
+// ExportFormats returns all supported export formats.
+func ExportFormats() []string {
+	return []string{FormatOSV, FormatCSAF}
+}
+
+// ExportOptions are the settings for exporting CVE maps.
+type ExportOptions struct {
+	// Format is the export format, one of ExportFormats().
+	Format string
+
+	// OutputDir is the directory where one document per CVE is written.
+	OutputDir string
+
+	// RepoPath is the path to a local repository clone including all
+	// release tags, which is used to derive the fixed versions from the
+	// linked PRs. The documents contain no fixed versions if not set.
+	RepoPath string
+
+	// GithubOrg and GithubRepo are the repository of the linked PRs.
+	GithubOrg  string
+	GithubRepo string
+
+	// TagPrefix is the prefix of the release tags before the semantic
+	// version.
+	TagPrefix string
+
+	// Ecosystem and Package identify the affected product in OSV documents.
+	Ecosystem string
+	Package   string
+
+	// Vendor and Product identify the affected product in CSAF documents.
+	Vendor          string
+	VendorNamespace string
+	Product         string
+
+	// Timestamp is used as modification and release date of the documents,
+	// defaults to the current time. OSV documents are published at the
+	// published date of the CVE if set, otherwise documents which already
+	// exist in the output directory keep their original publication date.
+	Timestamp time.Time
+}
+
+// DefaultExportOptions returns the export options for Kubernetes.
+func DefaultExportOptions() *ExportOptions {
+	return &ExportOptions{
+		Format:          FormatOSV,
+		GithubOrg:       git.DefaultGithubOrg,
+		GithubRepo:      git.DefaultGithubRepo,
+		TagPrefix:       "v",
+		Ecosystem:       "Go",
+		Package:         "k8s.io/kubernetes",
+		Vendor:          "Kubernetes",
+		VendorNamespace: "https://kubernetes.io",
+		Product:         "kubernetes",
+	}
+}
+
+// Validate checks the export options.
+func (o *ExportOptions) Validate() error {
+	if !slices.Contains(ExportFormats(), o.Format) {
+		return fmt.Errorf("unsupported export format %q, must be one of %v", o.Format, ExportFormats())
+	}
+
+	if o.OutputDir == "" {
+		return errors.New("no output directory provided")
+	}
+
+	return nil
+}
+
+// AffectedRange is a range of affected versions of a single release line.
+// The versions are semantic versions without tag prefix.
+type AffectedRange struct {
+	// Introduced is the first affected version, `0` means all previous
+	// versions.
+	Introduced string
+
+	// Fixed is the first version containing the fix, an empty string means
+	// that no fix is available.
+	Fixed string
+}
+
+// AffectedRanges returns the affected version ranges for the fixed
+// versions of each release line. All versions before the oldest fix are
+// affected, while newer release lines are affected from their `.0` release
+// until their fix. Release lines which were already fixed in their `.0`
+// release are not affected.
+func AffectedRanges(fixed []semver.Version) []AffectedRange {
+	if len(fixed) == 0 {
+		return []AffectedRange{{Introduced: "0"}}
+	}
+
+	sorted := slices.Clone(fixed)
+	slices.SortFunc(sorted, func(a, b semver.Version) int {
+		return a.Compare(b)
+	})
+
+	res := []AffectedRange{}
+
+	for i, version := range sorted {
+		introduced := "0"
+		if i > 0 {
+			introduced = semver.Version{Major: version.Major, Minor: version.Minor}.String()
+		}
+
+		if introduced == version.String() {
+			continue
+		}
+
+		res = append(res, AffectedRange{Introduced: introduced, Fixed: version.String()})
+	}
+
+	return res
+}
+
+// Exporter converts CVE maps into vulnerability documents.
+type Exporter struct {
+	options *ExportOptions
+}
+
+// NewExporter creates a new Exporter instance.
+func NewExporter(opts *ExportOptions) *Exporter {
+	return &Exporter{options: opts}
+}
+
+// Export resolves the fixed versions of the CVEs and writes one document per
+// CVE into the output directory.
+func (e *Exporter) Export(cves []*CVE) error {
+	if err := e.options.Validate(); err != nil {
+		return fmt.Errorf("validating export options: %w", err)
+	}
+
+	if err := os.MkdirAll(e.options.OutputDir, os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	for _, cve := range cves {
+		fixed := []semver.Version{}
+
+		if e.options.RepoPath != "" {
+			var err error
+
+			fixed, err = FixedVersions(e.options.RepoPath, e.options.TagPrefix, cve.LinkedPRs)
+			if err != nil {
+				return fmt.Errorf("getting fixed versions of %s: %w", cve.ID, err)
+			}
+		}
+
+		var (
+			doc  any
+			path string
+		)
+
+		switch e.options.Format {
+		case FormatCSAF:
+			doc = e.CSAF(cve, fixed)
+			path = filepath.Join(e.options.OutputDir, strings.ToLower(cve.ID)+".json")
+		default:
+			path = filepath.Join(e.options.OutputDir, cve.ID+".json")
+			osv := e.OSV(cve, fixed)
+
+			if published := publishedOSV(path); published != "" && cve.Published == "" {
+				osv.Published = published
+			}
+
+			doc = osv
+		}
+
+		content, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshalling %s document of %s: %w", e.options.Format, cve.ID, err)
+		}
+
+		if err := os.WriteFile(path, content, os.FileMode(0o644)); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+
+		logrus.Infof("Wrote %s document %s", e.options.Format, path)
+	}
+
+	return nil
+}
+
+// timestamp returns the document timestamp.
+func (e *Exporter) timestamp() string {
+	ts := e.options.Timestamp
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	return ts.UTC().Format(time.RFC3339)
+}
+
+// publishedOSV returns the publication date of a previously exported OSV
+// document, or an empty string if there is none.
+func publishedOSV(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	doc := &OSVDocument{}
+	if err := json.Unmarshal(content, doc); err != nil {
+		logrus.Debugf("Unable to read existing OSV document %s: %v", path, err)
+
+		return ""
+	}
+
+	return doc.Published
+}
+
+// prURL returns the URL of a linked PR.
+func (e *Exporter) prURL(pr int) string {
+	return fmt.Sprintf("https://github.com/%s/%s/pull/%d", e.options.GithubOrg, e.options.GithubRepo, pr)
+}
+
+// ReadMaps reads and validates the CVE data of all maps from the provided
+// files and directories. The PRs of maps with the same CVE ID are merged
+// into the linked PRs. The CVEs are sorted by their ID.
+func ReadMaps(paths ...string) ([]*CVE, error) {
+	files := []string{}
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("checking CVE map path: %w", err)
+		}
+
+		if !info.IsDir() {
+			files = append(files, path)
+
+			continue
+		}
+
+		for _, ext := range []string{"*.yaml", "*.yml"} {
+			matches, err := filepath.Glob(filepath.Join(path, ext))
+			if err != nil {
+				return nil, fmt.Errorf("finding CVE maps: %w", err)
+			}
+
+			files = append(files, matches...)
+		}
+	}
+
+	cves := map[string]*CVE{}
+
+	for _, file := range files {
+		notemaps, err := notes.ParseReleaseNotesMap(file)
+		if err != nil {
+			return nil, fmt.Errorf("parsing CVE data map %s: %w", file, err)
+		}
+
+		for i, dataMap := range *notemaps {
+			if _, ok := dataMap.DataFields["cve"]; !ok {
+				logrus.Debugf("Skipping data map #%d in file %s without CVE data", i, file)
+
+				continue
+			}
+
+			cve := &CVE{}
+			if err := cve.ReadRawInterface(dataMap.DataFields["cve"]); err != nil {
+				return nil, fmt.Errorf("reading CVE data from %s: %w", file, err)
+			}
+
+			if err := cve.Validate(); err != nil {
+				return nil, fmt.Errorf("validating map #%d in file %s: %w", i, file, err)
+			}
+
+			if dataMap.PR != 0 {
+				cve.LinkedPRs = append(cve.LinkedPRs, dataMap.PR)
+			}
+
+			if existing, ok := cves[cve.ID]; ok {
+				cve.LinkedPRs = append(cve.LinkedPRs, existing.LinkedPRs...)
+			}
+
+			slices.Sort(cve.LinkedPRs)
+			cve.LinkedPRs = slices.Compact(cve.LinkedPRs)
+			cves[cve.ID] = cve
+		}
+	}
+
+	res := []*CVE{}
+	for _, id := range slices.Sorted(maps.Keys(cves)) {
+		res = append(res, cves[id])
+	}
+
+	return res, nil
+}
+
+// FixedVersions returns the earliest final release of each release line
+// whose tag contains the merge commit of one of the PRs. The merge commits
+// are found by their `Merge pull request #123` message or the `(#123)`
+// suffix of squash merges. Automated cherry picks of the PRs into release
+// branches are found by their `Automated cherry pick of #123` message.
+func FixedVersions(repoPath, tagPrefix string, prs []int) ([]semver.Version, error) {
+	earliest := map[string]semver.Version{}
+
+	for _, pr := range prs {
+		res, err := command.NewWithWorkDir(
+			repoPath, gitExecutable, "log", "--all", "--format=%H", "--extended-regexp",
+			fmt.Sprintf("--grep=^Merge pull request #%d( |$)", pr),
+			fmt.Sprintf("--grep=\\(#%d\\)$", pr),
+			fmt.Sprintf("--grep=[Cc]herry[- ]pick[- ]of[- ].*#%d([^0-9]|$)", pr),
+		).RunSilentSuccessOutput()
+		if err != nil {
+			return nil, fmt.Errorf("finding merge commit of PR #%d: %w", pr, err)
+		}
+
+		for _, commit := range strings.Fields(res.Output()) {
+			res, err := command.NewWithWorkDir(
+				repoPath, gitExecutable, "tag", "--contains", commit,
+			).RunSilentSuccessOutput()
+			if err != nil {
+				return nil, fmt.Errorf("finding tags containing %s: %w", commit, err)
+			}
+
+			for _, tag := range strings.Fields(res.Output()) {
+				if !strings.HasPrefix(tag, tagPrefix) {
+					continue
+				}
+
+				version, err := semver.Parse(strings.TrimPrefix(tag, tagPrefix))
+				if err != nil || len(version.Pre) > 0 {
+					continue
+				}
+
+				line := fmt.Sprintf("%d.%d", version.Major, version.Minor)
+				if current, ok := earliest[line]; !ok || version.LT(current) {
+					earliest[line] = version
+				}
+			}
+		}
+	}
+
+	res := []semver.Version{}
+	for _, version := range earliest {
+		res = append(res, version)
+	}
+
+	slices.SortFunc(res, func(a, b semver.Version) int {
+		return a.Compare(b)
+	})
+
+	return res, nil
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+)
+
+const testCVEMap = `---
+pr: 100
+releasenote:
+  text: Fix CVE-2024-1234
+datafields:
+  cve:
+    id: CVE-2024-1234
+    title: Node escape via crafted volume
+    issue: https://github.com/kubernetes/kubernetes/issues/99
+    vector: CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
+    score: 6.4
+    rating: Medium
+    description: A crafted volume allows escaping to the node.
+    linkedPRs:
+    - 101
+---
+pr: 102
+datafields:
+  cve:
+    id: CVE-2024-1234
+    title: Node escape via crafted volume
+    vector: CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
+    score: 6.4
+    rating: Medium
+    description: A crafted volume allows escaping to the node.
+---
+pr: 103
+releasenote:
+  text: Not a CVE
+`
+
+func testCVE() *CVE {
+	return &CVE{
+		ID:            "CVE-2024-1234",
+		Title:         "Node escape via crafted volume",
+		Description:   "A crafted volume allows escaping to the node.",
+		TrackingIssue: "https://github.com/kubernetes/kubernetes/issues/99",
+		CVSSVector:    "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+		CVSSScore:     6.4,
+		CVSSRating:    "Medium",
+		LinkedPRs:     []int{100, 101},
+	}
+}
+
+func testExporter() *Exporter {
+	opts := DefaultExportOptions()
+	opts.Timestamp = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	return NewExporter(opts)
+}
+
+func TestAffectedRanges(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		fixed    []string
+		expected []AffectedRange
+	}{
+		{
+			fixed:    nil,
+			expected: []AffectedRange{{Introduced: "0"}},
+		},
+		{
+			fixed:    []string{"1.30.2"},
+			expected: []AffectedRange{{Introduced: "0", Fixed: "1.30.2"}},
+		},
+		{
+			fixed: []string{"1.31.0", "1.29.5", "1.30.2"},
+			expected: []AffectedRange{
+				{Introduced: "0", Fixed: "1.29.5"},
+				{Introduced: "1.30.0", Fixed: "1.30.2"},
+			},
+		},
+	} {
+		fixed := []semver.Version{}
+		for _, v := range tc.fixed {
+			fixed = append(fixed, semver.MustParse(v))
+		}
+
+		require.Equal(t, tc.expected, AffectedRanges(fixed))
+	}
+}
+
+func TestReadMaps(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cve.yaml"), []byte(testCVEMap), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# maps"), 0o600))
+
+	cves, err := ReadMaps(dir)
+	require.NoError(t, err)
+	require.Len(t, cves, 1)
+	require.Equal(t, "CVE-2024-1234", cves[0].ID)
+	require.Equal(t, []int{100, 101, 102}, cves[0].LinkedPRs)
+
+	// Invalid CVE data
+	invalid := filepath.Join(dir, "invalid.yml")
+	require.NoError(t, os.WriteFile(invalid, []byte("pr: 1\ndatafields:\n  cve:\n    id: CVE-1\n"), 0o600))
+	_, err = ReadMaps(invalid)
+	require.Error(t, err)
+
+	_, err = ReadMaps(filepath.Join(dir, "missing.yaml"))
+	require.Error(t, err)
+}
+
+func TestOSV(t *testing.T) {
+	t.Parallel()
+
+	doc := testExporter().OSV(testCVE(), []semver.Version{
+		semver.MustParse("1.29.5"), semver.MustParse("1.30.2"),
+	})
+
+	require.Equal(t, OSVSchemaVersion, doc.SchemaVersion)
+	require.Equal(t, "CVE-2024-1234", doc.ID)
+	require.Equal(t, "2024-05-01T12:00:00Z", doc.Modified)
+	require.Equal(t, []OSVSeverity{{
+		Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+	}}, doc.Severity)
+	require.Equal(t, []OSVAffected{{
+		Package: OSVPackage{Ecosystem: "Go", Name: "k8s.io/kubernetes"},
+		Ranges: []OSVRange{{
+			Type: "SEMVER",
+			Events: []OSVEvent{
+				{Introduced: "0"}, {Fixed: "1.29.5"},
+				{Introduced: "1.30.0"}, {Fixed: "1.30.2"},
+			},
+		}},
+	}}, doc.Affected)
+	require.Contains(t, doc.References, OSVReference{
+		Type: "FIX", URL: "https://github.com/kubernetes/kubernetes/pull/101",
+	})
+	require.Contains(t, doc.References, OSVReference{
+		Type: "REPORT", URL: "https://github.com/kubernetes/kubernetes/issues/99",
+	})
+
+	cve := testCVE()
+	cve.CVSSVector = "CVSS:4.0/AV:N/AC:H/AT:P/PR:H/UI:A/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+	require.Equal(t, []OSVSeverity{{
+		Type: "CVSS_V4", Score: cve.CVSSVector,
+	}}, testExporter().OSV(cve, nil).Severity)
+
+	cve = testCVE()
+	cve.Published = "2020-05-28"
+	doc = testExporter().OSV(cve, nil)
+	require.Equal(t, "2020-05-28T00:00:00Z", doc.Published)
+	require.Equal(t, "2024-05-01T12:00:00Z", doc.Modified)
+}
+
+func TestCSAF(t *testing.T) {
+	t.Parallel()
+
+	doc := testExporter().CSAF(testCVE(), []semver.Version{
+		semver.MustParse("1.29.5"), semver.MustParse("1.30.2"),
+	})
+
+	require.Equal(t, "csaf_vex", doc.Document.Category)
+	require.Equal(t, CSAFVersion, doc.Document.CSAFVersion)
+	require.Equal(t, "CVE-2024-1234", doc.Document.Tracking.ID)
+	require.Equal(t, "2024-05-01T12:00:00Z", doc.Document.Tracking.CurrentReleaseDate)
+
+	versions := doc.ProductTree.Branches[0].Branches[0].Branches
+	require.Len(t, versions, 4)
+	require.Equal(t, "vers:semver/<1.29.5", versions[0].Name)
+	require.Equal(t, "vers:semver/>=1.30.0|<1.30.2", versions[2].Name)
+	require.Equal(t, "1.30.2", versions[3].Name)
+
+	require.Len(t, doc.Vulnerabilities, 1)
+	vuln := doc.Vulnerabilities[0]
+	require.Equal(t, "CVE-2024-1234", vuln.CVE)
+	require.Equal(t, CSAFProductStatus{
+		Fixed:         []string{"kubernetes@1.29.5", "kubernetes@1.30.2"},
+		KnownAffected: []string{"kubernetes@<1.29.5", "kubernetes@>=1.30.0|<1.30.2"},
+	}, vuln.ProductStatus)
+	require.Equal(t, &CSAFCVSS{
+		Version:      "3.1",
+		VectorString: "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+		BaseScore:    6.4,
+		BaseSeverity: "MEDIUM",
+	}, vuln.Scores[0].CVSSV3)
+	require.Len(t, vuln.Remediations, 2)
+	require.Equal(t, "vendor_fix", vuln.Remediations[0].Category)
+
+	// Without fixed versions all versions are affected
+	vuln = testExporter().CSAF(testCVE(), nil).Vulnerabilities[0]
+	require.Empty(t, vuln.ProductStatus.Fixed)
+	require.Equal(t, []string{"kubernetes@*"}, vuln.ProductStatus.KnownAffected)
+	require.Equal(t, "none_available", vuln.Remediations[0].Category)
+
+	// CVSS v4.0 scores are not supported by CSAF 2.0
+	cve := testCVE()
+	cve.CVSSVector = "CVSS:4.0/AV:N/AC:H/AT:P/PR:H/UI:A/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+	require.Empty(t, testExporter().CSAF(cve, nil).Vulnerabilities[0].Scores)
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "commit.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	git("init", "-q", "-b", "master")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+	git("tag", "v1.29.0")
+	git("checkout", "-q", "-b", "release-1.29")
+	git("commit", "-q", "--allow-empty", "-m", "Merge pull request #101 from user/cherry-pick\n\nFix CVE")
+	git("tag", "v1.29.1")
+	git("tag", "v1.29.2")
+	git("checkout", "-q", "master")
+	git("commit", "-q", "--allow-empty", "-m", "Fix CVE on master (#100)")
+	git("tag", "v1.30.0-rc.0")
+	git("tag", "v1.30.0")
+
+	// Fix which is only released on a release branch yet
+	git("checkout", "-q", "release-1.29")
+	git("commit", "-q", "--allow-empty", "-m",
+		"Merge pull request #201 from robot/automated-cherry-pick-of-#200-upstream-release-1.29\n\n"+
+			"Automated cherry pick of #200: Fix another CVE")
+	git("tag", "v1.29.3")
+	git("checkout", "-q", "master")
+	git("commit", "-q", "--allow-empty", "-m", "Fix another CVE (#200)")
+
+	fixed, err := FixedVersions(dir, "v", []int{100, 101, 999})
+	require.NoError(t, err)
+	require.Equal(t, []semver.Version{
+		semver.MustParse("1.29.1"), semver.MustParse("1.30.0"),
+	}, fixed)
+
+	fixed, err = FixedVersions(dir, "v", []int{200})
+	require.NoError(t, err)
+	require.Equal(t, []semver.Version{semver.MustParse("1.29.3")}, fixed)
+
+	fixed, err = FixedVersions(dir, "v", []int{20})
+	require.NoError(t, err)
+	require.Empty(t, fixed)
+
+	for _, format := range ExportFormats() {
+		exporter := testExporter()
+		exporter.options.Format = format
+		exporter.options.RepoPath = dir
+		exporter.options.OutputDir = t.TempDir()
+		require.NoError(t, exporter.Export([]*CVE{testCVE()}))
+
+		files, err := filepath.Glob(filepath.Join(exporter.options.OutputDir, "*.json"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		content, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+
+		if format == FormatOSV {
+			doc := &OSVDocument{}
+			require.NoError(t, json.Unmarshal(content, doc))
+			require.Equal(t, []OSVEvent{{Introduced: "0"}, {Fixed: "1.29.1"}}, doc.Affected[0].Ranges[0].Events)
+		} else {
+			require.Equal(t, "cve-2024-1234.json", filepath.Base(files[0]))
+		}
+	}
+
+	// The publication date of existing OSV documents is kept
+	exporter := testExporter()
+	exporter.options.OutputDir = t.TempDir()
+	require.NoError(t, exporter.Export([]*CVE{testCVE()}))
+
+	exporter.options.Timestamp = exporter.options.Timestamp.Add(24 * time.Hour)
+	require.NoError(t, exporter.Export([]*CVE{testCVE()}))
+
+	content, err := os.ReadFile(filepath.Join(exporter.options.OutputDir, "CVE-2024-1234.json"))
+	require.NoError(t, err)
+
+	doc := &OSVDocument{}
+	require.NoError(t, json.Unmarshal(content, doc))
+	require.Equal(t, "2024-05-01T12:00:00Z", doc.Published)
+	require.Equal(t, "2024-05-02T12:00:00Z", doc.Modified)
+
+	exporter = testExporter()
+	exporter.options.Format = "invalid"
+	exporter.options.OutputDir = t.TempDir()
+	require.Error(t, exporter.Export([]*CVE{testCVE()}))
+}
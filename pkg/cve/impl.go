@@ -27,6 +27,7 @@ import (
 
 	"cloud.google.com/go/storage"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/api/iterator"
 	"gopkg.in/yaml.v2"
 
 	"sigs.k8s.io/release-sdk/object"
@@ -45,6 +46,7 @@ type ClientImplementation interface {
 	ValidateCVEMap(string, string, *ClientOptions) error
 	CreateEmptyFile(string, *ClientOptions) (*os.File, error)
 	EntryExists(string, *ClientOptions) (bool, error)
+	ListEntries(*ClientOptions) ([]string, error)
 }
 
 // defaultClientImplementation.
@@ -311,3 +313,40 @@ func (impl *defaultClientImplementation) EntryExists(
 
 	return gcs.PathExists(path)
 }
+
+// ListEntries returns the IDs of all CVE maps in the bucket.
+func (impl *defaultClientImplementation) ListEntries(opts *ClientOptions) ([]string, error) {
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"fetching gcloud credentials, try running "+
+				`"gcloud auth application-default login: %w"`,
+			err,
+		)
+	}
+	defer client.Close()
+
+	it := client.Bucket(opts.Bucket).Objects(context.Background(), &storage.Query{
+		Prefix:    strings.Trim(opts.Directory, "/") + "/",
+		Delimiter: "/",
+	})
+
+	ids := []string{}
+
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing CVE maps in bucket %s: %w", opts.Bucket, err)
+		}
+
+		if id, ok := entryID(filepath.Base(attrs.Name)); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+)
+
+// OSVSchemaVersion is the implemented version of the OSV schema, see
+// https://ossf.github.io/osv-schema.
+const OSVSchemaVersion = "1.6.0"
+
+// OSVDocument is an Open Source Vulnerability document.
+type OSVDocument struct {
+	SchemaVersion    string         `json:"schema_version"`
+	ID               string         `json:"id"`
+	Modified         string         `json:"modified"`
+	Published        string         `json:"published"`
+	Summary          string         `json:"summary,omitempty"`
+	Details          string         `json:"details,omitempty"`
+	Severity         []OSVSeverity  `json:"severity,omitempty"`
+	Affected         []OSVAffected  `json:"affected"`
+	References       []OSVReference `json:"references,omitempty"`
+	DatabaseSpecific map[string]any `json:"database_specific,omitempty"`
+}
+
+// OSVSeverity is the CVSS severity of an OSV document.
+type OSVSeverity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// OSVAffected is an affected package of an OSV document.
+type OSVAffected struct {
+	Package OSVPackage `json:"package"`
+	Ranges  []OSVRange `json:"ranges"`
+}
+
+// OSVPackage identifies the affected package.
+type OSVPackage struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// OSVRange is a range of affected versions.
+type OSVRange struct {
+	Type   string     `json:"type"`
+	Events []OSVEvent `json:"events"`
+}
+
+// OSVEvent is a single introduced or fixed event of an affected range.
+type OSVEvent struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// OSVReference is a reference of an OSV document.
+type OSVReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// OSV converts a CVE and its fixed versions into an OSV document. The
+// document is published at the published date of the CVE, if set.
+func (e *Exporter) OSV(cve *CVE, fixed []semver.Version) *OSVDocument {
+	ts := e.timestamp()
+
+	published := ts
+	if t, err := cve.publishedTime(); err == nil && !t.IsZero() {
+		published = t.UTC().Format(time.RFC3339)
+	}
+
+	doc := &OSVDocument{
+		SchemaVersion: OSVSchemaVersion,
+		ID:            cve.ID,
+		Modified:      ts,
+		Published:     published,
+		Summary:       cve.Title,
+		Details:       cve.Description,
+		Severity: []OSVSeverity{{
+			Type:  osvSeverityType(cve.CVSSVector),
+			Score: cve.CVSSVector,
+		}},
+		References: []OSVReference{{
+			Type: "ADVISORY",
+			URL:  "https://www.cve.org/CVERecord?id=" + cve.ID,
+		}},
+		DatabaseSpecific: map[string]any{
+			"cvss_score": cve.CVSSScore,
+			"severity":   strings.ToUpper(cve.CVSSRating),
+		},
+	}
+
+	if cve.TrackingIssue != "" {
+		doc.References = append(doc.References, OSVReference{Type: "REPORT", URL: cve.TrackingIssue})
+	}
+
+	for _, pr := range cve.LinkedPRs {
+		doc.References = append(doc.References, OSVReference{Type: "FIX", URL: e.prURL(pr)})
+	}
+
+	osvRange := OSVRange{Type: "SEMVER", Events: []OSVEvent{}}
+	for _, r := range AffectedRanges(fixed) {
+		osvRange.Events = append(osvRange.Events, OSVEvent{Introduced: r.Introduced})
+		if r.Fixed != "" {
+			osvRange.Events = append(osvRange.Events, OSVEvent{Fixed: r.Fixed})
+		}
+	}
+
+	doc.Affected = []OSVAffected{{
+		Package: OSVPackage{Ecosystem: e.options.Ecosystem, Name: e.options.Package},
+		Ranges:  []OSVRange{osvRange},
+	}}
+
+	return doc
+}
+
+// osvSeverityType returns the OSV severity type of a CVSS vector string.
+func osvSeverityType(vector string) string {
+	if cvssVersion(vector) == CVSSVersion4 {
+		return "CVSS_V4"
+	}
+
+	return "CVSS_V3"
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/object"
+	"sigs.k8s.io/release-utils/command"
+	"sigs.k8s.io/release-utils/util"
+)
+
+const (
+	// StorageGCS stores the CVE maps in a Google Cloud Storage bucket.
+	StorageGCS = "gcs"
+
+	// StorageLocal stores the CVE maps in a local directory.
+	StorageLocal = "local"
+
+	// StorageGit stores the CVE maps in a directory of a local git
+	// repository and commits every change.
+	StorageGit = "git"
+)
+
+// StorageBackends returns all supported storage backends.
+func StorageBackends() []string {
+	return []string{StorageGCS, StorageLocal, StorageGit}
+}
+
+// Storage is the backend storing the CVE maps.
+//
+//counterfeiter:generate . Storage
+type Storage interface {
+	// Write stores the map file of a CVE.
+	Write(cveID, mapPath string) error
+
+	// Delete removes the map of a CVE.
+	Delete(cveID string) error
+
+	// CopyToTemp copies the map of a CVE into a temporary file.
+	CopyToTemp(cveID string) (*os.File, error)
+
+	// Exists returns true if a map of the CVE is stored.
+	Exists(cveID string) (bool, error)
+
+	// List returns the IDs of all stored CVE maps.
+	List() ([]string, error)
+}
+
+// newStorage creates the storage backend defined in the client options.
+func newStorage(impl ClientImplementation, opts *ClientOptions) (Storage, error) {
+	switch opts.Storage {
+	case StorageGCS, "":
+		return &gcsStorage{impl: impl, options: opts}, nil
+	case StorageLocal:
+		if opts.Path == "" {
+			return nil, errors.New("no directory provided for the local CVE storage")
+		}
+
+		return &localStorage{dir: opts.Path}, nil
+	case StorageGit:
+		if opts.Path == "" {
+			return nil, errors.New("no directory provided for the git CVE storage")
+		}
+
+		if _, err := command.NewWithWorkDir(
+			opts.Path, gitExecutable, "rev-parse", "--is-inside-work-tree",
+		).RunSilentSuccessOutput(); err != nil {
+			return nil, fmt.Errorf("checking if %s is a git repository: %w", opts.Path, err)
+		}
+
+		return &gitStorage{localStorage: localStorage{dir: opts.Path}}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported CVE storage %q, must be one of %v", opts.Storage, StorageBackends(),
+		)
+	}
+}
+
+// entryID returns the CVE ID of a map file name, like `CVE-2024-1234` for
+// `CVE-2024-1234.yaml`.
+func entryID(filename string) (string, bool) {
+	id, found := strings.CutSuffix(filename, mapExt)
+	if !found || ValidateID(id) != nil {
+		return "", false
+	}
+
+	return id, true
+}
+
+// gcsStorage stores the CVE maps in a Google Cloud Storage bucket.
+type gcsStorage struct {
+	impl    ClientImplementation
+	options *ClientOptions
+}
+
+// path returns the bucket path of a CVE map.
+func (s *gcsStorage) path(cveID string) string {
+	return object.GcsPrefix + filepath.Join(
+		s.options.Bucket, s.options.Directory, cveID+mapExt,
+	)
+}
+
+// Write copies a map into the bucket.
+func (s *gcsStorage) Write(cveID, mapPath string) error {
+	if err := s.impl.CopyFile(mapPath, s.path(cveID), s.options); err != nil {
+		return fmt.Errorf("writing %s map file to CVE bucket: %w", cveID, err)
+	}
+
+	return nil
+}
+
+// Delete removes a map from the bucket.
+func (s *gcsStorage) Delete(cveID string) error {
+	return s.impl.DeleteFile(s.path(cveID), s.options)
+}
+
+// CopyToTemp downloads a map into a temporary file.
+func (s *gcsStorage) CopyToTemp(cveID string) (*os.File, error) {
+	return s.impl.CopyToTemp(cveID, s.options)
+}
+
+// Exists checks if a map exists in the bucket.
+func (s *gcsStorage) Exists(cveID string) (bool, error) {
+	return s.impl.EntryExists(cveID, s.options)
+}
+
+// List returns the IDs of all maps in the bucket.
+func (s *gcsStorage) List() ([]string, error) {
+	return s.impl.ListEntries(s.options)
+}
+
+// localStorage stores the CVE maps in a local directory.
+type localStorage struct {
+	dir string
+}
+
+// path returns the local path of a CVE map.
+func (s *localStorage) path(cveID string) string {
+	return filepath.Join(s.dir, cveID+mapExt)
+}
+
+// Write copies a map into the directory.
+func (s *localStorage) Write(cveID, mapPath string) error {
+	if err := os.MkdirAll(s.dir, os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("creating CVE directory: %w", err)
+	}
+
+	if err := util.CopyFileLocal(mapPath, s.path(cveID), true); err != nil {
+		return fmt.Errorf("writing %s map file to %s: %w", cveID, s.dir, err)
+	}
+
+	return nil
+}
+
+// Delete removes a map from the directory.
+func (s *localStorage) Delete(cveID string) error {
+	exists, err := s.Exists(cveID)
+	if err != nil {
+		return fmt.Errorf("checking if cve entry exists: %w", err)
+	}
+
+	if !exists {
+		return errors.New("specified CVE entry not found")
+	}
+
+	if err := os.Remove(s.path(cveID)); err != nil {
+		return fmt.Errorf("deleting %s map file: %w", cveID, err)
+	}
+
+	return nil
+}
+
+// CopyToTemp copies a map into a temporary file.
+func (s *localStorage) CopyToTemp(cveID string) (*os.File, error) {
+	dir, err := os.MkdirTemp(os.TempDir(), "cve-maps-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir: %w", err)
+	}
+
+	dest := filepath.Join(dir, cveID+mapExt)
+	if err := util.CopyFileLocal(s.path(cveID), dest, true); err != nil {
+		return nil, fmt.Errorf("copying CVE %s to tempfile: %w", cveID, err)
+	}
+
+	return os.Open(dest)
+}
+
+// Exists checks if a map exists in the directory.
+func (s *localStorage) Exists(cveID string) (bool, error) {
+	if err := ValidateID(cveID); err != nil {
+		return false, fmt.Errorf("checking CVE ID string: %w", err)
+	}
+
+	if _, err := os.Stat(s.path(cveID)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("checking CVE map file: %w", err)
+	}
+
+	return true, nil
+}
+
+// List returns the IDs of all maps in the directory.
+func (s *localStorage) List() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+
+		return nil, fmt.Errorf("reading CVE directory: %w", err)
+	}
+
+	ids := []string{}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		if id, ok := entryID(entry.Name()); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// gitStorage stores the CVE maps in a directory of a local git repository.
+// Changes are committed but never pushed, which allows managing embargoed
+// CVE data offline.
+type gitStorage struct {
+	localStorage
+}
+
+// Write copies a map into the repository and commits it.
+func (s *gitStorage) Write(cveID, mapPath string) error {
+	exists, err := s.Exists(cveID)
+	if err != nil {
+		return fmt.Errorf("checking if cve entry exists: %w", err)
+	}
+
+	if err := s.localStorage.Write(cveID, mapPath); err != nil {
+		return err
+	}
+
+	action := "Add"
+	if exists {
+		action = "Update"
+	}
+
+	return s.commit(cveID, fmt.Sprintf("%s %s map", action, cveID))
+}
+
+// Delete removes a map from the repository and commits the deletion.
+func (s *gitStorage) Delete(cveID string) error {
+	if err := s.localStorage.Delete(cveID); err != nil {
+		return err
+	}
+
+	return s.commit(cveID, fmt.Sprintf("Delete %s map", cveID))
+}
+
+// commit stages and commits the map of a CVE if it changed.
+func (s *gitStorage) commit(cveID, message string) error {
+	filename := cveID + mapExt
+
+	if _, err := command.NewWithWorkDir(
+		s.dir, gitExecutable, "add", "--", filename,
+	).RunSilentSuccessOutput(); err != nil {
+		return fmt.Errorf("staging %s: %w", filename, err)
+	}
+
+	status, err := command.NewWithWorkDir(
+		s.dir, gitExecutable, "status", "--porcelain", "--", filename,
+	).RunSilentSuccessOutput()
+	if err != nil {
+		return fmt.Errorf("checking status of %s: %w", filename, err)
+	}
+
+	if status.OutputTrimNL() == "" {
+		logrus.Infof("CVE map %s not modified, nothing to commit", filename)
+
+		return nil
+	}
+
+	if _, err := command.NewWithWorkDir(
+		s.dir, gitExecutable, "commit", "-m", message, "--", filename,
+	).RunSilentSuccessOutput(); err != nil {
+		return fmt.Errorf("committing %s: %w", filename, err)
+	}
+
+	logrus.Infof("Committed %s to the git repository in %s", filename, s.dir)
+
+	return nil
+}
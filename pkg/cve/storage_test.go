@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cve
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testStorageMap = `---
+pr: 100
+datafields:
+  cve:
+    id: CVE-2024-1234
+    title: Node escape via crafted volume
+    vector: CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H
+    score: 6.4
+    rating: Medium
+    description: A crafted volume allows escaping to the node.
+`
+
+func TestNewClientWithOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		opts      ClientOptions
+		shouldErr bool
+	}{
+		{opts: *DefaultClientOptions()},
+		{opts: ClientOptions{Storage: StorageLocal, Path: t.TempDir()}},
+		{opts: ClientOptions{Storage: StorageLocal}, shouldErr: true},
+		{opts: ClientOptions{Storage: StorageGit}, shouldErr: true},
+		{opts: ClientOptions{Storage: StorageGit, Path: t.TempDir()}, shouldErr: true},
+		{opts: ClientOptions{Storage: "invalid"}, shouldErr: true},
+	} {
+		_, err := NewClientWithOptions(&tc.opts)
+		if tc.shouldErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestLocalStorage(t *testing.T) {
+	t.Parallel()
+
+	mapFile := filepath.Join(t.TempDir(), "map.yaml")
+	require.NoError(t, os.WriteFile(mapFile, []byte(testStorageMap), 0o600))
+
+	dir := filepath.Join(t.TempDir(), "cve")
+	client, err := NewClientWithOptions(&ClientOptions{Storage: StorageLocal, Path: dir})
+	require.NoError(t, err)
+
+	// Missing directory
+	ids, err := client.List()
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	exists, err := client.EntryExists("CVE-2024-1234")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = client.Read("CVE-2024-1234")
+	require.Error(t, err)
+
+	// Map with a different CVE ID
+	require.Error(t, client.Write("CVE-2024-9999", mapFile))
+	require.NoError(t, client.Write("CVE-2024-1234", mapFile))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# maps"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CVE-2023-1.yaml"), []byte(testStorageMap), 0o600))
+
+	ids, err = client.List()
+	require.NoError(t, err)
+	require.Equal(t, []string{"CVE-2023-1", "CVE-2024-1234"}, ids)
+
+	content, err := client.Read("CVE-2024-1234")
+	require.NoError(t, err)
+	require.Equal(t, testStorageMap, string(content))
+
+	file, err := client.CopyToTemp("CVE-2024-1234")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, os.RemoveAll(filepath.Dir(file.Name())))
+
+	require.NoError(t, client.Delete("CVE-2024-1234"))
+	require.NoFileExists(t, filepath.Join(dir, "CVE-2024-1234.yaml"))
+	require.Error(t, client.Delete("CVE-2024-1234"))
+}
+
+func TestGitStorage(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		return strings.TrimSpace(string(out))
+	}
+
+	git("init", "-q", "-b", "main")
+	git("config", "user.name", "test")
+	git("config", "user.email", "test@example.com")
+	git("config", "commit.gpgsign", "false")
+
+	mapFile := filepath.Join(t.TempDir(), "map.yaml")
+	require.NoError(t, os.WriteFile(mapFile, []byte(testStorageMap), 0o600))
+
+	client, err := NewClientWithOptions(&ClientOptions{Storage: StorageGit, Path: dir})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Write("CVE-2024-1234", mapFile))
+	require.Equal(t, "Add CVE-2024-1234 map", git("log", "-1", "--format=%s"))
+
+	// Unchanged maps are not committed
+	require.NoError(t, client.Write("CVE-2024-1234", mapFile))
+	require.Equal(t, "1", git("rev-list", "--count", "HEAD"))
+
+	require.NoError(t, os.WriteFile(mapFile, []byte(strings.Replace(testStorageMap, "pr: 100", "pr: 101", 1)), 0o600))
+	require.NoError(t, client.Write("CVE-2024-1234", mapFile))
+	require.Equal(t, "Update CVE-2024-1234 map", git("log", "-1", "--format=%s"))
+
+	ids, err := client.List()
+	require.NoError(t, err)
+	require.Equal(t, []string{"CVE-2024-1234"}, ids)
+
+	require.NoError(t, client.Delete("CVE-2024-1234"))
+	require.Equal(t, "Delete CVE-2024-1234 map", git("log", "-1", "--format=%s"))
+	require.Empty(t, git("status", "--porcelain"))
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"k8s.io/release/pkg/release"
 )
 
 type FakeHistoryImpl struct {
@@ -39,6 +40,19 @@ type FakeHistoryImpl struct {
 		result1 []*cloudbuild.Build
 		result2 error
 	}
+	GetRunReportStub        func(string) (*release.RunReport, error)
+	getRunReportMutex       sync.RWMutex
+	getRunReportArgsForCall []struct {
+		arg1 string
+	}
+	getRunReportReturns struct {
+		result1 *release.RunReport
+		result2 error
+	}
+	getRunReportReturnsOnCall map[int]struct {
+		result1 *release.RunReport
+		result2 error
+	}
 	ParseTimeStub        func(string, string) (time.Time, error)
 	parseTimeMutex       sync.RWMutex
 	parseTimeArgsForCall []struct {
@@ -122,6 +136,70 @@ func (fake *FakeHistoryImpl) GetJobsByTagReturnsOnCall(i int, result1 []*cloudbu
 	}{result1, result2}
 }
 
+func (fake *FakeHistoryImpl) GetRunReport(arg1 string) (*release.RunReport, error) {
+	fake.getRunReportMutex.Lock()
+	ret, specificReturn := fake.getRunReportReturnsOnCall[len(fake.getRunReportArgsForCall)]
+	fake.getRunReportArgsForCall = append(fake.getRunReportArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRunReportStub
+	fakeReturns := fake.getRunReportReturns
+	fake.recordInvocation("GetRunReport", []interface{}{arg1})
+	fake.getRunReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHistoryImpl) GetRunReportCallCount() int {
+	fake.getRunReportMutex.RLock()
+	defer fake.getRunReportMutex.RUnlock()
+	return len(fake.getRunReportArgsForCall)
+}
+
+func (fake *FakeHistoryImpl) GetRunReportCalls(stub func(string) (*release.RunReport, error)) {
+	fake.getRunReportMutex.Lock()
+	defer fake.getRunReportMutex.Unlock()
+	fake.GetRunReportStub = stub
+}
+
+func (fake *FakeHistoryImpl) GetRunReportArgsForCall(i int) string {
+	fake.getRunReportMutex.RLock()
+	defer fake.getRunReportMutex.RUnlock()
+	argsForCall := fake.getRunReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHistoryImpl) GetRunReportReturns(result1 *release.RunReport, result2 error) {
+	fake.getRunReportMutex.Lock()
+	defer fake.getRunReportMutex.Unlock()
+	fake.GetRunReportStub = nil
+	fake.getRunReportReturns = struct {
+		result1 *release.RunReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHistoryImpl) GetRunReportReturnsOnCall(i int, result1 *release.RunReport, result2 error) {
+	fake.getRunReportMutex.Lock()
+	defer fake.getRunReportMutex.Unlock()
+	fake.GetRunReportStub = nil
+	if fake.getRunReportReturnsOnCall == nil {
+		fake.getRunReportReturnsOnCall = make(map[int]struct {
+			result1 *release.RunReport
+			result2 error
+		})
+	}
+	fake.getRunReportReturnsOnCall[i] = struct {
+		result1 *release.RunReport
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeHistoryImpl) ParseTime(arg1 string, arg2 string) (time.Time, error) {
 	fake.parseTimeMutex.Lock()
 	ret, specificReturn := fake.parseTimeReturnsOnCall[len(fake.parseTimeArgsForCall)]
@@ -192,6 +270,8 @@ func (fake *FakeHistoryImpl) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getJobsByTagMutex.RLock()
 	defer fake.getJobsByTagMutex.RUnlock()
+	fake.getRunReportMutex.RLock()
+	defer fake.getRunReportMutex.RUnlock()
 	fake.parseTimeMutex.RLock()
 	defer fake.parseTimeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -19,6 +19,9 @@ package gcb
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -27,6 +30,7 @@ import (
 	"google.golang.org/api/cloudbuild/v1"
 
 	"sigs.k8s.io/release-sdk/git"
+	"sigs.k8s.io/release-sdk/object"
 
 	"k8s.io/release/pkg/gcp/build"
 	"k8s.io/release/pkg/release"
@@ -64,12 +68,17 @@ type HistoryOptions struct {
 
 	// DateTo is the string date for selecting the end of the range.
 	DateTo string
+
+	// Reports enables printing the step durations from the stage and release
+	// run reports stored in the staging bucket.
+	Reports bool
 }
 
 //counterfeiter:generate . historyImpl
 type historyImpl interface {
 	ParseTime(layout, value string) (time.Time, error)
 	GetJobsByTag(project, tagsFilter string) ([]*cloudbuild.Build, error)
+	GetRunReport(gcsPath string) (*release.RunReport, error)
 }
 
 type defaultHistoryImpl struct{}
@@ -84,6 +93,21 @@ func (*defaultHistoryImpl) GetJobsByTag(
 	return build.GetJobsByTag(project, tagsFilter)
 }
 
+func (*defaultHistoryImpl) GetRunReport(gcsPath string) (*release.RunReport, error) {
+	tempDir, err := os.MkdirTemp("", "run-report-")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	dst := filepath.Join(tempDir, filepath.Base(gcsPath))
+	if err := object.NewGCS().CopyToLocal(gcsPath, dst); err != nil {
+		return nil, fmt.Errorf("copy run report from %s: %w", gcsPath, err)
+	}
+
+	return release.ReadRunReport(dst)
+}
+
 // NewHistoryOptions creates a new default HistoryOptions instance.
 func NewHistoryOptions() *HistoryOptions {
 	return &HistoryOptions{
@@ -126,6 +150,8 @@ func (h *History) Run() error {
 
 	table.SetHeader([]string{"Step", "Command", "Link", "Start", "Duration", "Succeeded?"})
 
+	reports := &strings.Builder{}
+
 	for i := len(jobs) - 1; i >= 0; i-- {
 		job := jobs[i]
 		subcommand := ""
@@ -187,6 +213,10 @@ func (h *History) Run() error {
 			step, command, logs, start,
 			out.Format("15:04:05"), status[job.Status],
 		})
+
+		if h.opts.Reports {
+			h.writeRunReport(reports, job, step, subcommand)
+		}
 	}
 
 	table.SetBorders(tablewriter.Border{
@@ -196,10 +226,61 @@ func (h *History) Run() error {
 	table.Render()
 
 	fmt.Print(tableString.String())
+	fmt.Print(reports.String())
 
 	return nil
 }
 
+// writeRunReport renders the step durations of the run report belonging to
+// the job. Missing reports are only logged, because they do not exist for
+// older runs.
+func (h *History) writeRunReport(
+	w io.Writer, job *cloudbuild.Build, step, subcommand string,
+) {
+	buildVersion := job.Substitutions["_BUILDVERSION"]
+	if buildVersion == "" {
+		return
+	}
+
+	bucket := release.TestBucket
+	if job.Substitutions["_NOMOCK"] != "" {
+		bucket = release.ProductionBucket
+	}
+
+	reportFile := release.StageReportFilename
+	if subcommand == release.RunTypeRelease {
+		reportFile = release.ReleaseReportFilename
+	}
+
+	gcsPath := object.GcsPrefix + filepath.Join(
+		bucket, release.StagePath, buildVersion, reportFile,
+	)
+
+	report, err := h.impl.GetRunReport(gcsPath)
+	if err != nil {
+		logrus.Warnf("Unable to get run report for job %s: %v", job.Id, err)
+
+		return
+	}
+
+	table := tablewriter.NewWriter(w)
+	table.SetAutoWrapText(false)
+	table.SetHeader([]string{"Step", "Outcome", "Duration"})
+
+	for _, s := range report.Steps {
+		out := time.Time{}.Add(time.Duration(s.DurationSeconds * float64(time.Second)))
+		table.Append([]string{s.Name, s.Outcome, out.Format("15:04:05")})
+	}
+
+	table.SetBorders(tablewriter.Border{
+		Left: true, Top: false, Right: true, Bottom: false,
+	})
+	table.SetCenterSeparator("|")
+
+	fmt.Fprintf(w, "\n%s %s (%s):\n\n", step, buildVersion, job.Id)
+	table.Render()
+}
+
 func (h *History) parseDateRange() (from, to string, err error) {
 	if h.opts.DateFrom == "" {
 		return "", "", errors.New("need to specify a start date")
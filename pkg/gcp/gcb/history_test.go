@@ -26,6 +26,7 @@ import (
 
 	"k8s.io/release/pkg/gcp/gcb"
 	"k8s.io/release/pkg/gcp/gcb/gcbfakes"
+	"k8s.io/release/pkg/release"
 )
 
 func TestHistoryRun(t *testing.T) {
@@ -63,6 +64,33 @@ func TestHistoryRun(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{ // success with reports
+			options: &gcb.HistoryOptions{
+				DateFrom: "2020-11-11",
+				Reports:  true,
+			},
+			prepare: func(mock *gcbfakes.FakeHistoryImpl) {
+				build := func(tag string) *cloudbuild.Build {
+					return &cloudbuild.Build{
+						Tags: []string{tag},
+						Timing: map[string]cloudbuild.TimeSpan{
+							"BUILD": {StartTime: "2020-10-10", EndTime: "2020-10-10"},
+						},
+						Substitutions: map[string]string{
+							"_BUILDVERSION": "v1.20.0-beta.1.203+8f6ffb24df9896",
+						},
+					}
+				}
+				mock.GetJobsByTagReturns([]*cloudbuild.Build{
+					build("STAGE"), build("RELEASE"),
+				}, nil)
+				report := release.NewRunReport(release.RunTypeStage)
+				report.StartStep("build").Finish(release.StepOutcomeSucceeded, nil)
+				mock.GetRunReportReturnsOnCall(0, report, nil)
+				mock.GetRunReportReturnsOnCall(1, nil, err)
+			},
+			shouldErr: false,
+		},
 		{ // failure no from date
 			options:   &gcb.HistoryOptions{},
 			prepare:   func(*gcbfakes.FakeHistoryImpl) {},
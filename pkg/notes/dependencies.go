@@ -26,11 +26,15 @@ import (
 )
 
 type Dependencies struct {
-	moDiff MoDiff
+	moDiff   MoDiff
+	modCache string
 }
 
 func NewDependencies() *Dependencies {
-	return &Dependencies{&moDiff{}}
+	return &Dependencies{
+		moDiff:   &moDiff{},
+		modCache: defaultModCache(),
+	}
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -0,0 +1,415 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"errors"
+	"fmt"
+	"go/build"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	gitobject "github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+// LicenseUnknown is the license of a module which contains a license file
+// that could not be classified.
+const LicenseUnknown = "Unknown"
+
+// DependencyChange is the change of a single Go module between two revisions.
+type DependencyChange struct {
+	// Module is the path of the module as required in the go.mod file.
+	Module string `json:"module"`
+
+	// OldVersion is the version at the start revision, empty if added.
+	OldVersion string `json:"old_version,omitempty"`
+
+	// NewVersion is the version at the end revision, empty if removed.
+	NewVersion string `json:"new_version,omitempty"`
+
+	// Direct indicates if the module is a direct dependency, which means
+	// that it is not marked as `// indirect` in the go.mod file.
+	Direct bool `json:"direct"`
+
+	// OldLicense is the detected license at the start revision, empty if
+	// the module is not available in the local module cache.
+	OldLicense string `json:"old_license,omitempty"`
+
+	// NewLicense is the detected license at the end revision, empty if
+	// the module is not available in the local module cache.
+	NewLicense string `json:"new_license,omitempty"`
+
+	// oldPath and newPath are the module paths after applying replace
+	// directives, which are used to lookup the module cache.
+	oldPath, newPath string
+}
+
+// LicenseChanged returns true if the license could be detected for both
+// versions and differs between them.
+func (c *DependencyChange) LicenseChanged() bool {
+	return c.OldLicense != "" && c.NewLicense != "" && c.OldLicense != c.NewLicense
+}
+
+// DependencyReport contains all Go module changes between two revisions,
+// each sorted by module path.
+type DependencyReport struct {
+	Added   []*DependencyChange `json:"added"`
+	Removed []*DependencyChange `json:"removed"`
+	Updated []*DependencyChange `json:"updated"`
+}
+
+// LicenseChanges returns all updated modules which changed their license.
+func (r *DependencyReport) LicenseChanges() []*DependencyChange {
+	changes := []*DependencyChange{}
+	if r == nil {
+		return changes
+	}
+
+	for _, change := range r.Updated {
+		if change.LicenseChanged() {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// LicenseChangesMarkdown renders the license changes as a markdown
+// subsection of the dependencies section. It returns an empty string if no
+// license changed.
+func (r *DependencyReport) LicenseChangesMarkdown() string {
+	changes := r.LicenseChanges()
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("### License Changes\n")
+
+	for _, change := range changes {
+		fmt.Fprintf(&sb, "- %s: %s → %s (%s → %s)\n",
+			change.Module, change.OldVersion, change.NewVersion,
+			change.OldLicense, change.NewLicense,
+		)
+	}
+
+	return sb.String()
+}
+
+// goModule is a required module of a go.mod file after applying the replace
+// directives.
+type goModule struct {
+	version string
+	path    string
+	direct  bool
+}
+
+// SetModCache can be used to set the Go module cache directory which is used
+// to detect the licenses.
+func (d *Dependencies) SetModCache(dir string) {
+	d.modCache = dir
+}
+
+// Report compares the go.mod files between both provided revisions of the
+// local repository and detects license changes of the updated modules from
+// the local module cache.
+func (d *Dependencies) Report(repoPath, from, to string) (*DependencyReport, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening repository %s: %w", repoPath, err)
+	}
+
+	oldGoMod, err := goModAt(repo, from)
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod at %s: %w", from, err)
+	}
+
+	newGoMod, err := goModAt(repo, to)
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod at %s: %w", to, err)
+	}
+
+	report, err := DiffGoMod(oldGoMod, newGoMod)
+	if err != nil {
+		return nil, fmt.Errorf("comparing go.mod files: %w", err)
+	}
+
+	d.DetectLicenses(report)
+
+	return report, nil
+}
+
+// DetectLicenses sets the old and new licenses of all updated modules which
+// are available in the local module cache.
+func (d *Dependencies) DetectLicenses(report *DependencyReport) {
+	for _, change := range report.Updated {
+		change.OldLicense = d.license(change.oldPath, change.OldVersion)
+		change.NewLicense = d.license(change.newPath, change.NewVersion)
+	}
+}
+
+// goModAt returns the content of the go.mod file at the revision.
+func goModAt(repo *git.Repository, rev string) ([]byte, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("resolving revision: %w", err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("getting commit: %w", err)
+	}
+
+	file, err := commit.File("go.mod")
+	if errors.Is(err, gitobject.ErrFileNotFound) {
+		logrus.Debugf("No go.mod file found at %s", rev)
+
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("getting go.mod: %w", err)
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod: %w", err)
+	}
+
+	return []byte(content), nil
+}
+
+// DiffGoMod compares the required modules of two go.mod files. Modules which
+// are replaced by a local directory are not part of the report.
+func DiffGoMod(oldContent, newContent []byte) (*DependencyReport, error) {
+	oldModules, err := parseGoMod(oldContent)
+	if err != nil {
+		return nil, fmt.Errorf("parsing old go.mod: %w", err)
+	}
+
+	newModules, err := parseGoMod(newContent)
+	if err != nil {
+		return nil, fmt.Errorf("parsing new go.mod: %w", err)
+	}
+
+	report := &DependencyReport{
+		Added:   []*DependencyChange{},
+		Removed: []*DependencyChange{},
+		Updated: []*DependencyChange{},
+	}
+
+	paths := slices.Collect(maps.Keys(oldModules))
+	for path := range newModules {
+		if _, ok := oldModules[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		oldModule, oldOK := oldModules[path]
+		newModule, newOK := newModules[path]
+
+		switch {
+		case !oldOK:
+			report.Added = append(report.Added, &DependencyChange{
+				Module:     path,
+				NewVersion: newModule.version,
+				Direct:     newModule.direct,
+				newPath:    newModule.path,
+			})
+		case !newOK:
+			report.Removed = append(report.Removed, &DependencyChange{
+				Module:     path,
+				OldVersion: oldModule.version,
+				Direct:     oldModule.direct,
+				oldPath:    oldModule.path,
+			})
+		case oldModule.version != newModule.version || oldModule.path != newModule.path:
+			report.Updated = append(report.Updated, &DependencyChange{
+				Module:     path,
+				OldVersion: oldModule.version,
+				NewVersion: newModule.version,
+				Direct:     newModule.direct,
+				oldPath:    oldModule.path,
+				newPath:    newModule.path,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// parseGoMod returns the required modules of a go.mod file indexed by their
+// path.
+func parseGoMod(content []byte) (map[string]goModule, error) {
+	modules := map[string]goModule{}
+	if len(content) == 0 {
+		return modules, nil
+	}
+
+	file, err := modfile.Parse("go.mod", content, nil)
+	if err != nil {
+		return nil, fmt.Errorf("parsing go.mod: %w", err)
+	}
+
+	for _, require := range file.Require {
+		modules[require.Mod.Path] = goModule{
+			version: require.Mod.Version,
+			path:    require.Mod.Path,
+			direct:  !require.Indirect,
+		}
+	}
+
+	for _, replace := range file.Replace {
+		mod, ok := modules[replace.Old.Path]
+		if !ok || (replace.Old.Version != "" && replace.Old.Version != mod.version) {
+			continue
+		}
+
+		// Local directory replacements are part of the repository
+		if replace.New.Version == "" {
+			delete(modules, replace.Old.Path)
+
+			continue
+		}
+
+		mod.path = replace.New.Path
+		mod.version = replace.New.Version
+		modules[replace.Old.Path] = mod
+	}
+
+	return modules, nil
+}
+
+// license returns the detected license of the module version from the
+// module cache, or an empty string if the module is not available.
+func (d *Dependencies) license(path, version string) string {
+	if d.modCache == "" {
+		return ""
+	}
+
+	escapedPath, err := module.EscapePath(path)
+	if err != nil {
+		return ""
+	}
+
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return ""
+	}
+
+	dir := filepath.Join(d.modCache, escapedPath+"@"+escapedVersion)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		logrus.Debugf("Module %s@%s not found in module cache: %v", path, version, err)
+
+		return ""
+	}
+
+	for _, entry := range entries {
+		name := strings.ToUpper(entry.Name())
+		if entry.IsDir() || !(strings.HasPrefix(name, "LICENSE") ||
+			strings.HasPrefix(name, "LICENCE") ||
+			strings.HasPrefix(name, "COPYING")) {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			logrus.Debugf("Unable to read license file of %s@%s: %v", path, version, err)
+
+			return ""
+		}
+
+		return ClassifyLicense(string(content))
+	}
+
+	return LicenseUnknown
+}
+
+// licenseMatchers are the phrases to identify a license text. The order
+// matters, because for example the LGPL text references the GPL.
+var licenseMatchers = []struct {
+	license string
+	phrases []string
+}{
+	{"Apache-2.0", []string{"apache license", "version 2.0"}},
+	{"MPL-2.0", []string{"mozilla public license", "2.0"}},
+	{"AGPL-3.0", []string{"gnu affero general public license"}},
+	{"LGPL-3.0", []string{"gnu lesser general public license", "version 3"}},
+	{"LGPL-2.1", []string{"gnu lesser general public license"}},
+	{"GPL-3.0", []string{"gnu general public license", "version 3"}},
+	{"GPL-2.0", []string{"gnu general public license"}},
+	{"Unlicense", []string{"free and unencumbered software released into the public domain"}},
+	{"ISC", []string{"permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"MIT", []string{"permission is hereby granted, free of charge"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "names of its contributors"}},
+	{"BSD-2-Clause", []string{"redistribution and use in source and binary forms"}},
+}
+
+// ClassifyLicense returns the SPDX identifier of a license text or
+// LicenseUnknown if it cannot be classified.
+func ClassifyLicense(text string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(text), " "))
+
+	for _, matcher := range licenseMatchers {
+		matches := true
+
+		for _, phrase := range matcher.phrases {
+			if !strings.Contains(normalized, phrase) {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			return matcher.license
+		}
+	}
+
+	return LicenseUnknown
+}
+
+// defaultModCache returns the Go module cache directory of the environment.
+func defaultModCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = build.Default.GOPATH
+	}
+
+	if list := filepath.SplitList(gopath); len(list) > 0 && list[0] != "" {
+		return filepath.Join(list[0], "pkg", "mod")
+	}
+
+	return ""
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGoModOld = `module k8s.io/kubernetes
+
+go 1.23
+
+require (
+	github.com/example/direct v1.0.0
+	github.com/example/License v1.0.0
+	github.com/example/removed v0.1.0 // indirect
+	k8s.io/api v0.0.0
+)
+
+replace k8s.io/api => ./staging/src/k8s.io/api
+`
+
+	testGoModNew = `module k8s.io/kubernetes
+
+go 1.23
+
+require (
+	github.com/example/direct v1.1.0
+	github.com/example/License v2.0.0+incompatible
+	github.com/example/added v0.2.0 // indirect
+	github.com/example/replaced v1.0.0
+	k8s.io/api v0.0.0
+)
+
+replace (
+	github.com/example/replaced => github.com/fork/replaced v1.0.1
+	k8s.io/api => ./staging/src/k8s.io/api
+)
+`
+
+	testMITLicense = `MIT License
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction.
+`
+
+	testApacheLicense = `
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/
+`
+)
+
+func TestDiffGoMod(t *testing.T) {
+	t.Parallel()
+
+	report, err := DiffGoMod([]byte(testGoModOld), []byte(testGoModNew))
+	require.NoError(t, err)
+	require.Equal(t, &DependencyReport{
+		Added: []*DependencyChange{
+			{Module: "github.com/example/added", NewVersion: "v0.2.0", newPath: "github.com/example/added"},
+			{Module: "github.com/example/replaced", NewVersion: "v1.0.1", Direct: true, newPath: "github.com/fork/replaced"},
+		},
+		Removed: []*DependencyChange{
+			{Module: "github.com/example/removed", OldVersion: "v0.1.0", oldPath: "github.com/example/removed"},
+		},
+		Updated: []*DependencyChange{
+			{
+				Module: "github.com/example/License", OldVersion: "v1.0.0", NewVersion: "v2.0.0+incompatible", Direct: true,
+				oldPath: "github.com/example/License", newPath: "github.com/example/License",
+			},
+			{
+				Module: "github.com/example/direct", OldVersion: "v1.0.0", NewVersion: "v1.1.0", Direct: true,
+				oldPath: "github.com/example/direct", newPath: "github.com/example/direct",
+			},
+		},
+	}, report)
+
+	report, err = DiffGoMod(nil, []byte(testGoModOld))
+	require.NoError(t, err)
+	require.Len(t, report.Added, 3)
+
+	_, err = DiffGoMod([]byte("invalid ("), nil)
+	require.Error(t, err)
+}
+
+func TestClassifyLicense(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		text     string
+		expected string
+	}{
+		{testApacheLicense, "Apache-2.0"},
+		{testMITLicense, "MIT"},
+		{"Mozilla Public License Version 2.0", "MPL-2.0"},
+		{"GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007", "LGPL-3.0"},
+		{"GNU GENERAL PUBLIC LICENSE\nVersion 2, June 1991", "GPL-2.0"},
+		{
+			"Redistribution and use in source and binary forms, with or without modification, are permitted.\n" +
+				"Neither the name of Google Inc. nor the names of its contributors may be used.",
+			"BSD-3-Clause",
+		},
+		{"Redistribution and use in source and binary forms, with or without modification, are permitted.", "BSD-2-Clause"},
+		{
+			"Permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted",
+			"ISC",
+		},
+		{"All rights reserved.", LicenseUnknown},
+	} {
+		require.Equal(t, tc.expected, ClassifyLicense(tc.text), tc.text)
+	}
+}
+
+func TestDependenciesReport(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		return strings.TrimSpace(string(out))
+	}
+	writeFile := func(dir, path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), os.FileMode(0o755)))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(content), os.FileMode(0o644)))
+	}
+
+	git("init", "--quiet", "--initial-branch=main")
+	writeFile(repo, "go.mod", testGoModOld)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "Old dependencies")
+	start := git("rev-parse", "HEAD")
+
+	writeFile(repo, "go.mod", testGoModNew)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "New dependencies")
+	end := git("rev-parse", "HEAD")
+
+	// Upper case letters are escaped in the module cache
+	modCache := t.TempDir()
+	writeFile(modCache, "github.com/example/!license@v1.0.0/LICENSE", testMITLicense)
+	writeFile(modCache, "github.com/example/!license@v2.0.0+incompatible/LICENSE.txt", testApacheLicense)
+	writeFile(modCache, "github.com/example/direct@v1.0.0/LICENSE", testMITLicense)
+	writeFile(modCache, "github.com/example/direct@v1.1.0/COPYING", testMITLicense)
+
+	sut := NewDependencies()
+	sut.SetModCache(modCache)
+
+	report, err := sut.Report(repo, start, end)
+	require.NoError(t, err)
+	require.Len(t, report.Updated, 2)
+	require.Equal(t, "MIT", report.Updated[0].OldLicense)
+	require.Equal(t, "Apache-2.0", report.Updated[0].NewLicense)
+	require.Equal(t, "MIT", report.Updated[1].OldLicense)
+	require.Equal(t, "MIT", report.Updated[1].NewLicense)
+
+	require.Equal(t, []*DependencyChange{report.Updated[0]}, report.LicenseChanges())
+	require.Equal(t,
+		"### License Changes\n"+
+			"- github.com/example/License: v1.0.0 → v2.0.0+incompatible (MIT → Apache-2.0)\n",
+		report.LicenseChangesMarkdown(),
+	)
+
+	sut.SetModCache(t.TempDir())
+	report, err = sut.Report(repo, start, end)
+	require.NoError(t, err)
+	require.Empty(t, report.LicenseChanges())
+	require.Empty(t, report.LicenseChangesMarkdown())
+
+	_, err = sut.Report(repo, start, "wrong")
+	require.Error(t, err)
+}
@@ -23,15 +23,18 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
 
+	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
 	"sigs.k8s.io/release-utils/hash"
+	"sigs.k8s.io/release-utils/util"
 
 	"k8s.io/release/pkg/cve"
 	"k8s.io/release/pkg/notes"
@@ -50,8 +53,27 @@ type Document struct {
 	CurrentRevision         string         `json:"release_tag"`
 	PreviousRevision        string
 	CVEList                 []cve.CVE
+	Graduations             []Graduation             `json:"graduations,omitempty"`
+	FeatureGates            *notes.FeatureGateReport `json:"feature_gates,omitempty"`
 }
 
+// Graduation is a KEP which reached a new stage in the release, based on the
+// KEP metadata of the release notes documentation. Only KEPs whose milestone
+// of their current stage matches the minor version of the release are
+// graduations.
+type Graduation struct {
+	notes.KEP
+
+	// URL is the link to the KEP.
+	URL string
+
+	// PullRequests are the numbers of the pull requests linking the KEP.
+	PullRequests []int
+}
+
+// kepStagePriority is the order of the stages in the graduations section.
+var kepStagePriority = []string{notes.KEPStageStable, notes.KEPStageBeta, notes.KEPStageAlpha}
+
 // FileMetadata contains metadata about files associated with the release.
 type FileMetadata struct {
 	// Files containing source code
@@ -295,10 +317,17 @@ func New(
 	}
 
 	kindCategory := make(map[notes.Kind]NoteCategory)
+	graduations := map[int]*Graduation{}
+
+	// Graduations can only be determined for release tags
+	currentVersion, err := util.TagStringToSemver(currentRev)
+	withGraduations := err == nil
 
-	for _, pr := range releaseNotes.History() {
-		note := releaseNotes.Get(pr)
+	if !withGraduations {
+		logrus.Debugf("Skipping graduations because %q is no release tag", currentRev)
+	}
 
+	for _, note := range releaseNotes.All() {
 		if _, hasCVE := note.DataFields["cve"]; hasCVE {
 			logrus.Infof("Release note for PR #%d has CVE vulnerability info", note.PrNumber)
 
@@ -312,18 +341,22 @@ func New(
 
 			// Verify that CVE data has the minimum fields defined
 			if err := newcve.Validate(); err != nil {
-				return nil, fmt.Errorf("checking CVE map file for PR #%d: %w", pr, err)
+				return nil, fmt.Errorf("checking CVE map file for PR #%d: %w", note.PrNumber, err)
 			}
 
 			doc.CVEList = append(doc.CVEList, newcve)
 		}
 
 		if !note.IsMapped && note.DoNotPublish {
-			logrus.Debugf("Skipping PR %d as (marked to not be published)", pr)
+			logrus.Debugf("Skipping PR %d as (marked to not be published)", note.PrNumber)
 
 			continue
 		}
 
+		if withGraduations {
+			addGraduations(graduations, note, currentVersion)
+		}
+
 		// TODO: Refactor the logic here and add testing.
 		if note.DuplicateKind { //nolint:gocritic // a switch case would not make it better
 			kind := mapKind(highestPriorityKind(note.Kinds))
@@ -365,28 +398,59 @@ func New(
 	doc.Notes.Sort(kindPriority)
 	sort.Strings(doc.NotesWithActionRequired)
 
+	for _, graduation := range graduations {
+		sort.Ints(graduation.PullRequests)
+		doc.Graduations = append(doc.Graduations, *graduation)
+	}
+
+	sort.Slice(doc.Graduations, func(i, j int) bool {
+		a, b := doc.Graduations[i], doc.Graduations[j]
+		if a.Stage != b.Stage {
+			return slices.Index(kepStagePriority, a.Stage) < slices.Index(kepStagePriority, b.Stage)
+		}
+
+		return a.Number < b.Number
+	})
+
 	return doc, nil
 }
 
-// RenderMarkdownTemplate renders a document using the golang template in
-// `templateSpec`. If `templateSpec` is set to `options.GoTemplateDefault`,
-// then it renders in the default template markdown format.
-func (d *Document) RenderMarkdownTemplate(bucket, tars, images, templateSpec string) (string, error) {
-	urlPrefix := release.URLPrefixForBucket(bucket)
+// addGraduations adds the KEPs with a known stage linked by the note, which
+// reached their stage in the release line of the version.
+func addGraduations(graduations map[int]*Graduation, note *notes.ReleaseNote, version semver.Version) {
+	for _, doc := range note.Documentation {
+		if doc == nil || doc.KEP == nil || !slices.Contains(kepStagePriority, doc.KEP.Stage) {
+			continue
+		}
 
-	fileMetadata, err := fetchFileMetadata(tars, urlPrefix, d.CurrentRevision)
-	if err != nil {
-		return "", fmt.Errorf("fetching file downloads metadata: %w", err)
-	}
+		if !doc.KEP.GraduatedIn(version) {
+			logrus.Debugf(
+				"Skipping KEP %d which did not reach stage %s in %s",
+				doc.KEP.Number, doc.KEP.Stage, util.SemverToTagString(version),
+			)
 
-	d.FileDownloads = fileMetadata
+			continue
+		}
 
-	imageMetadata, err := fetchImageMetadata(images, d.CurrentRevision)
-	if err != nil {
-		return "", fmt.Errorf("fetching image downloads metadata: %w", err)
+		graduation, ok := graduations[doc.KEP.Number]
+		if !ok {
+			graduation = &Graduation{KEP: *doc.KEP, URL: doc.URL}
+			graduations[doc.KEP.Number] = graduation
+		}
+
+		if !slices.Contains(graduation.PullRequests, note.PrNumber) {
+			graduation.PullRequests = append(graduation.PullRequests, note.PrNumber)
+		}
 	}
+}
 
-	d.ImageDownloads = imageMetadata
+// RenderMarkdownTemplate renders a document using the golang template in
+// `templateSpec`. If `templateSpec` is set to `options.GoTemplateDefault`,
+// then it renders in the default template markdown format.
+func (d *Document) RenderMarkdownTemplate(bucket, tars, images, templateSpec string) (string, error) {
+	if err := d.fetchDownloads(bucket, tars, images); err != nil {
+		return "", err
+	}
 
 	goTemplate, err := d.template(templateSpec)
 	if err != nil {
@@ -394,7 +458,7 @@ func (d *Document) RenderMarkdownTemplate(bucket, tars, images, templateSpec str
 	}
 
 	tmpl, err := template.New("markdown").
-		Funcs(template.FuncMap{"prettyKind": prettyKind}).
+		Funcs(template.FuncMap{"prettyKind": prettyKind, "join": strings.Join}).
 		Parse(goTemplate)
 	if err != nil {
 		return "", fmt.Errorf("parsing template: %w", err)
@@ -408,6 +472,27 @@ func (d *Document) RenderMarkdownTemplate(bucket, tars, images, templateSpec str
 	return strings.TrimSpace(s.String()), nil
 }
 
+// fetchDownloads populates the file and image downloads of the document.
+func (d *Document) fetchDownloads(bucket, tars, images string) error {
+	urlPrefix := release.URLPrefixForBucket(bucket)
+
+	fileMetadata, err := fetchFileMetadata(tars, urlPrefix, d.CurrentRevision)
+	if err != nil {
+		return fmt.Errorf("fetching file downloads metadata: %w", err)
+	}
+
+	d.FileDownloads = fileMetadata
+
+	imageMetadata, err := fetchImageMetadata(images, d.CurrentRevision)
+	if err != nil {
+		return fmt.Errorf("fetching image downloads metadata: %w", err)
+	}
+
+	d.ImageDownloads = imageMetadata
+
+	return nil
+}
+
 // template returns either the default template, a template from file or an
 // inline string template. The `templateSpec` must be in the format of
 // `go-template:{default|path/to/template.ext}` or
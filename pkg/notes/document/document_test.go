@@ -23,12 +23,14 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"sigs.k8s.io/release-utils/command"
 
 	"k8s.io/release/pkg/consts"
+	"k8s.io/release/pkg/cve"
 	"k8s.io/release/pkg/notes"
 	"k8s.io/release/pkg/notes/options"
 	"k8s.io/release/pkg/release"
@@ -291,6 +293,21 @@ func repoTagTarball(t *testing.T, path, repoTag string) {
 	require.NoError(t, os.RemoveAll(manifestJSONPath))
 }
 
+func TestCreateDownloadsTableS3(t *testing.T) {
+	dir := t.TempDir()
+	setupTestDir(t, dir)
+	t.Setenv("AWS_ENDPOINT_URL", "http://minio:9000")
+
+	output := &strings.Builder{}
+	require.NoError(t, CreateDownloadsTable(
+		output, "s3://distribution/release", dir, "", "v1.28.0", "v1.28.1",
+	))
+
+	require.Contains(t, output.String(),
+		"[kubernetes.tar.gz](http://minio:9000/distribution/release/v1.28.1/kubernetes.tar.gz)",
+	)
+}
+
 func TestNew(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -460,6 +477,25 @@ func TestNew(t *testing.T) {
 				},
 			},
 		},
+		{
+			"notes of commits without PR are included",
+			func() *notes.ReleaseNotes {
+				n := notes.NewReleaseNotes()
+				n.Set(1, makeReleaseNote(notes.KindBug, "B"))
+				n.SetCommit("abc", makeReleaseNote(notes.KindBug, "A"))
+
+				return n
+			},
+			&Document{
+				NotesWithActionRequired: notes.Notes{},
+				Notes: NoteCollection{
+					NoteCategory{
+						Kind:        notes.KindBug,
+						NoteEntries: &notes.Notes{"A", "B"},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -470,6 +506,54 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewGraduations(t *testing.T) {
+	alpha := &notes.KEP{
+		Number: 1, Title: "Alpha", Stage: notes.KEPStageAlpha,
+		Milestones: map[string]string{notes.KEPStageAlpha: "v1.30"},
+	}
+	stable := &notes.KEP{
+		Number: 2, Title: "Stable", Stage: notes.KEPStageStable,
+		Milestones: map[string]string{notes.KEPStageBeta: "v1.29", notes.KEPStageStable: "v1.30"},
+	}
+	unknown := &notes.KEP{Number: 3, Title: "Unknown", LatestMilestone: "v1.30"}
+	oldStable := &notes.KEP{
+		Number: 4, Title: "Old stable", Stage: notes.KEPStageStable,
+		Milestones:      map[string]string{notes.KEPStageStable: "v1.27"},
+		LatestMilestone: "v1.30",
+	}
+	latestOnly := &notes.KEP{Number: 5, Title: "Latest only", Stage: notes.KEPStageBeta, LatestMilestone: "v1.30"}
+
+	n := notes.NewReleaseNotes()
+	n.Set(1, &notes.ReleaseNote{PrNumber: 1, Markdown: "PR#1", Documentation: []*notes.Documentation{
+		{URL: "https://kep/1", Type: notes.DocTypeKEP, KEP: alpha},
+		{URL: "https://kep/3", Type: notes.DocTypeKEP, KEP: unknown},
+		{URL: "https://kep/4", Type: notes.DocTypeKEP, KEP: oldStable},
+	}})
+	n.Set(2, &notes.ReleaseNote{PrNumber: 2, Markdown: "PR#2", Documentation: []*notes.Documentation{
+		{URL: "https://kep/2", Type: notes.DocTypeKEP, KEP: stable},
+		{URL: "https://kep/1", Type: notes.DocTypeKEP, KEP: alpha},
+		{URL: "https://kep/5", Type: notes.DocTypeKEP, KEP: latestOnly},
+	}})
+
+	doc, err := New(n, "v1.29.0", "v1.30.0")
+	require.NoError(t, err)
+	require.Equal(t, []Graduation{
+		{KEP: *stable, URL: "https://kep/2", PullRequests: []int{2}},
+		{KEP: *latestOnly, URL: "https://kep/5", PullRequests: []int{2}},
+		{KEP: *alpha, URL: "https://kep/1", PullRequests: []int{1, 2}},
+	}, doc.Graduations)
+
+	// Other release lines have no graduations
+	doc, err = New(n, "v1.30.0", "v1.31.0")
+	require.NoError(t, err)
+	require.Empty(t, doc.Graduations)
+
+	// Graduations require a release tag
+	doc, err = New(n, "", "")
+	require.NoError(t, err)
+	require.Empty(t, doc.Graduations)
+}
+
 func TestDocument_RenderMarkdownTemplate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -523,12 +607,36 @@ func TestDocument_RenderMarkdownTemplate(t *testing.T) {
 			actionNeeded := makeReleaseNote(notes.KindAPIChange, "Action required note.")
 			actionNeeded.ActionRequired = true
 
+			graduated := makeReleaseNote(notes.KindFeature, "Graduated a feature.")
+			graduated.PrNumber = 13
+			graduated.Documentation = []*notes.Documentation{{
+				URL:  "https://github.com/kubernetes/enhancements/issues/1234",
+				Type: notes.DocTypeKEP,
+				KEP: &notes.KEP{
+					Number: 1234, Title: "My Feature", Stage: notes.KEPStageBeta,
+					FeatureGates: []string{"MyFeature", "MyOtherFeature"},
+					Milestones:   map[string]string{notes.KEPStageBeta: "v1.28"},
+				},
+			}}
+
 			testNotes.Set(11, duplicate)
 			testNotes.Set(12, actionNeeded)
+			testNotes.Set(13, graduated)
 
 			doc, err := New(testNotes, "v1.16.0", "v1.28.1")
 			require.NoError(t, err, "Creating test document.")
 
+			doc.FeatureGates = notes.DiffFeatureGates(
+				map[string]*notes.FeatureGate{
+					"Promoted": {Name: "Promoted", Stage: "Alpha"},
+					"Removed":  {Name: "Removed", Stage: "GA", Default: true},
+				},
+				map[string]*notes.FeatureGate{
+					"Added":    {Name: "Added", Stage: "Alpha"},
+					"Promoted": {Name: "Promoted", Stage: "Beta", Default: true},
+				},
+			)
+
 			templateSpec := tt.templateSpec
 
 			var dir string
@@ -579,3 +687,54 @@ func readFile(t *testing.T, path string) string {
 
 	return strings.TrimSpace(string(b))
 }
+
+func TestDocument_RenderFormat(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	for _, tc := range []struct {
+		format         string
+		wantGoldenFile string
+	}{
+		{options.FormatKeepAChangelog, "document.keep-a-changelog.md.golden"},
+		{options.FormatAsciiDoc, "document.adoc.golden"},
+		{options.FormatRST, "document.rst.golden"},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			testNotes := notes.NewReleaseNotes()
+			testNotes.Set(0, makeReleaseNote(notes.KindDeprecation, "Deprecation #1."))
+			testNotes.Set(1, makeReleaseNote(notes.KindBug, "Fixed `kubectl` crash, see [#1](https://github.com/kubernetes/kubernetes/issues/1)."))
+			testNotes.Set(2, makeReleaseNote(notes.KindCleanup, "Clean up."))
+			testNotes.Set(3, makeReleaseNote(notes.KindFeature, "A **new** feature.\n\nWith a second paragraph."))
+
+			actionNeeded := makeReleaseNote(notes.KindAPIChange, "Action required note.")
+			actionNeeded.ActionRequired = true
+			testNotes.Set(4, actionNeeded)
+
+			doc, err := New(testNotes, "v1.16.0", "v1.28.1")
+			require.NoError(t, err)
+
+			doc.CVEList = []cve.CVE{{
+				ID:          "CVE-2026-1234",
+				Title:       "Test vulnerability",
+				Description: "A `test` vulnerability.",
+				CVSSVector:  "CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+				CVSSScore:   6.2,
+				CVSSRating:  "Medium",
+				CalcLink:    "https://www.first.org/cvss/calculator/3.1#CVSS:3.1/AV:N/AC:H/PR:H/UI:R/S:U/C:H/I:H/A:H",
+			}}
+
+			dir := t.TempDir()
+			setupTestDir(t, dir)
+
+			got, err := doc.RenderFormat(tc.format, release.ProductionBucket, dir, dir)
+			require.NoError(t, err)
+
+			expected := readFile(t, filepath.Join("testdata", tc.wantGoldenFile))
+			require.Equal(t, expected, got)
+		})
+	}
+
+	_, err := (&Document{}).RenderFormat(options.FormatJSON, "", "", "")
+	require.Error(t, err)
+}
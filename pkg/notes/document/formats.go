@@ -0,0 +1,398 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package document
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"k8s.io/release/pkg/notes"
+	"k8s.io/release/pkg/notes/options"
+)
+
+// now returns the current time and can be overridden for testing purposes.
+var now = time.Now
+
+var (
+	markdownLinkRE = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownCodeRE = regexp.MustCompile("`+([^`]+)`+")
+	markdownBoldRE = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+)
+
+// formatTemplates contains the templates of all natively supported output
+// formats besides markdown and JSON.
+var formatTemplates = map[string]string{
+	options.FormatKeepAChangelog: keepAChangelogTemplate,
+	options.FormatAsciiDoc:       asciiDocTemplate,
+	options.FormatRST:            rstTemplate,
+}
+
+// RenderFormat renders the document in one of the formats
+// `options.FormatKeepAChangelog`, `options.FormatAsciiDoc` or
+// `options.FormatRST`. Markdown documents are rendered by
+// `RenderMarkdownTemplate`.
+func (d *Document) RenderFormat(format, bucket, tars, images string) (string, error) {
+	goTemplate, ok := formatTemplates[format]
+	if !ok {
+		return "", fmt.Errorf("unsupported release notes format: %s", format)
+	}
+
+	if err := d.fetchDownloads(bucket, tars, images); err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New(format).Funcs(template.FuncMap{
+		"prettyKind":       prettyKind,
+		"date":             func() string { return now().Format(time.DateOnly) },
+		"changelog":        d.keepAChangelogSections,
+		"asciidoc":         asciiDocInline,
+		"asciidocListItem": asciiDocListItem,
+		"rst":              rstInline,
+		"listItem":         indentListItem,
+		"rstListItem":      rstListItem,
+		"rstTitle":         rstTitle,
+	}).Parse(goTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s template: %w", format, err)
+	}
+
+	var s strings.Builder
+	if err := tmpl.Execute(&s, d); err != nil {
+		return "", fmt.Errorf("rendering %s template: %w", format, err)
+	}
+
+	return strings.TrimSpace(s.String()), nil
+}
+
+// changelogSection is a section of a Keep a Changelog release entry.
+type changelogSection struct {
+	Name    string
+	Entries []string
+}
+
+// keepAChangelogSectionOrder is the order of the sections as defined by
+// https://keepachangelog.com.
+var keepAChangelogSectionOrder = []string{
+	"Added", "Changed", "Deprecated", "Removed", "Fixed", "Security",
+}
+
+// keepAChangelogSection maps a note kind to its Keep a Changelog section.
+func keepAChangelogSection(kind notes.Kind) string {
+	//nolint:exhaustive // all other kinds are changes
+	switch kind {
+	case notes.KindFeature:
+		return "Added"
+	case notes.KindDeprecation:
+		return "Deprecated"
+	case notes.KindBug, notes.KindFailingTest, notes.KindRegression:
+		return "Fixed"
+	default:
+		return "Changed"
+	}
+}
+
+// keepAChangelogSections groups the notes of the document into the Keep a
+// Changelog sections, while preserving the kind priority of the notes.
+func (d *Document) keepAChangelogSections() []changelogSection {
+	entries := map[string][]string{}
+
+	for _, note := range d.NotesWithActionRequired {
+		entries["Changed"] = append(entries["Changed"], "**ACTION REQUIRED:** "+note)
+	}
+
+	for _, category := range d.Notes {
+		section := keepAChangelogSection(category.Kind)
+		entries[section] = append(entries[section], *category.NoteEntries...)
+	}
+
+	for i := range d.CVEList {
+		c := &d.CVEList[i]
+		entries["Security"] = append(entries["Security"], fmt.Sprintf(
+			"%s: %s (CVSS %s %.1f, %s)",
+			c.ID, c.Title, c.CVSSRating, c.CVSSScore, markdownLink(c.CVSSVector, c.CalcLink),
+		))
+	}
+
+	sections := []changelogSection{}
+
+	for _, name := range keepAChangelogSectionOrder {
+		if len(entries[name]) > 0 {
+			sections = append(sections, changelogSection{Name: name, Entries: entries[name]})
+		}
+	}
+
+	return sections
+}
+
+// asciiDocInline converts inline markdown links and bold text to AsciiDoc.
+func asciiDocInline(s string) string {
+	s = markdownBoldRE.ReplaceAllString(s, "*$1*")
+
+	return markdownLinkRE.ReplaceAllString(s, "$2[$1]")
+}
+
+// asciiDocListItem converts a markdown note to an AsciiDoc list item, where
+// blank lines are replaced by list continuations to keep multi paragraph
+// notes within the item.
+func asciiDocListItem(s string) string {
+	lines := strings.Split(strings.TrimSpace(asciiDocInline(s)), "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = "+"
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rstInline converts inline markdown code and links to reStructuredText.
+func rstInline(s string) string {
+	s = markdownCodeRE.ReplaceAllString(s, "``$1``")
+
+	return markdownLinkRE.ReplaceAllString(s, "`$1 <$2>`__")
+}
+
+// rstListItem converts a markdown note to a reStructuredText list item body.
+func rstListItem(s string) string {
+	return indentListItem(rstInline(s))
+}
+
+// indentListItem indents all continuation lines of a list item body to keep
+// them within the item.
+func indentListItem(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			lines[i] = "  " + lines[i]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rstTitle returns the title underlined with the provided adornment
+// character.
+func rstTitle(title, adornment string) string {
+	return title + "\n" + strings.Repeat(adornment, utf8.RuneCountInString(title))
+}
+
+// keepAChangelogTemplate renders a single release entry of a changelog
+// following https://keepachangelog.com.
+const keepAChangelogTemplate = `
+## [{{.CurrentRevision}}] - {{date}}
+{{range changelog}}
+### {{.Name}}
+
+{{range .Entries}}- {{listItem .}}
+{{end}}
+{{- end -}}
+`
+
+// asciiDocTemplate renders the release notes as AsciiDoc.
+const asciiDocTemplate = `
+{{- $CurrentRevision := .CurrentRevision -}}
+{{- define "asciidocFiles" -}}
+[options="header"]
+|===
+|filename |sha512 hash
+{{range .}}|{{.URL}}[{{.Name}}] |{{.Checksum}}
+{{end -}}
+|===
+{{- end -}}
+
+{{if or .FileDownloads .ImageDownloads}}
+== Downloads for {{$CurrentRevision}}
+
+{{- if .FileDownloads -}}
+{{- with .FileDownloads.Source }}
+
+=== Source Code
+
+{{template "asciidocFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Client }}
+
+=== Client Binaries
+
+{{template "asciidocFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Server }}
+
+=== Server Binaries
+
+{{template "asciidocFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Node }}
+
+=== Node Binaries
+
+{{template "asciidocFiles" .}}
+{{- end -}}
+{{- end -}}
+
+{{- with .ImageDownloads }}
+
+=== Container Images
+
+All container images are available as manifest lists and support the described
+architectures. It is also possible to pull a specific architecture directly by
+adding the "-$ARCH" suffix to the container image name.
+
+[options="header"]
+|===
+|name |architectures
+{{range .}}|{{asciidoc .Name}} |{{range $i, $a := .Architectures}}{{if $i}}, {{end}}{{asciidoc $a}}{{end}}
+{{end -}}
+|===
+{{- end}}
+{{end}}
+{{- with .CVEList}}
+== Important Security Information
+
+This release contains changes that address the following vulnerabilities:
+{{range .}}
+=== {{.ID}}: {{.Title}}
+
+{{asciidoc .Description}}
+
+*CVSS Rating:* {{.CVSSRating}} ({{.CVSSScore}}) {{.CalcLink}}[{{.CVSSVector}}]
+{{- if .TrackingIssue}} +
+*Tracking Issue:* {{.TrackingIssue}}
+{{- end}}
+{{end -}}
+{{end}}
+{{- with .NotesWithActionRequired}}
+== Urgent Upgrade Notes
+
+=== (No, really, you MUST read this before you upgrade)
+
+{{range .}}* {{asciidocListItem .}}
+{{end -}}
+{{end}}
+{{- if .Notes}}
+== Changes by Kind
+{{range .Notes}}
+=== {{.Kind | prettyKind}}
+
+{{range .NoteEntries}}* {{asciidocListItem .}}
+{{end}}
+{{- end}}
+{{- end}}
+`
+
+// rstTemplate renders the release notes as reStructuredText.
+const rstTemplate = `
+{{- $CurrentRevision := .CurrentRevision -}}
+{{- define "rstFiles" -}}
+.. list-table::
+   :header-rows: 1
+
+   * - filename
+     - sha512 hash
+{{- range .}}
+   * - ` + "`" + `{{.Name}} <{{.URL}}>` + "`" + `__
+     - ` + "``" + `{{.Checksum}}` + "``" + `
+{{- end}}
+{{- end -}}
+
+{{if or .FileDownloads .ImageDownloads}}
+{{rstTitle (print "Downloads for " $CurrentRevision) "="}}
+
+{{- if .FileDownloads -}}
+{{- with .FileDownloads.Source }}
+
+{{rstTitle "Source Code" "-"}}
+
+{{template "rstFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Client }}
+
+{{rstTitle "Client Binaries" "-"}}
+
+{{template "rstFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Server }}
+
+{{rstTitle "Server Binaries" "-"}}
+
+{{template "rstFiles" .}}
+{{- end -}}
+{{- with .FileDownloads.Node }}
+
+{{rstTitle "Node Binaries" "-"}}
+
+{{template "rstFiles" .}}
+{{- end -}}
+{{- end -}}
+
+{{- with .ImageDownloads }}
+
+{{rstTitle "Container Images" "-"}}
+
+All container images are available as manifest lists and support the described
+architectures. It is also possible to pull a specific architecture directly by
+adding the "-$ARCH" suffix to the container image name.
+
+.. list-table::
+   :header-rows: 1
+
+   * - name
+     - architectures
+{{- range .}}
+   * - {{rst .Name}}
+     - {{range $i, $a := .Architectures}}{{if $i}}, {{end}}{{rst $a}}{{end}}
+{{- end}}
+{{- end}}
+{{end}}
+{{- with .CVEList}}
+{{rstTitle "Important Security Information" "="}}
+
+This release contains changes that address the following vulnerabilities:
+{{range .}}
+{{rstTitle (print .ID ": " .Title) "-"}}
+
+{{rst .Description}}
+
+**CVSS Rating:** {{.CVSSRating}} ({{.CVSSScore}}) ` + "`" + `{{.CVSSVector}} <{{.CalcLink}}>` + "`" + `__
+{{- if .TrackingIssue}}
+
+**Tracking Issue:** {{.TrackingIssue}}
+{{- end}}
+{{end -}}
+{{end}}
+{{- with .NotesWithActionRequired}}
+{{rstTitle "Urgent Upgrade Notes" "="}}
+
+{{rstTitle "(No, really, you MUST read this before you upgrade)" "-"}}
+
+{{range .}}- {{rstListItem .}}
+{{end -}}
+{{end}}
+{{- if .Notes}}
+{{rstTitle "Changes by Kind" "="}}
+{{range .Notes}}
+{{rstTitle (prettyKind .Kind) "-"}}
+
+{{range .NoteEntries}}- {{rstListItem .}}
+{{end}}
+{{- end}}
+{{- end}}
+`
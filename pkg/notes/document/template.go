@@ -104,6 +104,24 @@ This release contains changes that address the following vulnerabilities:
 {{range .}}{{println "-" .}} {{end}}
 {{end}}
 
+{{- with .Graduations -}}
+## Graduations
+
+KEP | Title | Stage | Feature Gates
+--- | ----- | ----- | -------------
+{{range .}}[KEP-{{.Number}}]({{.URL}}) | {{.Title}} | {{.Stage}} | {{join .FeatureGates ", "}}{{println}}{{end}}
+{{end}}
+
+{{- with .FeatureGates -}}
+{{- with .Changes -}}
+## Feature Gates
+
+Feature Gate | Change | Stage | Default
+------------ | ------ | ----- | -------
+{{range .}}{{.Name}} | {{.KindsString}} | {{.StageChange}} | {{.DefaultChange}}{{println}}{{end}}
+{{end}}
+{{- end -}}
+
 {{- if .Notes -}}
 ## Changes by Kind
 {{ range .Notes}}
@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	gitobject "github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultFeatureGateFiles are the files of kubernetes/kubernetes which
+// define the feature gates.
+var DefaultFeatureGateFiles = []string{
+	"pkg/features/kube_features.go",
+	"pkg/features/versioned_kube_features.go",
+	"staging/src/k8s.io/apiextensions-apiserver/pkg/features/kube_features.go",
+	"staging/src/k8s.io/apiserver/pkg/features/kube_features.go",
+	"staging/src/k8s.io/client-go/features/known_features.go",
+	"staging/src/k8s.io/component-base/logs/api/v1/kube_features.go",
+	"staging/src/k8s.io/component-base/metrics/features/kube_features.go",
+	"staging/src/k8s.io/controller-manager/pkg/features/kube_features.go",
+}
+
+// FeatureGate is the specification of a feature gate at a revision.
+type FeatureGate struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name"`
+
+	// Stage is the pre-release stage, like Alpha, Beta, GA or Deprecated.
+	Stage string `json:"stage"`
+
+	// Default indicates if the feature gate is enabled by default.
+	Default bool `json:"default"`
+
+	// LockToDefault indicates if the feature gate cannot be changed.
+	LockToDefault bool `json:"lock_to_default,omitempty"`
+}
+
+// FeatureGateChangeKind is the kind of a feature gate change.
+type FeatureGateChangeKind string
+
+const (
+	FeatureGateAdded          FeatureGateChangeKind = "added"
+	FeatureGateRemoved        FeatureGateChangeKind = "removed"
+	FeatureGateStageChanged   FeatureGateChangeKind = "stage-changed"
+	FeatureGateDefaultFlipped FeatureGateChangeKind = "default-flipped"
+)
+
+// FeatureGateChange is the change of a single feature gate between two
+// revisions.
+type FeatureGateChange struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name"`
+
+	// Kinds are the kinds of the change. A feature gate can be promoted and
+	// enabled by default at the same time.
+	Kinds []FeatureGateChangeKind `json:"kinds"`
+
+	// Old is the feature gate at the start revision, nil if added.
+	Old *FeatureGate `json:"old,omitempty"`
+
+	// New is the feature gate at the end revision, nil if removed.
+	New *FeatureGate `json:"new,omitempty"`
+}
+
+// StageChange returns the stage transition in a human readable format.
+func (c *FeatureGateChange) StageChange() string {
+	return featureGateTransition(c.Old, c.New, func(f *FeatureGate) string { return f.Stage })
+}
+
+// DefaultChange returns the default value transition in a human readable
+// format.
+func (c *FeatureGateChange) DefaultChange() string {
+	return featureGateTransition(c.Old, c.New, func(f *FeatureGate) string { return strconv.FormatBool(f.Default) })
+}
+
+// KindsString returns the kinds of the change separated by comma.
+func (c *FeatureGateChange) KindsString() string {
+	kinds := []string{}
+	for _, kind := range c.Kinds {
+		kinds = append(kinds, string(kind))
+	}
+
+	return strings.Join(kinds, ", ")
+}
+
+func featureGateTransition(old, cur *FeatureGate, value func(*FeatureGate) string) string {
+	switch {
+	case old == nil:
+		return value(cur)
+	case cur == nil:
+		return value(old)
+	case value(old) == value(cur):
+		return value(cur)
+	default:
+		return value(old) + " → " + value(cur)
+	}
+}
+
+// FeatureGateReport contains all feature gate changes between two revisions,
+// sorted by name.
+type FeatureGateReport struct {
+	Changes []*FeatureGateChange `json:"changes"`
+}
+
+// FeatureGates generates feature gate change reports from a local repository.
+type FeatureGates struct {
+	files []string
+}
+
+// NewFeatureGates creates a new feature gate report generator for the
+// default feature gate files.
+func NewFeatureGates() *FeatureGates {
+	return &FeatureGates{files: DefaultFeatureGateFiles}
+}
+
+// SetFiles can be used to set the files which define the feature gates.
+func (f *FeatureGates) SetFiles(files []string) {
+	f.files = files
+}
+
+// Changes compares the feature gates between both provided revisions of the
+// local repository.
+func (f *FeatureGates) Changes(repoPath, from, to string) (*FeatureGateReport, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening repository %s: %w", repoPath, err)
+	}
+
+	oldGates, err := f.featureGatesAt(repo, from)
+	if err != nil {
+		return nil, fmt.Errorf("reading feature gates at %s: %w", from, err)
+	}
+
+	newGates, err := f.featureGatesAt(repo, to)
+	if err != nil {
+		return nil, fmt.Errorf("reading feature gates at %s: %w", to, err)
+	}
+
+	return DiffFeatureGates(oldGates, newGates), nil
+}
+
+// DiffFeatureGates compares two sets of feature gates indexed by name.
+func DiffFeatureGates(oldGates, newGates map[string]*FeatureGate) *FeatureGateReport {
+	report := &FeatureGateReport{Changes: []*FeatureGateChange{}}
+
+	names := slices.Collect(maps.Keys(oldGates))
+	for name := range newGates {
+		if _, ok := oldGates[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		change := &FeatureGateChange{Name: name, Old: oldGates[name], New: newGates[name]}
+
+		switch {
+		case change.Old == nil:
+			change.Kinds = append(change.Kinds, FeatureGateAdded)
+		case change.New == nil:
+			change.Kinds = append(change.Kinds, FeatureGateRemoved)
+		default:
+			if change.Old.Stage != change.New.Stage {
+				change.Kinds = append(change.Kinds, FeatureGateStageChanged)
+			}
+
+			if change.Old.Default != change.New.Default {
+				change.Kinds = append(change.Kinds, FeatureGateDefaultFlipped)
+			}
+		}
+
+		if len(change.Kinds) > 0 {
+			report.Changes = append(report.Changes, change)
+		}
+	}
+
+	return report
+}
+
+// featureGatesAt returns all feature gates defined at the revision.
+func (f *FeatureGates) featureGatesAt(repo *git.Repository, rev string) (map[string]*FeatureGate, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("resolving revision: %w", err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("getting commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("getting tree: %w", err)
+	}
+
+	sources := map[string]string{}
+
+	for _, path := range f.files {
+		file, err := tree.File(path)
+		if errors.Is(err, gitobject.ErrFileNotFound) {
+			logrus.Debugf("Feature gate file %s does not exist at %s", path, rev)
+
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("getting file %s: %w", path, err)
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("reading file %s: %w", path, err)
+		}
+
+		sources[path] = content
+	}
+
+	return ParseFeatureGates(sources)
+}
+
+// ParseFeatureGates parses the feature gate specs from the Go sources indexed
+// by their path. It supports both the unversioned
+// `map[featuregate.Feature]featuregate.FeatureSpec` and the versioned
+// `map[featuregate.Feature]featuregate.VersionedSpecs`, where the latest
+// version is used.
+func ParseFeatureGates(sources map[string]string) (map[string]*FeatureGate, error) {
+	fset := token.NewFileSet()
+	files := []*ast.File{}
+
+	for _, path := range slices.Sorted(maps.Keys(sources)) {
+		file, err := parser.ParseFile(fset, path, sources[path], parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		files = append(files, file)
+	}
+
+	// The feature names are usually constants, which may be defined in
+	// another file than the specs.
+	names := map[string]string{}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.ValueSpec)
+			if !ok {
+				return true
+			}
+
+			for i, ident := range spec.Names {
+				if i >= len(spec.Values) {
+					break
+				}
+
+				if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if value, err := strconv.Unquote(lit.Value); err == nil {
+						names[ident.Name] = value
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	gates := map[string]*FeatureGate{}
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			lit, ok := node.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			mapType, ok := lit.Type.(*ast.MapType)
+			if !ok {
+				return true
+			}
+
+			versioned := false
+
+			switch identName(mapType.Value) {
+			case "FeatureSpec":
+			case "VersionedSpecs":
+				versioned = true
+			default:
+				return true
+			}
+
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+
+				name := identName(kv.Key)
+				if value, ok := names[name]; ok {
+					name = value
+				} else if key, ok := kv.Key.(*ast.BasicLit); ok && key.Kind == token.STRING {
+					name, _ = strconv.Unquote(key.Value)
+				}
+
+				if name == "" {
+					continue
+				}
+
+				specLit, ok := kv.Value.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+
+				if versioned {
+					if len(specLit.Elts) == 0 {
+						continue
+					}
+
+					if specLit, ok = specLit.Elts[len(specLit.Elts)-1].(*ast.CompositeLit); !ok {
+						continue
+					}
+				}
+
+				gates[name] = parseFeatureSpec(name, specLit)
+			}
+
+			return false
+		})
+	}
+
+	return gates, nil
+}
+
+// parseFeatureSpec parses a featuregate.FeatureSpec literal.
+func parseFeatureSpec(name string, lit *ast.CompositeLit) *FeatureGate {
+	gate := &FeatureGate{Name: name}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		value := identName(kv.Value)
+
+		switch identName(kv.Key) {
+		case "Default":
+			gate.Default = value == "true"
+		case "LockToDefault":
+			gate.LockToDefault = value == "true"
+		case "PreRelease":
+			gate.Stage = value
+		}
+	}
+
+	return gate
+}
+
+// identName returns the name of an identifier or the selected name of a
+// selector expression.
+func identName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	default:
+		return ""
+	}
+}
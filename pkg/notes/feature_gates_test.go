@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFeatureGateNames = `package features
+
+import "k8s.io/component-base/featuregate"
+
+const (
+	// owner: @someone
+	NodeSwap featuregate.Feature = "NodeSwap"
+
+	InPlacePodVerticalScaling featuregate.Feature = "InPlacePodVerticalScaling"
+)
+`
+
+	testFeatureGatesUnversioned = `package features
+
+import (
+	genericfeatures "k8s.io/apiserver/pkg/features"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	NodeSwap featuregate.Feature = "NodeSwap"
+
+	InPlacePodVerticalScaling featuregate.Feature = "InPlacePodVerticalScaling"
+)
+
+var defaultKubernetesFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	NodeSwap: {Default: false, PreRelease: featuregate.Alpha},
+
+	InPlacePodVerticalScaling: {Default: false, PreRelease: featuregate.Alpha},
+
+	genericfeatures.APIListChunking: {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
+}
+`
+
+	testFeatureGatesVersioned = `package features
+
+import (
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/component-base/featuregate"
+)
+
+var defaultVersionedKubernetesFeatureGates = map[featuregate.Feature]featuregate.VersionedSpecs{
+	NodeSwap: {
+		{Version: version.MustParse("1.22"), Default: false, PreRelease: featuregate.Alpha},
+		{Version: version.MustParse("1.28"), Default: true, PreRelease: featuregate.Beta},
+	},
+
+	InPlacePodVerticalScaling: {
+		{Version: version.MustParse("1.27"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	"MyNewFeature": {
+		{Version: version.MustParse("1.33"), Default: false, PreRelease: featuregate.Alpha},
+	},
+}
+`
+)
+
+func TestParseFeatureGates(t *testing.T) {
+	t.Parallel()
+
+	gates, err := ParseFeatureGates(map[string]string{
+		"kube_features.go":           testFeatureGateNames,
+		"versioned_kube_features.go": testFeatureGatesVersioned,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*FeatureGate{
+		"NodeSwap":                  {Name: "NodeSwap", Stage: "Beta", Default: true},
+		"InPlacePodVerticalScaling": {Name: "InPlacePodVerticalScaling", Stage: "Alpha"},
+		"MyNewFeature":              {Name: "MyNewFeature", Stage: "Alpha"},
+	}, gates)
+
+	gates, err = ParseFeatureGates(map[string]string{
+		"kube_features.go": testFeatureGatesUnversioned,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*FeatureGate{
+		"NodeSwap":                  {Name: "NodeSwap", Stage: "Alpha"},
+		"InPlacePodVerticalScaling": {Name: "InPlacePodVerticalScaling", Stage: "Alpha"},
+		"APIListChunking":           {Name: "APIListChunking", Stage: "GA", Default: true, LockToDefault: true},
+	}, gates)
+
+	_, err = ParseFeatureGates(map[string]string{"invalid.go": "invalid"})
+	require.Error(t, err)
+}
+
+func TestFeatureGatesChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		return strings.TrimSpace(string(out))
+	}
+	writeFile := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(repo, path)), os.FileMode(0o755)))
+		require.NoError(t, os.WriteFile(filepath.Join(repo, path), []byte(content), os.FileMode(0o644)))
+	}
+
+	git("init", "--quiet", "--initial-branch=main")
+	writeFile("pkg/features/kube_features.go", testFeatureGatesUnversioned)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "Unversioned feature gates")
+	start := git("rev-parse", "HEAD")
+
+	// Feature gates moved into a new file
+	writeFile("pkg/features/kube_features.go", testFeatureGateNames)
+	writeFile("pkg/features/versioned_kube_features.go", testFeatureGatesVersioned)
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "Versioned feature gates")
+	end := git("rev-parse", "HEAD")
+
+	report, err := NewFeatureGates().Changes(repo, start, end)
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 3)
+
+	require.Equal(t, "APIListChunking", report.Changes[0].Name)
+	require.Equal(t, []FeatureGateChangeKind{FeatureGateRemoved}, report.Changes[0].Kinds)
+	require.Equal(t, "GA", report.Changes[0].StageChange())
+
+	require.Equal(t, "MyNewFeature", report.Changes[1].Name)
+	require.Equal(t, []FeatureGateChangeKind{FeatureGateAdded}, report.Changes[1].Kinds)
+
+	require.Equal(t, "NodeSwap", report.Changes[2].Name)
+	require.Equal(t, []FeatureGateChangeKind{FeatureGateStageChanged, FeatureGateDefaultFlipped}, report.Changes[2].Kinds)
+	require.Equal(t, "Alpha → Beta", report.Changes[2].StageChange())
+	require.Equal(t, "false → true", report.Changes[2].DefaultChange())
+	require.Equal(t, "stage-changed, default-flipped", report.Changes[2].KindsString())
+
+	_, err = NewFeatureGates().Changes(repo, start, "wrong")
+	require.Error(t, err)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+
+	khttp "sigs.k8s.io/release-utils/http"
+)
+
+const (
+	// GitHub is the default forge, which uses the release-sdk GitHub client.
+	GitHub = "github"
+
+	// GitLab is the forge for GitLab.com or self-hosted GitLab instances.
+	GitLab = "gitlab"
+
+	// Gitea is the forge for Gitea (and Forgejo) instances.
+	Gitea = "gitea"
+
+	// DefaultGitLabURL is the default base URL for the GitLab forge.
+	DefaultGitLabURL = "https://gitlab.com"
+
+	// DefaultGiteaURL is the default base URL for the Gitea forge.
+	DefaultGiteaURL = "https://gitea.com"
+
+	// GitLabTokenEnvKey is the environment variable containing the GitLab
+	// access token.
+	GitLabTokenEnvKey = "GITLAB_TOKEN"
+
+	// GiteaTokenEnvKey is the environment variable containing the Gitea
+	// access token.
+	GiteaTokenEnvKey = "GITEA_TOKEN"
+
+	// requestTimeout is the timeout of every request to the forge API.
+	requestTimeout = time.Minute
+)
+
+// Names returns all supported forges.
+func Names() []string {
+	return []string{GitHub, GitLab, Gitea}
+}
+
+// DefaultURL returns the default base URL of the forge.
+func DefaultURL(forge string) string {
+	switch forge {
+	case GitLab:
+		return DefaultGitLabURL
+	case Gitea:
+		return DefaultGiteaURL
+	default:
+		return "https://github.com"
+	}
+}
+
+// Client is the interface of a forge used for gathering release notes. The
+// data model follows the GitHub API: Forges other than GitHub map their
+// commits, merge requests and labels to the corresponding go-github types.
+// The release-sdk GitHub client satisfies this interface.
+type Client interface {
+	GetCommit(
+		context.Context, string, string, string,
+	) (*gogithub.Commit, *gogithub.Response, error)
+	ListCommits(
+		context.Context, string, string, *gogithub.CommitsListOptions,
+	) ([]*gogithub.RepositoryCommit, *gogithub.Response, error)
+	GetPullRequest(
+		context.Context, string, string, int,
+	) (*gogithub.PullRequest, *gogithub.Response, error)
+	ListPullRequestsWithCommit(
+		context.Context, string, string, string, *gogithub.ListOptions,
+	) ([]*gogithub.PullRequest, *gogithub.Response, error)
+	ListIssues(
+		context.Context, string, string, *gogithub.IssueListByRepoOptions,
+	) ([]*gogithub.Issue, *gogithub.Response, error)
+}
+
+// restClient is a minimal JSON REST API client.
+type restClient struct {
+	baseURL string
+	header  http.Header
+	client  *http.Client
+}
+
+func newRESTClient(baseURL string, header http.Header) *restClient {
+	return &restClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		header:  header,
+		client:  khttp.NewAgent().WithTimeout(requestTimeout).Client(),
+	}
+}
+
+// get requests the API path and decodes the JSON response into v. The
+// returned response contains the pagination derived from the Link header and
+// is also set if the request was not successful.
+func (c *restClient) get(ctx context.Context, path string, query url.Values, v any) (*gogithub.Response, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	for key, values := range c.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	res := &gogithub.Response{Response: resp}
+	res.NextPage, res.LastPage = linkPages(resp.Header.Get("Link"))
+
+	// GitLab omits the last page link but may provide the total pages
+	if totalPages, err := strconv.Atoi(resp.Header.Get("X-Total-Pages")); err == nil && totalPages > res.LastPage {
+		res.LastPage = totalPages
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if err != nil {
+			return res, fmt.Errorf("request %s: unexpected status %s", path, resp.Status)
+		}
+
+		return res, fmt.Errorf(
+			"request %s: unexpected status %s: %s",
+			path, resp.Status, strings.TrimSpace(string(body)),
+		)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return res, fmt.Errorf("decode response of %s: %w", path, err)
+	}
+
+	return res, nil
+}
+
+// linkPages returns the next and last page from a Link header, which is
+// supported by GitHub, GitLab and Gitea in the same way.
+func linkPages(header string) (next, last int) {
+	for _, link := range strings.Split(header, ",") {
+		target, params, found := strings.Cut(link, ";")
+		if !found {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(target), "<>"))
+		if err != nil {
+			continue
+		}
+
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case strings.Contains(params, `rel="next"`):
+			next = page
+		case strings.Contains(params, `rel="last"`):
+			last = page
+		}
+	}
+
+	if last < next {
+		last = next
+	}
+
+	return next, last
+}
+
+// pageQuery returns the pagination query parameters.
+func pageQuery(opts gogithub.ListOptions, perPageKey string) url.Values {
+	query := url.Values{}
+
+	if opts.Page > 0 {
+		query.Set("page", strconv.Itoa(opts.Page))
+	}
+
+	if opts.PerPage > 0 {
+		query.Set(perPageKey, strconv.Itoa(opts.PerPage))
+	}
+
+	return query
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+)
+
+// GiteaClient is a forge client for the Gitea (and Forgejo) REST API v1. The
+// Gitea API is modeled after the GitHub API, which means that most responses
+// can be decoded into the go-github types directly.
+type GiteaClient struct {
+	rest *restClient
+}
+
+// NewGitea creates a new Gitea forge client for the instance at `baseURL`,
+// which uses the optional access `token`.
+func NewGitea(baseURL, token string) *GiteaClient {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "token "+token)
+	}
+
+	return &GiteaClient{rest: newRESTClient(baseURL, header)}
+}
+
+func (*GiteaClient) repoPath(owner, repo string) string {
+	return "/api/v1/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(repo)
+}
+
+// GetCommit returns a single commit of the repository.
+func (c *GiteaClient) GetCommit(
+	ctx context.Context, owner, repo, sha string,
+) (*gogithub.Commit, *gogithub.Response, error) {
+	commit := &gogithub.RepositoryCommit{}
+
+	resp, err := c.rest.get(ctx,
+		c.repoPath(owner, repo)+"/git/commits/"+url.PathEscape(sha), nil, commit,
+	)
+	if err != nil {
+		return nil, resp, fmt.Errorf("get commit %s: %w", sha, err)
+	}
+
+	res := commit.GetCommit()
+	if res == nil {
+		res = &gogithub.Commit{}
+	}
+
+	res.SHA = commit.SHA
+	res.HTMLURL = commit.HTMLURL
+
+	return res, resp, nil
+}
+
+// ListCommits lists the commits of the repository.
+func (c *GiteaClient) ListCommits(
+	ctx context.Context, owner, repo string, opts *gogithub.CommitsListOptions,
+) ([]*gogithub.RepositoryCommit, *gogithub.Response, error) {
+	if opts == nil {
+		opts = &gogithub.CommitsListOptions{}
+	}
+
+	query := pageQuery(opts.ListOptions, "limit")
+
+	if opts.SHA != "" {
+		query.Set("sha", opts.SHA)
+	}
+
+	if !opts.Since.IsZero() {
+		query.Set("since", opts.Since.Format(time.RFC3339))
+	}
+
+	if !opts.Until.IsZero() {
+		query.Set("until", opts.Until.Format(time.RFC3339))
+	}
+
+	commits := []*gogithub.RepositoryCommit{}
+
+	resp, err := c.rest.get(ctx, c.repoPath(owner, repo)+"/commits", query, &commits)
+	if err != nil {
+		return nil, resp, fmt.Errorf("list commits: %w", err)
+	}
+
+	return commits, resp, nil
+}
+
+// GetPullRequest returns the pull request with the provided index.
+func (c *GiteaClient) GetPullRequest(
+	ctx context.Context, owner, repo string, number int,
+) (*gogithub.PullRequest, *gogithub.Response, error) {
+	pr := &gogithub.PullRequest{}
+
+	resp, err := c.rest.get(ctx, c.repoPath(owner, repo)+"/pulls/"+strconv.Itoa(number), nil, pr)
+	if err != nil {
+		return nil, resp, fmt.Errorf("get pull request #%d: %w", number, err)
+	}
+
+	return pr, resp, nil
+}
+
+// ListPullRequestsWithCommit returns the pull request which introduced the
+// commit. Gitea only provides a single pull request per commit, which means
+// that an empty list is returned if no pull request exists.
+func (c *GiteaClient) ListPullRequestsWithCommit(
+	ctx context.Context, owner, repo, sha string, _ *gogithub.ListOptions,
+) ([]*gogithub.PullRequest, *gogithub.Response, error) {
+	pr := &gogithub.PullRequest{}
+
+	resp, err := c.rest.get(ctx,
+		c.repoPath(owner, repo)+"/commits/"+url.PathEscape(sha)+"/pull", nil, pr,
+	)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return []*gogithub.PullRequest{}, resp, nil
+		}
+
+		return nil, resp, fmt.Errorf("get pull request for commit %s: %w", sha, err)
+	}
+
+	return []*gogithub.PullRequest{pr}, resp, nil
+}
+
+// ListIssues lists the pull requests of the repository as issues, which is
+// sufficient to find updated pull requests.
+func (c *GiteaClient) ListIssues(
+	ctx context.Context, owner, repo string, opts *gogithub.IssueListByRepoOptions,
+) ([]*gogithub.Issue, *gogithub.Response, error) {
+	if opts == nil {
+		opts = &gogithub.IssueListByRepoOptions{}
+	}
+
+	query := pageQuery(opts.ListOptions, "limit")
+	query.Set("type", "pulls")
+	query.Set("state", "all")
+
+	if !opts.Since.IsZero() {
+		query.Set("since", opts.Since.Format(time.RFC3339))
+	}
+
+	issues := []*gogithub.Issue{}
+
+	resp, err := c.rest.get(ctx, c.repoPath(owner, repo)+"/issues", query, &issues)
+	if err != nil {
+		return nil, resp, fmt.Errorf("list issues: %w", err)
+	}
+
+	return issues, resp, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+	"github.com/stretchr/testify/require"
+)
+
+const giteaPullRequestJSON = `{
+	"number": 7,
+	"title": "Fix bug",
+	"body": "Fix it",
+	"state": "closed",
+	"merged": true,
+	"labels": [{"name": "kind/bug"}],
+	"html_url": "https://gitea.example.com/org/repo/pulls/7",
+	"updated_at": "2026-01-02T00:00:00Z",
+	"user": {"login": "user", "html_url": "https://gitea.example.com/user"}
+}`
+
+func newGiteaServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	const repo = "/api/v1/repos/org/repo"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+repo+"/git/commits/{sha}", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token token", r.Header.Get("Authorization"))
+		fmt.Fprintf(w, `{
+			"sha": %q,
+			"html_url": "https://gitea.example.com/org/repo/commit/sha-1",
+			"commit": {
+				"message": "Fix bug (#7)",
+				"committer": {"name": "Jane Doe", "date": "2026-01-01T00:00:00Z"}
+			}
+		}`, r.PathValue("sha"))
+	})
+	mux.HandleFunc("GET "+repo+"/commits", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "main", r.URL.Query().Get("sha"))
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+		w.Header().Set("Link", `<http://gitea/commits?page=2>; rel="next",<http://gitea/commits?page=3>; rel="last"`)
+		fmt.Fprint(w, `[{"sha": "sha-1", "commit": {"message": "Fix bug (#7)"}, "author": {"login": "user"}}]`)
+	})
+	mux.HandleFunc("GET "+repo+"/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, giteaPullRequestJSON)
+	})
+	mux.HandleFunc("GET "+repo+"/commits/sha-1/pull", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, giteaPullRequestJSON)
+	})
+	mux.HandleFunc("GET "+repo+"/commits/sha-2/pull", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"pull request does not exist"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("GET "+repo+"/issues", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "pulls", r.URL.Query().Get("type"))
+		require.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("since"))
+		fmt.Fprint(w, `[{"number": 7, "updated_at": "2026-01-02T00:00:00Z", "pull_request": {"merged": true}}]`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGitea(t *testing.T) {
+	t.Parallel()
+
+	server := newGiteaServer(t)
+	sut := NewGitea(server.URL, "token")
+	ctx := context.Background()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// GetCommit
+	commit, _, err := sut.GetCommit(ctx, "org", "repo", "sha-1")
+	require.NoError(t, err)
+	require.Equal(t, "sha-1", commit.GetSHA())
+	require.Equal(t, "Fix bug (#7)", commit.GetMessage())
+	require.Equal(t, since, commit.GetCommitter().GetDate().Time)
+
+	// ListCommits
+	commits, resp, err := sut.ListCommits(ctx, "org", "repo", &gogithub.CommitsListOptions{
+		SHA:         "main",
+		ListOptions: gogithub.ListOptions{PerPage: 100},
+	})
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, "sha-1", commits[0].GetSHA())
+	require.Equal(t, "user", commits[0].GetAuthor().GetLogin())
+	require.Equal(t, 2, resp.NextPage)
+	require.Equal(t, 3, resp.LastPage)
+
+	// GetPullRequest
+	pr, _, err := sut.GetPullRequest(ctx, "org", "repo", 7)
+	require.NoError(t, err)
+	require.Equal(t, 7, pr.GetNumber())
+	require.True(t, pr.GetMerged())
+	require.Equal(t, "kind/bug", pr.Labels[0].GetName())
+	require.Equal(t, "https://gitea.example.com/user", pr.GetUser().GetHTMLURL())
+
+	// ListPullRequestsWithCommit
+	prs, _, err := sut.ListPullRequestsWithCommit(ctx, "org", "repo", "sha-1", nil)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, 7, prs[0].GetNumber())
+
+	prs, _, err = sut.ListPullRequestsWithCommit(ctx, "org", "repo", "sha-2", nil)
+	require.NoError(t, err)
+	require.Empty(t, prs)
+
+	// ListIssues
+	issues, _, err := sut.ListIssues(ctx, "org", "repo", &gogithub.IssueListByRepoOptions{
+		Since: since,
+	})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.True(t, issues[0].IsPullRequest())
+	require.Equal(t, since.Add(24*time.Hour), issues[0].GetUpdatedAt().Time)
+}
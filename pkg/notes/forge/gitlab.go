@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+)
+
+// GitLabClient is a forge client for the GitLab REST API v4. Merge requests
+// are mapped to pull requests, where the merge request IID becomes the pull
+// request number and scoped labels like `kind::bug` become `kind/bug`.
+type GitLabClient struct {
+	rest *restClient
+}
+
+// NewGitLab creates a new GitLab forge client for the instance at `baseURL`,
+// which uses the optional access `token`.
+func NewGitLab(baseURL, token string) *GitLabClient {
+	header := http.Header{}
+	if token != "" {
+		header.Set("PRIVATE-TOKEN", token)
+	}
+
+	return &GitLabClient{rest: newRESTClient(baseURL, header)}
+}
+
+// gitlabCommit is a commit of the GitLab API.
+type gitlabCommit struct {
+	ID             string    `json:"id"`
+	Message        string    `json:"message"`
+	AuthorName     string    `json:"author_name"`
+	AuthorEmail    string    `json:"author_email"`
+	AuthoredDate   time.Time `json:"authored_date"`
+	CommitterName  string    `json:"committer_name"`
+	CommitterEmail string    `json:"committer_email"`
+	CommittedDate  time.Time `json:"committed_date"`
+	WebURL         string    `json:"web_url"`
+}
+
+// gitlabMergeRequest is a merge request of the GitLab API.
+type gitlabMergeRequest struct {
+	IID          int       `json:"iid"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description"`
+	State        string    `json:"state"`
+	Labels       []string  `json:"labels"`
+	WebURL       string    `json:"web_url"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	SourceBranch string    `json:"source_branch"`
+	Author       struct {
+		Username string `json:"username"`
+		WebURL   string `json:"web_url"`
+	} `json:"author"`
+}
+
+func (c *gitlabCommit) toCommit() *gogithub.Commit {
+	return &gogithub.Commit{
+		SHA:     gogithub.String(c.ID),
+		Message: gogithub.String(c.Message),
+		HTMLURL: gogithub.String(c.WebURL),
+		Author: &gogithub.CommitAuthor{
+			Name:  gogithub.String(c.AuthorName),
+			Email: gogithub.String(c.AuthorEmail),
+			Date:  &gogithub.Timestamp{Time: c.AuthoredDate},
+		},
+		Committer: &gogithub.CommitAuthor{
+			Name:  gogithub.String(c.CommitterName),
+			Email: gogithub.String(c.CommitterEmail),
+			Date:  &gogithub.Timestamp{Time: c.CommittedDate},
+		},
+	}
+}
+
+func (mr *gitlabMergeRequest) toPullRequest() *gogithub.PullRequest {
+	labels := make([]*gogithub.Label, 0, len(mr.Labels))
+	for _, label := range mr.Labels {
+		labels = append(labels, &gogithub.Label{
+			Name: gogithub.String(strings.ReplaceAll(label, "::", "/")),
+		})
+	}
+
+	// Merged and closed merge requests are both closed pull requests
+	state := "closed"
+	if mr.State == "opened" || mr.State == "locked" {
+		state = "open"
+	}
+
+	return &gogithub.PullRequest{
+		Number:    gogithub.Int(mr.IID),
+		Title:     gogithub.String(mr.Title),
+		Body:      gogithub.String(mr.Description),
+		State:     gogithub.String(state),
+		Merged:    gogithub.Bool(mr.State == "merged"),
+		Labels:    labels,
+		HTMLURL:   gogithub.String(mr.WebURL),
+		UpdatedAt: &gogithub.Timestamp{Time: mr.UpdatedAt},
+		Head:      &gogithub.PullRequestBranch{Label: gogithub.String(mr.SourceBranch)},
+		User: &gogithub.User{
+			Login:   gogithub.String(mr.Author.Username),
+			HTMLURL: gogithub.String(mr.Author.WebURL),
+		},
+	}
+}
+
+// projectPath returns the API path of the project, which also supports
+// nested groups as owner.
+func (*GitLabClient) projectPath(owner, repo string) string {
+	return "/api/v4/projects/" + url.PathEscape(owner+"/"+repo)
+}
+
+// GetCommit returns a single commit of the project.
+func (c *GitLabClient) GetCommit(
+	ctx context.Context, owner, repo, sha string,
+) (*gogithub.Commit, *gogithub.Response, error) {
+	commit := &gitlabCommit{}
+
+	resp, err := c.rest.get(ctx,
+		c.projectPath(owner, repo)+"/repository/commits/"+url.PathEscape(sha), nil, commit,
+	)
+	if err != nil {
+		return nil, resp, fmt.Errorf("get commit %s: %w", sha, err)
+	}
+
+	return commit.toCommit(), resp, nil
+}
+
+// ListCommits lists the commits of the project.
+func (c *GitLabClient) ListCommits(
+	ctx context.Context, owner, repo string, opts *gogithub.CommitsListOptions,
+) ([]*gogithub.RepositoryCommit, *gogithub.Response, error) {
+	if opts == nil {
+		opts = &gogithub.CommitsListOptions{}
+	}
+
+	query := pageQuery(opts.ListOptions, "per_page")
+
+	if opts.SHA != "" {
+		query.Set("ref_name", opts.SHA)
+	}
+
+	if !opts.Since.IsZero() {
+		query.Set("since", opts.Since.Format(time.RFC3339))
+	}
+
+	if !opts.Until.IsZero() {
+		query.Set("until", opts.Until.Format(time.RFC3339))
+	}
+
+	commits := []*gitlabCommit{}
+
+	resp, err := c.rest.get(ctx, c.projectPath(owner, repo)+"/repository/commits", query, &commits)
+	if err != nil {
+		return nil, resp, fmt.Errorf("list commits: %w", err)
+	}
+
+	res := make([]*gogithub.RepositoryCommit, 0, len(commits))
+	for _, commit := range commits {
+		res = append(res, &gogithub.RepositoryCommit{
+			SHA:     gogithub.String(commit.ID),
+			HTMLURL: gogithub.String(commit.WebURL),
+			Commit:  commit.toCommit(),
+		})
+	}
+
+	return res, resp, nil
+}
+
+// GetPullRequest returns the merge request with the provided IID.
+func (c *GitLabClient) GetPullRequest(
+	ctx context.Context, owner, repo string, number int,
+) (*gogithub.PullRequest, *gogithub.Response, error) {
+	mr := &gitlabMergeRequest{}
+
+	resp, err := c.rest.get(ctx,
+		c.projectPath(owner, repo)+"/merge_requests/"+strconv.Itoa(number), nil, mr,
+	)
+	if err != nil {
+		return nil, resp, fmt.Errorf("get merge request !%d: %w", number, err)
+	}
+
+	return mr.toPullRequest(), resp, nil
+}
+
+// ListPullRequestsWithCommit lists the merge requests containing the commit.
+func (c *GitLabClient) ListPullRequestsWithCommit(
+	ctx context.Context, owner, repo, sha string, opts *gogithub.ListOptions,
+) ([]*gogithub.PullRequest, *gogithub.Response, error) {
+	if opts == nil {
+		opts = &gogithub.ListOptions{}
+	}
+
+	mrs := []*gitlabMergeRequest{}
+
+	resp, err := c.rest.get(ctx,
+		c.projectPath(owner, repo)+"/repository/commits/"+url.PathEscape(sha)+"/merge_requests",
+		pageQuery(*opts, "per_page"), &mrs,
+	)
+	if err != nil {
+		return nil, resp, fmt.Errorf("list merge requests for commit %s: %w", sha, err)
+	}
+
+	res := make([]*gogithub.PullRequest, 0, len(mrs))
+	for _, mr := range mrs {
+		res = append(res, mr.toPullRequest())
+	}
+
+	return res, resp, nil
+}
+
+// ListIssues lists the merge requests of the project as issues, which is
+// sufficient to find updated merge requests.
+func (c *GitLabClient) ListIssues(
+	ctx context.Context, owner, repo string, opts *gogithub.IssueListByRepoOptions,
+) ([]*gogithub.Issue, *gogithub.Response, error) {
+	if opts == nil {
+		opts = &gogithub.IssueListByRepoOptions{}
+	}
+
+	query := pageQuery(opts.ListOptions, "per_page")
+	query.Set("state", "all")
+	query.Set("order_by", "updated_at")
+
+	if !opts.Since.IsZero() {
+		query.Set("updated_after", opts.Since.Format(time.RFC3339))
+	}
+
+	mrs := []*gitlabMergeRequest{}
+
+	resp, err := c.rest.get(ctx, c.projectPath(owner, repo)+"/merge_requests", query, &mrs)
+	if err != nil {
+		return nil, resp, fmt.Errorf("list merge requests: %w", err)
+	}
+
+	res := make([]*gogithub.Issue, 0, len(mrs))
+	for _, mr := range mrs {
+		pr := mr.toPullRequest()
+		res = append(res, &gogithub.Issue{
+			Number:           pr.Number,
+			Title:            pr.Title,
+			Body:             pr.Body,
+			State:            pr.State,
+			Labels:           pr.Labels,
+			HTMLURL:          pr.HTMLURL,
+			UpdatedAt:        pr.UpdatedAt,
+			User:             pr.User,
+			PullRequestLinks: &gogithub.PullRequestLinks{HTMLURL: pr.HTMLURL},
+		})
+	}
+
+	return res, resp, nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+	"github.com/stretchr/testify/require"
+)
+
+const gitlabMergeRequestJSON = `{
+	"iid": 42,
+	"title": "Add feature",
+	"description": "` + "```release-note\\nNew feature\\n```" + `",
+	"state": "merged",
+	"labels": ["kind::feature", "sig/release"],
+	"web_url": "https://gitlab.example.com/group/sub/project/-/merge_requests/42",
+	"updated_at": "2026-01-02T03:04:05Z",
+	"source_branch": "feature",
+	"author": {"username": "user", "web_url": "https://gitlab.example.com/user"}
+}`
+
+func newGitLabServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	const project = "/api/v4/projects/group%2Fsub%2Fproject"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+project+"/repository/commits/{sha}", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+		fmt.Fprintf(w, `{
+			"id": %q,
+			"message": "Merge branch 'feature' into 'main'\n\nSee merge request group/sub/project!42",
+			"committer_name": "Jane Doe",
+			"committed_date": "2026-01-01T00:00:00Z"
+		}`, r.PathValue("sha"))
+	})
+	mux.HandleFunc("GET "+project+"/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "main", r.URL.Query().Get("ref_name"))
+		require.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("since"))
+
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `[{"id": "sha-2"}]`)
+
+			return
+		}
+
+		w.Header().Set("Link", `<http://gitlab/commits?page=2>; rel="next"`)
+		w.Header().Set("X-Total-Pages", "2")
+		fmt.Fprint(w, `[{"id": "sha-1", "web_url": "https://gitlab.example.com/-/commit/sha-1"}]`)
+	})
+	mux.HandleFunc("GET "+project+"/merge_requests/42", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, gitlabMergeRequestJSON)
+	})
+	mux.HandleFunc("GET "+project+"/merge_requests/1", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"404 Not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("GET "+project+"/repository/commits/{sha}/merge_requests", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "["+gitlabMergeRequestJSON+"]")
+	})
+	mux.HandleFunc("GET "+project+"/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "all", r.URL.Query().Get("state"))
+		require.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("updated_after"))
+		fmt.Fprint(w, "["+gitlabMergeRequestJSON+"]")
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGitLab(t *testing.T) {
+	t.Parallel()
+
+	server := newGitLabServer(t)
+	sut := NewGitLab(server.URL+"/", "token")
+	ctx := context.Background()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Requests do not hang forever
+	require.Equal(t, requestTimeout, sut.rest.client.Timeout)
+
+	// GetCommit
+	commit, _, err := sut.GetCommit(ctx, "group/sub", "project", "sha-1")
+	require.NoError(t, err)
+	require.Equal(t, "sha-1", commit.GetSHA())
+	require.Contains(t, commit.GetMessage(), "See merge request")
+	require.Equal(t, since, commit.GetCommitter().GetDate().Time)
+
+	// ListCommits
+	opts := &gogithub.CommitsListOptions{
+		SHA:         "main",
+		Since:       since,
+		ListOptions: gogithub.ListOptions{Page: 1, PerPage: 100},
+	}
+	commits, resp, err := sut.ListCommits(ctx, "group/sub", "project", opts)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, "sha-1", commits[0].GetSHA())
+	require.Equal(t, "sha-1", commits[0].GetCommit().GetSHA())
+	require.Equal(t, 2, resp.NextPage)
+	require.Equal(t, 2, resp.LastPage)
+
+	opts.Page = resp.NextPage
+	commits, resp, err = sut.ListCommits(ctx, "group/sub", "project", opts)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, "sha-2", commits[0].GetSHA())
+	require.Zero(t, resp.NextPage)
+
+	// GetPullRequest
+	pr, _, err := sut.GetPullRequest(ctx, "group/sub", "project", 42)
+	require.NoError(t, err)
+	require.Equal(t, 42, pr.GetNumber())
+	require.Equal(t, "closed", pr.GetState())
+	require.True(t, pr.GetMerged())
+	require.Equal(t, "```release-note\nNew feature\n```", pr.GetBody())
+	require.Equal(t, "user", pr.GetUser().GetLogin())
+	require.Equal(t, "https://gitlab.example.com/user", pr.GetUser().GetHTMLURL())
+	require.Len(t, pr.Labels, 2)
+	require.Equal(t, "kind/feature", pr.Labels[0].GetName())
+	require.Equal(t, "sig/release", pr.Labels[1].GetName())
+
+	_, resp, err = sut.GetPullRequest(ctx, "group/sub", "project", 1)
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// ListPullRequestsWithCommit
+	prs, _, err := sut.ListPullRequestsWithCommit(ctx, "group/sub", "project", "sha-1", nil)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	require.Equal(t, 42, prs[0].GetNumber())
+
+	// ListIssues
+	issues, _, err := sut.ListIssues(ctx, "group/sub", "project", &gogithub.IssueListByRepoOptions{
+		Since: since,
+	})
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.True(t, issues[0].IsPullRequest())
+	require.Equal(t, 42, issues[0].GetNumber())
+	require.Equal(t, since.Add(27*time.Hour+4*time.Minute+5*time.Second), issues[0].GetUpdatedAt().Time)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// KEP stages as defined in the kep.yaml of the enhancements repository.
+const (
+	KEPStageAlpha  = "alpha"
+	KEPStageBeta   = "beta"
+	KEPStageStable = "stable"
+)
+
+// KEP is the metadata of a Kubernetes Enhancement Proposal.
+type KEP struct {
+	// Number is the KEP number, which matches the tracking issue in
+	// kubernetes/enhancements.
+	Number int `json:"number"`
+
+	// Title is the title of the KEP.
+	Title string `json:"title"`
+
+	// Stage is the latest stage of the KEP, like alpha, beta or stable.
+	Stage string `json:"stage,omitempty"`
+
+	// FeatureGates are the names of the feature gates of the KEP.
+	FeatureGates []string `json:"feature_gates,omitempty"`
+
+	// Milestones are the releases in which the KEP reached a stage, indexed
+	// by the stage, like `v1.30` for beta.
+	Milestones map[string]string `json:"milestones,omitempty"`
+
+	// LatestMilestone is the latest release the KEP has been targeted at.
+	LatestMilestone string `json:"latest_milestone,omitempty"`
+}
+
+// KEPs are the resolved KEPs indexed by their number.
+type KEPs map[int]*KEP
+
+// kepYAML is the subset of the kep.yaml fields used for the release notes.
+type kepYAML struct {
+	Title           string            `yaml:"title"`
+	Number          string            `yaml:"kep-number"`
+	Stage           string            `yaml:"stage"`
+	LatestMilestone string            `yaml:"latest-milestone"`
+	Milestone       map[string]string `yaml:"milestone"`
+	FeatureGates    []struct {
+		Name string `yaml:"name"`
+	} `yaml:"feature-gates"`
+}
+
+var (
+	// kepDirRE matches KEP directories like `1234-my-feature`.
+	kepDirRE = regexp.MustCompile(`^(\d+)-`)
+
+	// kepURLRE matches links to KEP issues or to the KEP directories in
+	// kubernetes/enhancements.
+	kepURLRE = regexp.MustCompile(`/kubernetes/enhancements/(?:issues/(\d+)|(?:tree|blob)/[^/]+/keps/[^/]+/(\d+)-)`)
+)
+
+// LoadKEPs reads the metadata of all KEPs from a local checkout of the
+// kubernetes/enhancements repository.
+func LoadKEPs(enhancementsDir string) (KEPs, error) {
+	paths, err := filepath.Glob(filepath.Join(enhancementsDir, "keps", "*", "*", "kep.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("finding KEPs: %w", err)
+	}
+
+	keps := KEPs{}
+
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading KEP: %w", err)
+		}
+
+		metadata := &kepYAML{}
+		if err := yaml.Unmarshal(content, metadata); err != nil {
+			return nil, fmt.Errorf("unmarshalling KEP %s: %w", path, err)
+		}
+
+		number, err := strconv.Atoi(strings.TrimSpace(metadata.Number))
+		if err != nil {
+			// Fallback to the number of the KEP directory
+			match := kepDirRE.FindStringSubmatch(filepath.Base(filepath.Dir(path)))
+			if match == nil {
+				logrus.Debugf("Skipping KEP %s without number", path)
+
+				continue
+			}
+
+			number, err = strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("parsing KEP number of %s: %w", path, err)
+			}
+		}
+
+		kep := &KEP{
+			Number:          number,
+			Title:           metadata.Title,
+			Stage:           metadata.Stage,
+			LatestMilestone: metadata.LatestMilestone,
+		}
+
+		for stage, milestone := range metadata.Milestone {
+			if milestone == "" {
+				continue
+			}
+
+			if kep.Milestones == nil {
+				kep.Milestones = map[string]string{}
+			}
+
+			kep.Milestones[stage] = milestone
+		}
+
+		for _, featureGate := range metadata.FeatureGates {
+			if featureGate.Name != "" {
+				kep.FeatureGates = append(kep.FeatureGates, featureGate.Name)
+			}
+		}
+
+		keps[number] = kep
+	}
+
+	logrus.Infof("Loaded %d KEPs from %s", len(keps), enhancementsDir)
+
+	return keps, nil
+}
+
+// GraduatedIn returns true if the KEP reached its current stage in the
+// release line of the version. The milestone of the stage is used, or the
+// latest milestone if the KEP does not define one for the stage.
+func (k *KEP) GraduatedIn(version semver.Version) bool {
+	milestone := k.Milestones[k.Stage]
+	if milestone == "" {
+		milestone = k.LatestMilestone
+	}
+
+	if milestone == "" {
+		return false
+	}
+
+	milestoneVersion, err := semver.ParseTolerant(milestone)
+	if err != nil {
+		logrus.Debugf("Ignoring invalid milestone %q of KEP %d: %v", milestone, k.Number, err)
+
+		return false
+	}
+
+	return milestoneVersion.Major == version.Major && milestoneVersion.Minor == version.Minor
+}
+
+// KEPNumberFromURL returns the KEP number of a link to kubernetes/enhancements
+// or false if the URL does not reference a KEP.
+func KEPNumberFromURL(u string) (int, bool) {
+	match := kepURLRE.FindStringSubmatch(u)
+	if match == nil {
+		return 0, false
+	}
+
+	number, err := strconv.Atoi(match[1] + match[2])
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
+
+// Enrich attaches the KEP metadata to all KEP documentation links of the
+// release notes.
+func (k KEPs) Enrich(releaseNotes *ReleaseNotes) {
+	for _, note := range releaseNotes.ByPR() {
+		for _, doc := range note.Documentation {
+			if doc == nil || doc.Type != DocTypeKEP {
+				continue
+			}
+
+			number, ok := KEPNumberFromURL(doc.URL)
+			if !ok {
+				continue
+			}
+
+			kep, ok := k[number]
+			if !ok {
+				logrus.Debugf("KEP %d of PR #%d not found in enhancements repository", number, note.PrNumber)
+
+				continue
+			}
+
+			doc.KEP = kep
+		}
+	}
+}
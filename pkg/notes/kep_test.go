@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadKEPs(t *testing.T) {
+	t.Parallel()
+
+	keps, err := LoadKEPs("testdata/enhancements")
+	require.NoError(t, err)
+	require.Equal(t, KEPs{
+		2400: {
+			Number:          2400,
+			Title:           "Node system swap support",
+			Stage:           KEPStageBeta,
+			FeatureGates:    []string{"NodeSwap"},
+			Milestones:      map[string]string{KEPStageAlpha: "v1.22", KEPStageBeta: "v1.28"},
+			LatestMilestone: "v1.28",
+		},
+		1440: {Number: 1440, Title: "kubectl events", Stage: KEPStageStable, LatestMilestone: "v1.26"},
+		3000: {Number: 3000, Title: "KEP without number", Stage: KEPStageAlpha},
+	}, keps)
+
+	keps, err = LoadKEPs(t.TempDir())
+	require.NoError(t, err)
+	require.Empty(t, keps)
+}
+
+func TestKEPGraduatedIn(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		kep      KEP
+		version  string
+		expected bool
+	}{
+		{KEP{Stage: KEPStageBeta, Milestones: map[string]string{KEPStageBeta: "v1.28"}}, "1.28.3", true},
+		{KEP{Stage: KEPStageBeta, Milestones: map[string]string{KEPStageBeta: "v1.28"}}, "1.29.0", false},
+		{KEP{Stage: KEPStageStable, Milestones: map[string]string{KEPStageStable: "v1.26"}, LatestMilestone: "v1.30"}, "1.30.0", false},
+		{KEP{Stage: KEPStageStable, LatestMilestone: "v1.30"}, "1.30.0", true},
+		{KEP{Stage: KEPStageAlpha, Milestones: map[string]string{KEPStageAlpha: "invalid"}}, "1.30.0", false},
+		{KEP{Stage: KEPStageAlpha}, "1.30.0", false},
+	} {
+		require.Equal(t, tc.expected, tc.kep.GraduatedIn(semver.MustParse(tc.version)), tc)
+	}
+}
+
+func TestKEPNumberFromURL(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		url      string
+		expected int
+		ok       bool
+	}{
+		{"https://github.com/kubernetes/enhancements/issues/2400", 2400, true},
+		{"https://github.com/kubernetes/enhancements/tree/master/keps/sig-node/2400-node-swap", 2400, true},
+		{"https://github.com/kubernetes/enhancements/blob/master/keps/sig-cli/1440-kubectl-events/README.md", 1440, true},
+		{"https://github.com/kubernetes/enhancements/pull/1234", 0, false},
+		{"https://kubernetes.io/docs/concepts/", 0, false},
+	} {
+		number, ok := KEPNumberFromURL(tc.url)
+		require.Equal(t, tc.expected, number, tc.url)
+		require.Equal(t, tc.ok, ok, tc.url)
+	}
+}
+
+func TestKEPsEnrich(t *testing.T) {
+	t.Parallel()
+
+	keps, err := LoadKEPs("testdata/enhancements")
+	require.NoError(t, err)
+
+	kepDoc := &Documentation{URL: "https://github.com/kubernetes/enhancements/issues/2400", Type: DocTypeKEP}
+	unknownDoc := &Documentation{URL: "https://github.com/kubernetes/enhancements/issues/9999", Type: DocTypeKEP}
+	externalDoc := &Documentation{URL: "https://example.com/kubernetes/enhancements/issues/1440", Type: DocTypeExternal}
+
+	releaseNotes := NewReleaseNotes()
+	releaseNotes.Set(1, &ReleaseNote{
+		PrNumber:      1,
+		Documentation: []*Documentation{kepDoc, unknownDoc, externalDoc, nil},
+	})
+
+	keps.Enrich(releaseNotes)
+	require.Equal(t, keps[2400], kepDoc.KEP)
+	require.Nil(t, unknownDoc.KEP)
+	require.Nil(t, externalDoc.KEP)
+}
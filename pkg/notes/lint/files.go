@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"k8s.io/release/pkg/notes"
+)
+
+// File is a release notes map or JSON release notes document.
+type File struct {
+	// Path is the location of the file.
+	Path string
+
+	// Targets are the release notes of the file.
+	Targets []*Target
+
+	// maps are the decoded map documents if the file is a map file.
+	maps []*notes.ReleaseNotesMap
+
+	// mapTexts are the map documents of the targets, which get updated
+	// before writing the file.
+	mapTexts map[*Target]*notes.ReleaseNotesMap
+
+	// document is the decoded JSON document if the file contains release
+	// notes generated with `--format=json`.
+	document *notes.JSONDocument
+}
+
+var (
+	yamlDocumentSeparatorRE = regexp.MustCompile(`^---\s*$`)
+	yamlCommentRE           = regexp.MustCompile(`^\s*#`)
+	yamlTextRE              = regexp.MustCompile(`^\s+text:`)
+)
+
+// LoadMaps loads all release notes maps from the path, which can be a
+// single file or a directory.
+func LoadMaps(path string) ([]*File, error) {
+	files := []*File{}
+
+	if err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || (filepath.Ext(p) != ".yaml" && filepath.Ext(p) != ".yml") {
+			return nil
+		}
+
+		file, err := loadMap(p)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, file)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("loading maps from %s: %w", path, err)
+	}
+
+	return files, nil
+}
+
+func loadMap(path string) (*File, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading map: %w", err)
+	}
+
+	textLines, hasComments := mapTextLines(content)
+	file := &File{Path: path, mapTexts: map[*Target]*notes.ReleaseNotesMap{}}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+
+	for i := 0; ; i++ {
+		noteMap := &notes.ReleaseNotesMap{}
+		if err := decoder.Decode(noteMap); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("decoding map %s: %w", path, err)
+		}
+
+		file.maps = append(file.maps, noteMap)
+
+		if noteMap.ReleaseNote.Text == nil {
+			continue
+		}
+
+		target := &Target{
+			Source: path,
+			Note:   noteFromMap(noteMap),
+			// Maps with comments are not fixable, because re-marshalling
+			// them would drop the comments.
+			fixable: !hasComments,
+		}
+
+		if i < len(textLines) {
+			target.Line = textLines[i]
+		}
+
+		file.Targets = append(file.Targets, target)
+		file.mapTexts[target] = noteMap
+	}
+
+	return file, nil
+}
+
+// mapTextLines returns the line of the text field per YAML document, or
+// zero if the document has none, as well as if the content contains any
+// comment.
+func mapTextLines(content []byte) (textLines []int, hasComments bool) {
+	textLines = []int{0}
+	hasContent := false
+
+	for i, line := range strings.Split(string(content), "\n") {
+		switch {
+		case yamlDocumentSeparatorRE.MatchString(line):
+			// A leading separator does not start a new document.
+			if hasContent {
+				textLines = append(textLines, 0)
+				hasContent = false
+			}
+		case yamlCommentRE.MatchString(line):
+			hasComments = true
+		case strings.TrimSpace(line) != "":
+			hasContent = true
+
+			if yamlTextRE.MatchString(line) && textLines[len(textLines)-1] == 0 {
+				textLines[len(textLines)-1] = i + 1
+			}
+		}
+	}
+
+	return textLines, hasComments
+}
+
+// noteFromMap converts the map into a release note, which contains only
+// the fields set by the map.
+func noteFromMap(noteMap *notes.ReleaseNotesMap) *notes.ReleaseNote {
+	note := &notes.ReleaseNote{
+		PrNumber: noteMap.PR,
+		Commit:   noteMap.Commit,
+		IsMapped: true,
+	}
+
+	if noteMap.ReleaseNote.Text != nil {
+		note.Text = *noteMap.ReleaseNote.Text
+	}
+
+	if noteMap.ReleaseNote.Documentation != nil {
+		note.Documentation = *noteMap.ReleaseNote.Documentation
+	}
+
+	if noteMap.ReleaseNote.Author != nil {
+		note.Author = *noteMap.ReleaseNote.Author
+	}
+
+	if noteMap.ReleaseNote.Areas != nil {
+		note.Areas = *noteMap.ReleaseNote.Areas
+	}
+
+	if noteMap.ReleaseNote.Kinds != nil {
+		note.Kinds = *noteMap.ReleaseNote.Kinds
+	}
+
+	if noteMap.ReleaseNote.SIGs != nil {
+		note.SIGs = *noteMap.ReleaseNote.SIGs
+	}
+
+	if noteMap.ReleaseNote.Feature != nil {
+		note.Feature = *noteMap.ReleaseNote.Feature
+	}
+
+	if noteMap.ReleaseNote.ActionRequired != nil {
+		note.ActionRequired = *noteMap.ReleaseNote.ActionRequired
+	}
+
+	if noteMap.ReleaseNote.DoNotPublish != nil {
+		note.DoNotPublish = *noteMap.ReleaseNote.DoNotPublish
+	}
+
+	return note
+}
+
+// LoadNotes loads a release notes document generated with `--format=json`.
+func LoadNotes(path string) (*File, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading release notes: %w", err)
+	}
+
+	doc, err := notes.DecodeJSONDocument(content)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshalling release notes %s: %w", path, err)
+	}
+
+	file := &File{Path: path, document: doc}
+	lines := strings.Split(string(content), "\n")
+
+	addTarget := func(key string, note *notes.ReleaseNote) {
+		target := &Target{
+			Source:  path,
+			Note:    note,
+			fixable: true,
+		}
+
+		prefix := fmt.Sprintf("%q:", key)
+		for i, line := range lines {
+			if strings.HasPrefix(strings.TrimSpace(line), prefix) {
+				target.Line = i + 1
+
+				break
+			}
+		}
+
+		file.Targets = append(file.Targets, target)
+	}
+
+	for _, pr := range slices.Sorted(maps.Keys(doc.ReleaseNotes)) {
+		addTarget(fmt.Sprint(pr), doc.ReleaseNotes[pr])
+	}
+
+	// Release notes of commits without PR are keyed by the commit SHA
+	for _, sha := range slices.Sorted(maps.Keys(doc.ReleaseNotesByCommit)) {
+		addTarget(sha, doc.ReleaseNotesByCommit[sha])
+	}
+
+	return file, nil
+}
+
+// Write writes the file including all fixes back to disk.
+func (f *File) Write() error {
+	var content []byte
+
+	if f.document != nil {
+		buf := &bytes.Buffer{}
+		if err := notes.EncodeJSONDocument(buf, f.document); err != nil {
+			return fmt.Errorf("encoding release notes: %w", err)
+		}
+
+		content = buf.Bytes()
+	} else {
+		for target, noteMap := range f.mapTexts {
+			text := target.Note.Text
+			noteMap.ReleaseNote.Text = &text
+		}
+
+		documents := []string{}
+
+		for _, noteMap := range f.maps {
+			data, err := yaml.Marshal(noteMap)
+			if err != nil {
+				return fmt.Errorf("marshalling release notes map: %w", err)
+			}
+
+			documents = append(documents, string(data))
+		}
+
+		content = []byte(strings.Join(documents, "---\n"))
+	}
+
+	if err := os.WriteFile(f.Path, content, os.FileMode(0o644)); err != nil {
+		return fmt.Errorf("writing %s: %w", f.Path, err)
+	}
+
+	return nil
+}
+
+// Modified returns true if the text of any target has been changed by a
+// fix.
+func (f *File) Modified() bool {
+	for _, target := range f.Targets {
+		if target.modified {
+			return true
+		}
+	}
+
+	return false
+}
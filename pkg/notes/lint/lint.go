@@ -0,0 +1,374 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"k8s.io/release/pkg/notes"
+)
+
+// Severity is the severity of a lint finding. The values match the SARIF
+// result levels.
+type Severity string
+
+const (
+	// SeverityError findings fail the lint run.
+	SeverityError Severity = "error"
+
+	// SeverityWarning findings are reported but do not fail the lint run.
+	SeverityWarning Severity = "warning"
+
+	// SeverityNote findings are informational only.
+	SeverityNote Severity = "note"
+
+	// SeverityOff disables a rule.
+	SeverityOff Severity = "off"
+)
+
+// ParseSeverity parses a severity string.
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToLower(s))
+	if !slices.Contains([]Severity{SeverityError, SeverityWarning, SeverityNote, SeverityOff}, severity) {
+		return "", fmt.Errorf(
+			"invalid severity %q, must be one of: %s, %s, %s, %s",
+			s, SeverityError, SeverityWarning, SeverityNote, SeverityOff,
+		)
+	}
+
+	return severity, nil
+}
+
+const (
+	// FormatText is the human readable output format.
+	FormatText = "text"
+
+	// FormatJSON outputs the findings as JSON.
+	FormatJSON = "json"
+
+	// FormatSARIF outputs the findings in the Static Analysis Results
+	// Interchange Format, which is supported by most CI systems for
+	// annotations.
+	FormatSARIF = "sarif"
+)
+
+// Formats returns all supported output formats.
+func Formats() []string {
+	return []string{FormatText, FormatJSON, FormatSARIF}
+}
+
+// Rule is a single lint rule.
+type Rule interface {
+	// ID returns the unique identifier of the rule.
+	ID() string
+
+	// Description returns a short description of the rule.
+	Description() string
+
+	// DefaultSeverity returns the severity of the rule if not configured
+	// otherwise.
+	DefaultSeverity() Severity
+
+	// Check returns the messages of all violations of the rule for the
+	// target.
+	Check(ctx *Context, target *Target) []string
+}
+
+// Fixer is implemented by rules which are able to fix their violations
+// safely.
+type Fixer interface {
+	// Fix returns the fixed note text and if the text got changed.
+	Fix(text string) (string, bool)
+}
+
+// Context is the context of a lint run.
+type Context struct {
+	// Options are the options of the lint run.
+	Options *Options
+
+	// Targets are all targets of the lint run, which allows rules to check
+	// across release notes.
+	Targets []*Target
+}
+
+// Target is a single release note to be linted.
+type Target struct {
+	// Source is the path of the file the note has been read from.
+	Source string `json:"source"`
+
+	// Line is the line of the note text within the source, or zero if
+	// unknown.
+	Line int `json:"line,omitempty"`
+
+	// Note is the release note.
+	Note *notes.ReleaseNote `json:"-"`
+
+	// fixable indicates if the source of the target can be fixed
+	// automatically.
+	fixable bool
+
+	// modified indicates if the note text has been changed by a fix.
+	modified bool
+}
+
+// setText changes the text of the note and updates the markdown, which
+// starts with the capitalized and indented note text.
+func (t *Target) setText(text string) {
+	indent := func(s string) string {
+		r := []rune(strings.ReplaceAll(s, "\n", "\n  "))
+		if len(r) > 0 {
+			r[0] = unicode.ToUpper(r[0])
+		}
+
+		return string(r)
+	}
+
+	oldMarkdown := indent(t.Note.Text)
+	if oldMarkdown != "" && strings.HasPrefix(t.Note.Markdown, oldMarkdown) {
+		t.Note.Markdown = indent(text) + strings.TrimPrefix(t.Note.Markdown, oldMarkdown)
+	}
+
+	t.Note.Text = text
+	t.modified = true
+}
+
+// Options are the options of the linter.
+type Options struct {
+	// Severities overrides the severity per rule ID.
+	Severities map[string]Severity
+
+	// MaxLength is the maximum length of a release note text in
+	// characters.
+	MaxLength int
+
+	// Fix enables fixing the violations of rules which support it.
+	Fix bool
+}
+
+// DefaultMaxLength is the default maximum length of a release note text.
+const DefaultMaxLength = 1000
+
+// DefaultOptions returns the default linter options.
+func DefaultOptions() *Options {
+	return &Options{
+		Severities: map[string]Severity{},
+		MaxLength:  DefaultMaxLength,
+	}
+}
+
+// Finding is a single violation of a rule.
+type Finding struct {
+	// RuleID is the ID of the violated rule.
+	RuleID string `json:"rule_id"`
+
+	// Severity is the configured severity of the rule.
+	Severity Severity `json:"severity"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+
+	// Source is the path of the file containing the note.
+	Source string `json:"source"`
+
+	// Line is the line of the note text within the source, or zero if
+	// unknown.
+	Line int `json:"line,omitempty"`
+
+	// PrNumber is the pull request number of the note.
+	PrNumber int `json:"pr_number,omitempty"`
+
+	// Fixed is true if the violation has been fixed automatically.
+	Fixed bool `json:"fixed,omitempty"`
+}
+
+// Result is the result of a lint run.
+type Result struct {
+	// Rules are the rules which have been applied.
+	Rules []Rule `json:"-"`
+
+	// Findings are all violations, sorted by source, line and rule.
+	Findings []*Finding `json:"findings"`
+}
+
+// Linter applies a set of rules to release notes.
+type Linter struct {
+	options *Options
+	rules   []Rule
+}
+
+// New creates a new linter with the provided options and all default rules.
+func New(opts *Options) (*Linter, error) {
+	return NewWithRules(opts, DefaultRules()...)
+}
+
+// NewWithRules creates a new linter with the provided rules.
+func NewWithRules(opts *Options, rules ...Rule) (*Linter, error) {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+
+	ids := []string{}
+	for _, rule := range rules {
+		ids = append(ids, rule.ID())
+	}
+
+	for id := range opts.Severities {
+		if !slices.Contains(ids, id) {
+			return nil, fmt.Errorf("unknown lint rule %q, must be one of: %s", id, strings.Join(ids, ", "))
+		}
+	}
+
+	return &Linter{options: opts, rules: rules}, nil
+}
+
+// severity returns the configured severity of the rule.
+func (l *Linter) severity(rule Rule) Severity {
+	if severity, ok := l.options.Severities[rule.ID()]; ok {
+		return severity
+	}
+
+	return rule.DefaultSeverity()
+}
+
+// Lint applies all enabled rules to the targets. Violations are fixed if
+// enabled, the rule supports it and the target is fixable.
+func (l *Linter) Lint(targets []*Target) *Result {
+	ctx := &Context{Options: l.options, Targets: targets}
+	res := &Result{Findings: []*Finding{}}
+
+	for _, rule := range l.rules {
+		severity := l.severity(rule)
+		if severity == SeverityOff {
+			continue
+		}
+
+		res.Rules = append(res.Rules, rule)
+
+		for _, target := range targets {
+			if target.Note.DoNotPublish {
+				continue
+			}
+
+			messages := rule.Check(ctx, target)
+			if len(messages) == 0 {
+				continue
+			}
+
+			fixed := false
+
+			if fixer, ok := rule.(Fixer); ok && l.options.Fix && target.fixable {
+				if text, changed := fixer.Fix(target.Note.Text); changed {
+					target.setText(text)
+					fixed = len(rule.Check(ctx, target)) == 0
+				}
+			}
+
+			for _, message := range messages {
+				res.Findings = append(res.Findings, &Finding{
+					RuleID:   rule.ID(),
+					Severity: severity,
+					Message:  message,
+					Source:   target.Source,
+					Line:     target.Line,
+					PrNumber: target.Note.PrNumber,
+					Fixed:    fixed,
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(res.Findings, func(a, b *Finding) int {
+		if c := strings.Compare(a.Source, b.Source); c != 0 {
+			return c
+		}
+
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+
+		return strings.Compare(a.RuleID, b.RuleID)
+	})
+
+	return res
+}
+
+// Failed returns true if any unfixed finding has the severity error.
+func (r *Result) Failed() bool {
+	for _, finding := range r.Findings {
+		if finding.Severity == SeverityError && !finding.Fixed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Write writes the result in the provided format.
+func (r *Result) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatText:
+		return r.writeText(w)
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(r); err != nil {
+			return fmt.Errorf("encoding JSON: %w", err)
+		}
+
+		return nil
+	case FormatSARIF:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(r.SARIF()); err != nil {
+			return fmt.Errorf("encoding SARIF: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported lint output format: %s", format)
+	}
+}
+
+func (r *Result) writeText(w io.Writer) error {
+	for _, finding := range r.Findings {
+		location := finding.Source
+		if finding.Line > 0 {
+			location = fmt.Sprintf("%s:%d", location, finding.Line)
+		}
+
+		fixed := ""
+		if finding.Fixed {
+			fixed = " (fixed)"
+		}
+
+		if _, err := fmt.Fprintf(
+			w, "%s: %s: %s [%s]%s\n",
+			location, finding.Severity, finding.Message, finding.RuleID, fixed,
+		); err != nil {
+			return fmt.Errorf("writing finding: %w", err)
+		}
+	}
+
+	return nil
+}
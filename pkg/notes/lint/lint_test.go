@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// loadTestdata copies the testdata into a temporary directory and loads
+// the maps and release notes from it.
+func loadTestdata(t *testing.T) (dir string, files []*File, targets []*Target) {
+	t.Helper()
+
+	dir = t.TempDir()
+	require.NoError(t, os.CopyFS(dir, os.DirFS("testdata")))
+
+	files, err := LoadMaps(filepath.Join(dir, "maps"))
+	require.NoError(t, err)
+
+	notesFile, err := LoadNotes(filepath.Join(dir, "release-notes.json"))
+	require.NoError(t, err)
+
+	files = append(files, notesFile)
+
+	for _, file := range files {
+		targets = append(targets, file.Targets...)
+	}
+
+	return dir, files, targets
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	dir, _, targets := loadTestdata(t)
+	require.Len(t, targets, 4)
+
+	linter, err := New(nil)
+	require.NoError(t, err)
+
+	res := linter.Lint(targets)
+	require.True(t, res.Failed())
+
+	output := &bytes.Buffer{}
+	require.NoError(t, res.Write(output, FormatText))
+	require.Equal(t,
+		filepath.Join(dir, "maps", "pr-100-map.yaml")+`:3: note: feature note does not link to a KEP [kep-link]
+`+filepath.Join(dir, "maps", "pr-100-map.yaml")+`:3: warning: note should use past tense: "Added" instead of "Adds" [past-tense]
+`+filepath.Join(dir, "maps", "pr-100-map.yaml")+`:3: error: note does not end with valid punctuation (., ! or ?) [trailing-punctuation]
+`+filepath.Join(dir, "maps", "pr-200-map.yaml")+`:4: warning: note duplicates the note of PR #101 (SIG scheduling) [duplicate-note]
+`+filepath.Join(dir, "maps", "pr-200-map.yaml")+`:4: error: note does not end with valid punctuation (., ! or ?) [trailing-punctuation]
+`+filepath.Join(dir, "release-notes.json")+`:2: warning: action required note does not explain what users have to do when upgrading [action-required-guidance]
+`+filepath.Join(dir, "release-notes.json")+`:2: error: note does not end with valid punctuation (., ! or ?) [trailing-punctuation]
+`, output.String())
+
+	// SARIF output
+	output.Reset()
+	require.NoError(t, res.Write(output, FormatSARIF))
+
+	sarif := &SARIFLog{}
+	require.NoError(t, json.Unmarshal(output.Bytes(), sarif))
+	require.Equal(t, sarifVersion, sarif.Version)
+	require.Len(t, sarif.Runs, 1)
+	require.Len(t, sarif.Runs[0].Tool.Driver.Rules, len(DefaultRules()))
+	require.Len(t, sarif.Runs[0].Results, 7)
+	require.Equal(t, RuleKEPLink, sarif.Runs[0].Results[0].RuleID)
+	require.Equal(t, SeverityNote, sarif.Runs[0].Results[0].Level)
+	require.Equal(t, 3, sarif.Runs[0].Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+
+	// JSON output
+	output.Reset()
+	require.NoError(t, res.Write(output, FormatJSON))
+	require.Contains(t, output.String(), `"rule_id": "action-required-guidance"`)
+
+	require.Error(t, res.Write(output, "wrong"))
+}
+
+func TestLintSeverities(t *testing.T) {
+	t.Parallel()
+
+	_, _, targets := loadTestdata(t)
+
+	opts := DefaultOptions()
+	opts.Severities[RuleTrailingPunctuation] = SeverityOff
+	opts.Severities[RuleKEPLink] = SeverityError
+
+	linter, err := New(opts)
+	require.NoError(t, err)
+
+	res := linter.Lint(targets)
+	require.True(t, res.Failed())
+	require.Len(t, res.Findings, 4)
+
+	for _, finding := range res.Findings {
+		require.NotEqual(t, RuleTrailingPunctuation, finding.RuleID)
+	}
+
+	opts.Severities["wrong"] = SeverityError
+	_, err = New(opts)
+	require.Error(t, err)
+
+	_, err = ParseSeverity("wrong")
+	require.Error(t, err)
+
+	linter, err = NewWithRules(nil, &trailingPunctuationRule{})
+	require.NoError(t, err)
+	require.Len(t, linter.Lint(targets).Findings, 3)
+}
+
+func TestLintFix(t *testing.T) {
+	t.Parallel()
+
+	dir, files, targets := loadTestdata(t)
+
+	opts := DefaultOptions()
+	opts.Fix = true
+
+	linter, err := New(opts)
+	require.NoError(t, err)
+
+	res := linter.Lint(targets)
+
+	// The map with comments cannot be fixed.
+	require.True(t, res.Failed())
+
+	for _, file := range files {
+		if file.Modified() {
+			require.NoError(t, file.Write())
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "maps", "pr-100-map.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, `pr: 100
+releasenote:
+  text: Added the `+"`--foo`"+` flag to kubectl.
+  kinds:
+  - feature
+  sigs:
+  - cli
+---
+pr: 101
+releasenote:
+  text: Fixed a bug in the scheduler.
+  sigs:
+  - scheduling
+`, string(content))
+
+	content, err = os.ReadFile(filepath.Join(dir, "maps", "pr-200-map.yaml"))
+	require.NoError(t, err)
+	require.Contains(t, string(content), "# Reviewed by the release notes team.")
+
+	notesFile, err := LoadNotes(filepath.Join(dir, "release-notes.json"))
+	require.NoError(t, err)
+	require.Equal(t, "Removed the deprecated `--bar` flag.", notesFile.Targets[0].Note.Text)
+	require.Equal(t, "Removed the deprecated `--bar` flag. (#300, @user)", notesFile.Targets[0].Note.Markdown)
+
+	// Linting the fixed files again reports only the remaining findings.
+	_, _, targets = loadTestdata(t)
+	require.NotEmpty(t, linter.Lint(targets).Findings)
+}
+
+func TestLintFixJSONDocument(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "release-notes.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "releaseNotes": {
+    "300": {
+      "text": "Removed the deprecated flag",
+      "pr_number": 300
+    }
+  },
+  "releaseNotesByCommit": {
+    "abc": {
+      "commit": "abc",
+      "text": "Fixed a crash"
+    }
+  },
+  "featureGates": {
+    "changes": []
+  }
+}
+`), os.FileMode(0o644)))
+
+	file, err := LoadNotes(path)
+	require.NoError(t, err)
+	require.Len(t, file.Targets, 2)
+	require.Equal(t, 3, file.Targets[0].Line)
+	require.Equal(t, 9, file.Targets[1].Line)
+
+	opts := DefaultOptions()
+	opts.Fix = true
+
+	linter, err := NewWithRules(opts, &trailingPunctuationRule{})
+	require.NoError(t, err)
+	require.False(t, linter.Lint(file.Targets).Failed())
+	require.NoError(t, file.Write())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	fields := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(content, &fields))
+	require.Contains(t, fields, "featureGates")
+
+	file, err = LoadNotes(path)
+	require.NoError(t, err)
+	require.Equal(t, "Removed the deprecated flag.", file.Targets[0].Note.Text)
+	require.Equal(t, "Fixed a crash.", file.Targets[1].Note.Text)
+}
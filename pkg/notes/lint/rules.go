@@ -0,0 +1,370 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"k8s.io/release/pkg/notes"
+)
+
+// Rule IDs of the default rules.
+const (
+	RulePastTense              = "past-tense"
+	RuleMaxLength              = "max-length"
+	RuleDanglingMarkdown       = "dangling-markdown"
+	RuleKEPLink                = "kep-link"
+	RuleDuplicateNote          = "duplicate-note"
+	RuleActionRequiredGuidance = "action-required-guidance"
+	RuleTrailingPunctuation    = "trailing-punctuation"
+)
+
+const (
+	kindFeature                  = "feature"
+	enhancementsRepositoryMarker = "kubernetes/enhancements"
+	codeFence                    = "```"
+)
+
+// DefaultRules returns all rules provided by this package.
+func DefaultRules() []Rule {
+	return []Rule{
+		&pastTenseRule{},
+		&maxLengthRule{},
+		&danglingMarkdownRule{},
+		&kepLinkRule{},
+		&duplicateNoteRule{},
+		&actionRequiredGuidanceRule{},
+		&trailingPunctuationRule{},
+	}
+}
+
+// pastTenseRule checks that the note starts with a verb in past tense, like
+// "Added" instead of "Add" or "Adds".
+type pastTenseRule struct{}
+
+// pastTenseVerbs maps the present and imperative forms of verbs commonly
+// used at the beginning of release notes to their past tense.
+var pastTenseVerbs = map[string]string{
+	"add": "added", "adds": "added",
+	"allow": "allowed", "allows": "allowed",
+	"bump": "bumped", "bumps": "bumped",
+	"change": "changed", "changes": "changed",
+	"deprecate": "deprecated", "deprecates": "deprecated",
+	"disable": "disabled", "disables": "disabled",
+	"drop": "dropped", "drops": "dropped",
+	"enable": "enabled", "enables": "enabled",
+	"fix": "fixed", "fixes": "fixed",
+	"graduate": "graduated", "graduates": "graduated",
+	"improve": "improved", "improves": "improved",
+	"introduce": "introduced", "introduces": "introduced",
+	"make": "made", "makes": "made",
+	"move": "moved", "moves": "moved",
+	"promote": "promoted", "promotes": "promoted",
+	"remove": "removed", "removes": "removed",
+	"rename": "renamed", "renames": "renamed",
+	"replace": "replaced", "replaces": "replaced",
+	"support": "supported", "supports": "supported",
+	"update": "updated", "updates": "updated",
+	"upgrade": "upgraded", "upgrades": "upgraded",
+	"use": "used", "uses": "used",
+}
+
+var firstWordRE = regexp.MustCompile(`^\s*([A-Za-z]+)\b`)
+
+func (*pastTenseRule) ID() string { return RulePastTense }
+
+func (*pastTenseRule) Description() string {
+	return "Release notes should start with a verb in past tense, like 'Added' or 'Fixed'."
+}
+
+func (*pastTenseRule) DefaultSeverity() Severity { return SeverityWarning }
+
+func (*pastTenseRule) Check(_ *Context, target *Target) []string {
+	word, past, ok := pastTenseReplacement(target.Note.Text)
+	if !ok {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("note should use past tense: %q instead of %q", past, word)}
+}
+
+// Fix replaces only unambiguous third-person forms like "Adds", because
+// imperative forms like "Support" or "Change" are often used as nouns.
+func (*pastTenseRule) Fix(text string) (string, bool) {
+	word, past, ok := pastTenseReplacement(text)
+	if !ok || !strings.HasSuffix(strings.ToLower(word), "s") {
+		return text, false
+	}
+
+	idx := strings.Index(text, word)
+
+	return text[:idx] + past + text[idx+len(word):], true
+}
+
+// pastTenseReplacement returns the first word of the text and its past
+// tense, preserving the capitalization of the first letter.
+func pastTenseReplacement(text string) (word, past string, ok bool) {
+	match := firstWordRE.FindStringSubmatch(text)
+	if match == nil {
+		return "", "", false
+	}
+
+	word = match[1]
+
+	past, ok = pastTenseVerbs[strings.ToLower(word)]
+	if !ok {
+		return "", "", false
+	}
+
+	if r, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(r) {
+		past = strings.ToUpper(past[:1]) + past[1:]
+	}
+
+	return word, past, true
+}
+
+// maxLengthRule checks that the note does not exceed the configured length.
+type maxLengthRule struct{}
+
+func (*maxLengthRule) ID() string { return RuleMaxLength }
+
+func (*maxLengthRule) Description() string {
+	return "Release notes should not exceed the maximum length."
+}
+
+func (*maxLengthRule) DefaultSeverity() Severity { return SeverityWarning }
+
+func (*maxLengthRule) Check(ctx *Context, target *Target) []string {
+	maxLength := ctx.Options.MaxLength
+	if maxLength <= 0 {
+		return nil
+	}
+
+	length := utf8.RuneCountInString(strings.TrimSpace(target.Note.Text))
+	if length <= maxLength {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("note has %d characters, which exceeds the maximum of %d", length, maxLength)}
+}
+
+// danglingMarkdownRule checks for unclosed markdown constructs, which break
+// the rendering of all following release notes.
+type danglingMarkdownRule struct{}
+
+var (
+	codeSpanRE = regexp.MustCompile("`[^`]*`")
+	openLinkRE = regexp.MustCompile(`\]\([^)]*$`)
+)
+
+func (*danglingMarkdownRule) ID() string { return RuleDanglingMarkdown }
+
+func (*danglingMarkdownRule) Description() string {
+	return "Release notes must not contain unclosed markdown code blocks, code spans, emphasis or links."
+}
+
+func (*danglingMarkdownRule) DefaultSeverity() Severity { return SeverityError }
+
+func (*danglingMarkdownRule) Check(_ *Context, target *Target) []string {
+	res := []string{}
+
+	// Collect the inline markdown outside of code blocks.
+	inlineLines := []string{}
+	inCodeBlock := false
+
+	for _, line := range strings.Split(target.Note.Text, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), codeFence) {
+			inCodeBlock = !inCodeBlock
+
+			continue
+		}
+
+		if !inCodeBlock {
+			inlineLines = append(inlineLines, line)
+		}
+	}
+
+	if inCodeBlock {
+		res = append(res, "note contains an unclosed code block")
+	}
+
+	inline := codeSpanRE.ReplaceAllString(strings.Join(inlineLines, "\n"), "")
+
+	if strings.Contains(inline, "`") {
+		res = append(res, "note contains an unclosed code span")
+	}
+
+	if strings.Count(inline, "**")%2 != 0 {
+		res = append(res, "note contains unclosed bold emphasis")
+	}
+
+	if strings.Count(inline, "[") != strings.Count(inline, "]") || openLinkRE.MatchString(inline) {
+		res = append(res, "note contains an unclosed link")
+	}
+
+	return res
+}
+
+// kepLinkRule checks that feature notes link to their KEP.
+type kepLinkRule struct{}
+
+func (*kepLinkRule) ID() string { return RuleKEPLink }
+
+func (*kepLinkRule) Description() string {
+	return "Release notes of kind/feature should link to the corresponding KEP."
+}
+
+func (*kepLinkRule) DefaultSeverity() Severity { return SeverityNote }
+
+func (*kepLinkRule) Check(_ *Context, target *Target) []string {
+	note := target.Note
+	if !note.Feature && !slices.Contains(note.Kinds, kindFeature) {
+		return nil
+	}
+
+	for _, doc := range note.Documentation {
+		if doc == nil {
+			continue
+		}
+
+		if doc.Type == notes.DocTypeKEP || strings.Contains(doc.URL, enhancementsRepositoryMarker) {
+			return nil
+		}
+	}
+
+	if strings.Contains(note.Text, enhancementsRepositoryMarker) {
+		return nil
+	}
+
+	return []string{"feature note does not link to a KEP"}
+}
+
+// duplicateNoteRule checks for notes with the same text across different
+// pull requests, which usually happens if the same change is labeled for
+// multiple SIGs. Only the later occurrences are reported.
+type duplicateNoteRule struct{}
+
+func (*duplicateNoteRule) ID() string { return RuleDuplicateNote }
+
+func (*duplicateNoteRule) Description() string {
+	return "Release notes should not be duplicated across pull requests or SIGs."
+}
+
+func (*duplicateNoteRule) DefaultSeverity() Severity { return SeverityWarning }
+
+func (*duplicateNoteRule) Check(ctx *Context, target *Target) []string {
+	text := normalizeText(target.Note.Text)
+	if text == "" {
+		return nil
+	}
+
+	res := []string{}
+
+	for _, other := range ctx.Targets {
+		if other == target {
+			break
+		}
+
+		if other.Note.DoNotPublish ||
+			other.Note.PrNumber == target.Note.PrNumber ||
+			normalizeText(other.Note.Text) != text {
+			continue
+		}
+
+		message := fmt.Sprintf("note duplicates the note of PR #%d", other.Note.PrNumber)
+		if len(other.Note.SIGs) > 0 {
+			message += fmt.Sprintf(" (SIG %s)", strings.Join(other.Note.SIGs, ", "))
+		}
+
+		res = append(res, message)
+	}
+
+	return res
+}
+
+// normalizeText lowercases the text and collapses all whitespace and
+// trailing punctuation to compare notes.
+func normalizeText(text string) string {
+	return strings.TrimRight(strings.ToLower(strings.Join(strings.Fields(text), " ")), ".!?")
+}
+
+// actionRequiredGuidanceRule checks that action required notes tell the
+// user what to do.
+type actionRequiredGuidanceRule struct{}
+
+var upgradeGuidanceRE = regexp.MustCompile(
+	`(?i)\b(action required|upgrade|upgrading|migrate|migrating|migration|` +
+		`must|should|need to|needs to|required to|instead|replace|replaced by|` +
+		`use|set|switch|update your)\b`,
+)
+
+func (*actionRequiredGuidanceRule) ID() string { return RuleActionRequiredGuidance }
+
+func (*actionRequiredGuidanceRule) Description() string {
+	return "Action required release notes should contain upgrade guidance."
+}
+
+func (*actionRequiredGuidanceRule) DefaultSeverity() Severity { return SeverityWarning }
+
+func (*actionRequiredGuidanceRule) Check(_ *Context, target *Target) []string {
+	if !target.Note.ActionRequired || upgradeGuidanceRE.MatchString(target.Note.Text) {
+		return nil
+	}
+
+	return []string{"action required note does not explain what users have to do when upgrading"}
+}
+
+// trailingPunctuationRule checks that the note ends with valid
+// punctuation, like `krel release-notes validate` does.
+type trailingPunctuationRule struct{}
+
+var (
+	validPunctuationRE = regexp.MustCompile(`[.!?]$`)
+	fixablePunctuation = regexp.MustCompile("[\\p{L}\\p{N})`\"']$")
+)
+
+func (*trailingPunctuationRule) ID() string { return RuleTrailingPunctuation }
+
+func (*trailingPunctuationRule) Description() string {
+	return "Release notes must end with valid punctuation (., ! or ?)."
+}
+
+func (*trailingPunctuationRule) DefaultSeverity() Severity { return SeverityError }
+
+func (*trailingPunctuationRule) Check(_ *Context, target *Target) []string {
+	text := strings.TrimSpace(target.Note.Text)
+	if text == "" || validPunctuationRE.MatchString(text) {
+		return nil
+	}
+
+	return []string{"note does not end with valid punctuation (., ! or ?)"}
+}
+
+func (*trailingPunctuationRule) Fix(text string) (string, bool) {
+	trimmed := strings.TrimRightFunc(text, unicode.IsSpace)
+	// Appending a period to a closing code fence would break the code block.
+	if !fixablePunctuation.MatchString(trimmed) || strings.HasSuffix(trimmed, codeFence) {
+		return text, false
+	}
+
+	return trimmed + "." + text[len(trimmed):], true
+}
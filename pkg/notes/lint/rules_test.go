@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes"
+)
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		rule     Rule
+		note     *notes.ReleaseNote
+		others   []*notes.ReleaseNote
+		later    []*notes.ReleaseNote
+		expected []string
+	}{
+		{
+			name: "past tense success",
+			rule: &pastTenseRule{},
+			note: &notes.ReleaseNote{Text: "Added a new flag."},
+		},
+		{
+			name:     "past tense failure",
+			rule:     &pastTenseRule{},
+			note:     &notes.ReleaseNote{Text: "Adds a new flag."},
+			expected: []string{`note should use past tense: "Added" instead of "Adds"`},
+		},
+		{
+			name: "max length success",
+			rule: &maxLengthRule{},
+			note: &notes.ReleaseNote{Text: strings.Repeat("a", DefaultMaxLength)},
+		},
+		{
+			name:     "max length failure",
+			rule:     &maxLengthRule{},
+			note:     &notes.ReleaseNote{Text: strings.Repeat("a", DefaultMaxLength+1)},
+			expected: []string{"note has 1001 characters, which exceeds the maximum of 1000"},
+		},
+		{
+			name: "dangling markdown success",
+			rule: &danglingMarkdownRule{},
+			note: &notes.ReleaseNote{
+				Text: "Fixed `kubectl` **output** for [docs](https://k8s.io).\n```yaml\nkey: `value\n```",
+			},
+		},
+		{
+			name: "dangling markdown failure",
+			rule: &danglingMarkdownRule{},
+			note: &notes.ReleaseNote{Text: "Fixed `kubectl **output for [docs](https://k8s.io.\n```yaml"},
+			expected: []string{
+				"note contains an unclosed code block",
+				"note contains an unclosed code span",
+				"note contains unclosed bold emphasis",
+				"note contains an unclosed link",
+			},
+		},
+		{
+			name: "KEP link not a feature",
+			rule: &kepLinkRule{},
+			note: &notes.ReleaseNote{Text: "Fixed a bug.", Kinds: []string{"bug"}},
+		},
+		{
+			name: "KEP link documented",
+			rule: &kepLinkRule{},
+			note: &notes.ReleaseNote{
+				Text: "Added a feature.", Kinds: []string{"feature"},
+				Documentation: []*notes.Documentation{{Type: notes.DocTypeKEP, URL: "https://k8s.io/kep"}},
+			},
+		},
+		{
+			name:     "KEP link missing",
+			rule:     &kepLinkRule{},
+			note:     &notes.ReleaseNote{Text: "Added a feature.", Feature: true},
+			expected: []string{"feature note does not link to a KEP"},
+		},
+		{
+			name:   "duplicate note success",
+			rule:   &duplicateNoteRule{},
+			note:   &notes.ReleaseNote{PrNumber: 1, Text: "Fixed a bug."},
+			others: []*notes.ReleaseNote{{PrNumber: 2, Text: "Fixed another bug."}},
+		},
+		{
+			name: "duplicate note failure",
+			rule: &duplicateNoteRule{},
+			note: &notes.ReleaseNote{PrNumber: 1, Text: "Fixed a bug.", SIGs: []string{"node"}},
+			others: []*notes.ReleaseNote{
+				{PrNumber: 2, Text: "fixed  a bug", SIGs: []string{"api-machinery", "auth"}},
+				{PrNumber: 3, Text: "Fixed a bug.", DoNotPublish: true},
+			},
+			expected: []string{"note duplicates the note of PR #2 (SIG api-machinery, auth)"},
+		},
+		{
+			name: "duplicate note first occurrence",
+			rule: &duplicateNoteRule{},
+			note: &notes.ReleaseNote{PrNumber: 1, Text: "Fixed a bug."},
+			later: []*notes.ReleaseNote{
+				{PrNumber: 2, Text: "Fixed a bug."},
+			},
+		},
+		{
+			name: "action required with guidance",
+			rule: &actionRequiredGuidanceRule{},
+			note: &notes.ReleaseNote{
+				Text:           "Removed the deprecated flag, use --new-flag instead.",
+				ActionRequired: true,
+			},
+		},
+		{
+			name:     "action required without guidance",
+			rule:     &actionRequiredGuidanceRule{},
+			note:     &notes.ReleaseNote{Text: "Removed the deprecated flag.", ActionRequired: true},
+			expected: []string{"action required note does not explain what users have to do when upgrading"},
+		},
+		{
+			name: "trailing punctuation success",
+			rule: &trailingPunctuationRule{},
+			note: &notes.ReleaseNote{Text: "Fixed a bug!\n"},
+		},
+		{
+			name:     "trailing punctuation failure",
+			rule:     &trailingPunctuationRule{},
+			note:     &notes.ReleaseNote{Text: "Fixed a bug"},
+			expected: []string{"note does not end with valid punctuation (., ! or ?)"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := &Target{Note: tc.note}
+			ctx := &Context{Options: DefaultOptions(), Targets: []*Target{}}
+
+			for _, other := range tc.others {
+				ctx.Targets = append(ctx.Targets, &Target{Note: other})
+			}
+
+			ctx.Targets = append(ctx.Targets, target)
+
+			for _, other := range tc.later {
+				ctx.Targets = append(ctx.Targets, &Target{Note: other})
+			}
+
+			res := tc.rule.Check(ctx, target)
+			if tc.expected == nil {
+				require.Empty(t, res)
+			} else {
+				require.Equal(t, tc.expected, res)
+			}
+		})
+	}
+}
+
+func TestFixers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		fixer    Fixer
+		text     string
+		expected string
+		changed  bool
+	}{
+		{
+			name:     "past tense capitalized",
+			fixer:    &pastTenseRule{},
+			text:     "Adds a new flag.",
+			expected: "Added a new flag.",
+			changed:  true,
+		},
+		{
+			name:     "past tense lowercase",
+			fixer:    &pastTenseRule{},
+			text:     "makes kubelet faster.",
+			expected: "made kubelet faster.",
+			changed:  true,
+		},
+		{
+			name:     "past tense imperative",
+			fixer:    &pastTenseRule{},
+			text:     "Support for the new API.",
+			expected: "Support for the new API.",
+		},
+		{
+			name:     "past tense unknown verb",
+			fixer:    &pastTenseRule{},
+			text:     "Kubelet is faster.",
+			expected: "Kubelet is faster.",
+		},
+		{
+			name:     "trailing punctuation",
+			fixer:    &trailingPunctuationRule{},
+			text:     "Fixed `kubectl`\n",
+			expected: "Fixed `kubectl`.\n",
+			changed:  true,
+		},
+		{
+			name:     "trailing punctuation after code block",
+			fixer:    &trailingPunctuationRule{},
+			text:     "Fixed:\n```\ncode\n```",
+			expected: "Fixed:\n```\ncode\n```",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, changed := tc.fixer.Fix(tc.text)
+			require.Equal(t, tc.expected, res)
+			require.Equal(t, tc.changed, changed)
+		})
+	}
+}
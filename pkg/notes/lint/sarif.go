@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"path/filepath"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	sarifTool    = "release-notes-lint"
+	sarifToolURI = "https://github.com/kubernetes/release"
+)
+
+// SARIFLog is the root of a SARIF 2.1.0 document, which contains only the
+// subset of properties required for CI annotations.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun is a single run of the linter.
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+// SARIFTool describes the linter and its rules.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver is the linter component of the tool.
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// SARIFRule is the metadata of a single rule.
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+}
+
+// SARIFMessage is a plain text message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult is a single finding.
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     Severity        `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+// SARIFLocation is the location of a finding.
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+// SARIFPhysicalLocation is the file and region of a finding.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           *SARIFRegion          `json:"region,omitempty"`
+}
+
+// SARIFArtifactLocation is the file of a finding.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion is the line of a finding.
+type SARIFRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// SARIF converts the result into a SARIF log. Fixed findings are omitted.
+func (r *Result) SARIF() *SARIFLog {
+	run := SARIFRun{
+		Tool: SARIFTool{Driver: SARIFDriver{
+			Name:           sarifTool,
+			InformationURI: sarifToolURI,
+			Rules:          []SARIFRule{},
+		}},
+		Results: []SARIFResult{},
+	}
+
+	for _, rule := range r.Rules {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, SARIFRule{
+			ID:               rule.ID(),
+			ShortDescription: SARIFMessage{Text: rule.Description()},
+		})
+	}
+
+	for _, finding := range r.Findings {
+		if finding.Fixed {
+			continue
+		}
+
+		location := SARIFPhysicalLocation{
+			ArtifactLocation: SARIFArtifactLocation{URI: filepath.ToSlash(finding.Source)},
+		}
+
+		if finding.Line > 0 {
+			location.Region = &SARIFRegion{StartLine: finding.Line}
+		}
+
+		run.Results = append(run.Results, SARIFResult{
+			RuleID:    finding.RuleID,
+			Level:     finding.Severity,
+			Message:   SARIFMessage{Text: finding.Message},
+			Locations: []SARIFLocation{{PhysicalLocation: location}},
+		})
+	}
+
+	return &SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []SARIFRun{run},
+	}
+}
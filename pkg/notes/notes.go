@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,8 +44,9 @@ import (
 	"golang.org/x/text/language"
 	"gopkg.in/yaml.v2"
 
-	"sigs.k8s.io/release-sdk/github"
+	"sigs.k8s.io/release-utils/util"
 
+	"k8s.io/release/pkg/notes/forge"
 	"k8s.io/release/pkg/notes/options"
 )
 
@@ -55,7 +57,10 @@ var (
 	apiSleepTime                  int64 = 60
 )
 
-var regexK8sCherryPickBotBranch = regexp.MustCompile(`cherry-pick-(?P<number>\d+)-to`)
+var (
+	regexK8sCherryPickBotBranch = regexp.MustCompile(`cherry-pick-(?P<number>\d+)-to`)
+	regexK8sCherryPickTitle     = regexp.MustCompile(`(?i)automated cherry pick of #(?P<number>\d+)`)
+)
 
 const (
 	DefaultOrg  = "kubernetes"
@@ -149,6 +154,17 @@ type ReleaseNote struct {
 
 	// PRBody is the full PR body of the release note
 	PRBody string `json:"pr_body,omitempty"`
+
+	// MapSources are the pinned locations of the maps applied to the note
+	MapSources []string `json:"map_sources,omitempty"`
+
+	// OriginPrNumber is the number of the original PR if the note is from an
+	// automated cherry-pick
+	OriginPrNumber int `json:"origin_pr_number,omitempty"`
+
+	// PreviouslyReleasedIn is the version of a previous release which already
+	// published the note of the origin PR
+	PreviouslyReleasedIn string `json:"previously_released_in,omitempty"`
 }
 
 type Documentation struct {
@@ -160,6 +176,10 @@ type Documentation struct {
 
 	// Classifies the link as something special, like a KEP
 	Type DocType `json:"type"`
+
+	// KEP is the metadata of the linked KEP, which is only set if the
+	// release notes are enriched from a local enhancements repository
+	KEP *KEP `json:"kep,omitempty"`
 }
 
 type DocType string
@@ -172,14 +192,17 @@ const (
 
 // ReleaseNotes is the main struct for collecting release notes.
 type ReleaseNotes struct {
-	byPR    ReleaseNotesByPR
-	history ReleaseNotesHistory
+	byPR          ReleaseNotesByPR
+	history       ReleaseNotesHistory
+	byCommit      ReleaseNotesByCommit
+	commitHistory []string
 }
 
 // NewReleaseNotes can be used to create a new empty ReleaseNotes struct.
 func NewReleaseNotes() *ReleaseNotes {
 	return &ReleaseNotes{
-		byPR: make(ReleaseNotesByPR),
+		byPR:     make(ReleaseNotesByPR),
+		byCommit: make(ReleaseNotesByCommit),
 	}
 }
 
@@ -189,6 +212,11 @@ func NewReleaseNotes() *ReleaseNotes {
 // the old entries with the new ones efficiently.
 type ReleaseNotesByPR map[int]*ReleaseNote
 
+// ReleaseNotesByCommit is a map of commit SHAs referencing notes which are
+// not associated with any PR, like conventional commits pushed directly to
+// the branch.
+type ReleaseNotesByCommit map[string]*ReleaseNote
+
 // ReleaseNotesHistory is the sorted list of PRs in the commit history.
 type ReleaseNotesHistory []int
 
@@ -213,21 +241,50 @@ func (r *ReleaseNotes) Set(prNumber int, note *ReleaseNote) {
 	r.history = append(r.history, prNumber)
 }
 
+// ByCommit returns the ReleaseNotesByCommit for the ReleaseNotes.
+func (r *ReleaseNotes) ByCommit() ReleaseNotesByCommit {
+	return r.byCommit
+}
+
+// SetCommit can be used to set a release note without PR for the provided
+// commit SHA.
+func (r *ReleaseNotes) SetCommit(sha string, note *ReleaseNote) {
+	r.byCommit[sha] = note
+	r.commitHistory = append(r.commitHistory, sha)
+}
+
+// All returns the release notes of all PRs followed by the release notes of
+// all commits without PR, each in the order of the commit history.
+func (r *ReleaseNotes) All() []*ReleaseNote {
+	res := []*ReleaseNote{}
+
+	for _, pr := range r.history {
+		res = append(res, r.byPR[pr])
+	}
+
+	for _, sha := range r.commitHistory {
+		res = append(res, r.byCommit[sha])
+	}
+
+	return res
+}
+
 type Result struct {
 	commit      *gogithub.RepositoryCommit
 	pullRequest *gogithub.PullRequest
 }
 
 type Gatherer struct {
-	client       github.Client
+	client       forge.Client
 	context      context.Context //nolint:containedctx // contained context is intentional
 	options      *options.Options
 	MapProviders []*MapProvider
+	cache        *notesCache
 }
 
 // NewGatherer creates a new notes gatherer.
 func NewGatherer(ctx context.Context, opts *options.Options) (*Gatherer, error) {
-	client, err := opts.Client()
+	client, err := opts.ForgeClient()
 	if err != nil {
 		return nil, fmt.Errorf("unable to create notes client: %w", err)
 	}
@@ -240,7 +297,7 @@ func NewGatherer(ctx context.Context, opts *options.Options) (*Gatherer, error)
 }
 
 // NewGathererWithClient creates a new notes gatherer with a specific client.
-func NewGathererWithClient(ctx context.Context, c github.Client) *Gatherer {
+func NewGathererWithClient(ctx context.Context, c forge.Client) *Gatherer {
 	return &Gatherer{
 		client:  c,
 		context: ctx,
@@ -262,9 +319,17 @@ func GatherReleaseNotes(opts *options.Options) (*ReleaseNotes, error) {
 
 	startTime := time.Now()
 
-	if gatherer.options.ListReleaseNotesV2 {
+	if gatherer.options.NoteSource == options.NoteSourceConventionalCommits {
+		logrus.Info("Deriving release notes from conventional commits")
+
+		releaseNotes, err = gatherer.ListReleaseNotesConventionalCommits()
+	} else if gatherer.options.ListReleaseNotesV2 {
 		logrus.Warn("EXPERIMENTAL IMPLEMENTATION ListReleaseNotesV2 ENABLED")
 
+		if opts.CacheDir != "" {
+			logrus.Warn("The notes cache is not supported by ListReleaseNotesV2")
+		}
+
 		releaseNotes, err = gatherer.ListReleaseNotesV2()
 	} else {
 		releaseNotes, err = gatherer.ListReleaseNotes()
@@ -274,6 +339,31 @@ func GatherReleaseNotes(opts *options.Options) (*ReleaseNotes, error) {
 		return nil, fmt.Errorf("listing release notes: %w", err)
 	}
 
+	if opts.EnhancementsDir != "" {
+		keps, err := LoadKEPs(opts.EnhancementsDir)
+		if err != nil {
+			return nil, fmt.Errorf("loading KEPs: %w", err)
+		}
+
+		keps.Enrich(releaseNotes)
+	}
+
+	if opts.PreviousReleaseNotesDir != "" {
+		target, err := util.TagStringToSemver(opts.EndRev)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"previous release notes require the end revision to be a release tag: %w", err,
+			)
+		}
+
+		previous, err := LoadPreviousReleases(opts.PreviousReleaseNotesDir, target)
+		if err != nil {
+			return nil, fmt.Errorf("loading previous release notes: %w", err)
+		}
+
+		previous.Apply(releaseNotes, opts.PreviouslyReleased == options.PreviouslyReleasedSuppress)
+	}
+
 	logrus.Infof("Finished gathering release notes in %v", time.Since(startTime))
 
 	return releaseNotes, nil
@@ -294,90 +384,123 @@ func (g *Gatherer) ListReleaseNotes() (*ReleaseNotes, error) {
 		mapProviders = append(mapProviders, provider)
 	}
 
+	if g.options.CacheDir != "" {
+		cache, err := newNotesCache(
+			g.options.CacheDir, g.options.GithubOrg, g.options.GithubRepo, g.options.AddMarkdownLinks,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating notes cache: %w", err)
+		}
+
+		g.cache = cache
+	}
+
 	commits, err := g.listCommits(g.options.Branch, g.options.StartSHA, g.options.EndSHA)
 	if err != nil {
 		return nil, fmt.Errorf("listing commits: %w", err)
 	}
 
+	entriesTemp, uncachedCommits, err := g.cachedEntries(commits)
+	if err != nil {
+		return nil, fmt.Errorf("reading notes cache: %w", err)
+	}
+
 	// Get the PRs into a temporary results set
-	resultsTemp, err := g.gatherNotes(commits)
+	resultsTemp, err := g.gatherNotes(uncachedCommits)
 	if err != nil {
 		return nil, fmt.Errorf("gathering notes: %w", err)
 	}
 
-	// Cycle the results and add the complete notes, as well as those that
+	resolvedEntries := make([]*noteEntry, 0, len(resultsTemp))
+	for _, res := range resultsTemp {
+		resolvedEntries = append(resolvedEntries, g.entryFromResult(res))
+	}
+
+	if err := g.storeEntries(uncachedCommits, resolvedEntries); err != nil {
+		return nil, fmt.Errorf("writing notes cache: %w", err)
+	}
+
+	entriesTemp = append(entriesTemp, resolvedEntries...)
+
+	// Cycle the entries and add the complete notes, as well as those that
 	// have a map associated with it
-	results := []*Result{}
+	entries := []*noteEntry{}
 
 	logrus.Info("Checking PRs for mapped data")
 
-	for _, res := range resultsTemp {
+	for _, entry := range entriesTemp {
+		if entry.PrNumber == 0 {
+			// The commit has no release note
+			continue
+		}
+
 		// If the PR has no release note, check if we have to add it
-		if MatchesExcludeFilter(*res.pullRequest.Body) {
+		if entry.Excluded {
 			for _, provider := range mapProviders {
-				noteMaps, err := provider.GetMapsForPR(res.pullRequest.GetNumber())
+				noteMaps, err := provider.GetMapsForPR(entry.PrNumber)
 				if err != nil {
 					return nil, fmt.Errorf(
-						"checking if a map exists for PR %d: %w", res.pullRequest.GetNumber(),
+						"checking if a map exists for PR %d: %w", entry.PrNumber,
 						err)
 				}
 
 				if len(noteMaps) != 0 {
 					logrus.Infof(
 						"Artificially adding pr #%d because a map for it was found",
-						res.pullRequest.GetNumber(),
+						entry.PrNumber,
 					)
 
-					results = append(results, res)
+					entries = append(entries, entry)
 				} else {
 					logrus.Debugf(
 						"Skipping PR #%d because it contains no release note",
-						res.pullRequest.GetNumber(),
+						entry.PrNumber,
 					)
 				}
 			}
 		} else {
 			// Append the note as it is
-			results = append(results, res)
+			entries = append(entries, entry)
 		}
 	}
 
 	dedupeCache := map[string]struct{}{}
 	notes := NewReleaseNotes()
 
-	for _, result := range results {
+	for _, entry := range entries {
 		if g.options.RequiredAuthor != "" {
-			if result.commit.GetAuthor().GetLogin() != g.options.RequiredAuthor {
+			if entry.CommitAuthor != g.options.RequiredAuthor {
 				logrus.Infof(
 					"Skipping release note for PR #%d because required author %q does not match with %q",
-					result.pullRequest.GetNumber(), g.options.RequiredAuthor, result.commit.GetAuthor().GetLogin(),
+					entry.PrNumber, g.options.RequiredAuthor, entry.CommitAuthor,
 				)
 
 				continue
 			}
 		}
 
-		note, err := g.ReleaseNoteFromCommit(result)
-		if err != nil {
+		if entry.Note == nil {
 			logrus.Errorf(
 				"Getting the release note from commit %s (PR #%d): %v",
-				result.commit.GetSHA(),
-				result.pullRequest.GetNumber(),
-				err)
+				entry.Commit,
+				entry.PrNumber,
+				entry.Error)
 
 			continue
 		}
 
+		note := entry.Note
+
 		// Query our map providers for additional data for the release note
 		for _, provider := range mapProviders {
-			noteMaps, err := provider.GetMapsForPR(result.pullRequest.GetNumber())
+			noteMaps, err := provider.GetMapsForPR(entry.PrNumber)
 			if err != nil {
 				return nil, fmt.Errorf("error while looking up note map: %w", err)
 			}
 
 			for _, noteMap := range noteMaps {
 				if err := note.ApplyMap(noteMap, g.options.AddMarkdownLinks); err != nil {
-					return nil, fmt.Errorf("applying notemap for PR #%d: %w", result.pullRequest.GetNumber(), err)
+					return nil, fmt.Errorf("applying notemap for PR #%d: %w", entry.PrNumber, err)
 				}
 			}
 		}
@@ -560,6 +683,7 @@ func (g *Gatherer) ReleaseNoteFromCommit(result *Result) (*ReleaseNote, error) {
 		ActionRequired: labelExactMatch(pr, "release-note-action-required"),
 		DoNotPublish:   labelExactMatch(pr, "release-note-none"),
 		PRBody:         prBody,
+		OriginPrNumber: originPrNumber(pr),
 	}, nil
 }
 
@@ -808,6 +932,7 @@ func (g *Gatherer) ReleaseNoteForPullRequest(prNr int) (*ReleaseNote, error) {
 		DoNotPublish:   doNotPublish,
 		DataFields:     map[string]ReleaseNotesDataField{},
 		PRBody:         prBody,
+		OriginPrNumber: originPrNumber(pr),
 	}
 
 	if s != "" {
@@ -832,6 +957,10 @@ func (g *Gatherer) notesForCommit(commit *gogithub.RepositoryCommit) (*Result, e
 		return nil, err
 	}
 
+	if g.cache != nil {
+		g.cache.recordPRs(commit.GetSHA(), prs)
+	}
+
 	for _, pr := range prs {
 		prBody := pr.GetBody()
 
@@ -909,6 +1038,26 @@ func originPrNumFromPr(pr *gogithub.PullRequest) (int, error) {
 	return originPR, nil
 }
 
+// originPrNumber returns the number of the origin PR if the PR is an
+// automated cherry-pick, otherwise zero.
+func originPrNumber(pr *gogithub.PullRequest) int {
+	if number, err := originPrNumFromPr(pr); err == nil {
+		return number
+	}
+
+	return prForRegex(regexK8sCherryPickTitle, pr.GetTitle())
+}
+
+// originPrNumFromMessage returns the number of the origin PR if the commit
+// message belongs to an automated cherry-pick, otherwise zero.
+func originPrNumFromMessage(message string) int {
+	if number := prForRegex(regexK8sCherryPickBotBranch, message); number != 0 {
+		return number
+	}
+
+	return prForRegex(regexK8sCherryPickTitle, message)
+}
+
 type resultList struct {
 	sync.RWMutex
 	list []*Result
@@ -1280,6 +1429,10 @@ func (rn *ReleaseNote) ApplyMap(noteMap *ReleaseNotesMap, markdownLinks bool) er
 
 	rn.IsMapped = true
 
+	if noteMap.Source != "" && !slices.Contains(rn.MapSources, noteMap.Source) {
+		rn.MapSources = append(rn.MapSources, noteMap.Source)
+	}
+
 	if noteMap.PRBody != nil && rn.PRBody != "" && rn.PRBody != *noteMap.PRBody {
 		logrus.Warnf("Original PR body of release note mapping changed for PR: #%d", rn.PrNumber)
 
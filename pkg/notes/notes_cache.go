@@ -0,0 +1,364 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	gogithub "github.com/google/go-github/v60/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// notesCacheVersion has to be increased on every incompatible change of
+	// the cached entries.
+	notesCacheVersion = 3
+
+	// cachedAtMargin is subtracted from the cache time of an entry to not
+	// miss any pull request updates because of clock skew.
+	cachedAtMargin = 5 * time.Minute
+)
+
+// noteEntry is a release note candidate for a single commit, either resolved
+// from GitHub or from the cache. It contains the note before any map got
+// applied, which means that changed maps always take effect.
+type noteEntry struct {
+	// Commit is the SHA of the commit.
+	Commit string `json:"commit"`
+
+	// CommitAuthor is the GitHub login of the commit author.
+	CommitAuthor string `json:"commit_author,omitempty"`
+
+	// PRs are the pull requests found for the commit together with their
+	// last update time, which is used to invalidate the entry.
+	PRs []cachedPR `json:"prs,omitempty"`
+
+	// PrNumber is the number of the pull request containing the release
+	// note, or zero if the commit has none.
+	PrNumber int `json:"pr_number,omitempty"`
+
+	// Excluded is true if the release note has been excluded from the pull
+	// request body (release-note-none) and is only used if a map exists.
+	Excluded bool `json:"excluded,omitempty"`
+
+	// Note is the release note without any map applied.
+	Note *ReleaseNote `json:"note,omitempty"`
+
+	// Error is set if the release note could not be created.
+	Error string `json:"error,omitempty"`
+
+	// Fingerprint identifies the options the note has been created with.
+	Fingerprint string `json:"fingerprint"`
+
+	// CachedAt is the time the entry has been cached. Pull request updates
+	// after this time invalidate the entry.
+	CachedAt time.Time `json:"cached_at"`
+}
+
+// cachedPR is a pull request referenced by a commit.
+type cachedPR struct {
+	Number    int       `json:"number"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// notesCache is an on-disk cache of release notes entries per commit SHA.
+type notesCache struct {
+	// dir is the cache directory for the repository.
+	dir string
+
+	// fingerprint identifies the options the notes get created with.
+	fingerprint string
+
+	// startTime is the time the current run started.
+	startTime time.Time
+
+	// prs are the pull requests found per commit during the current run.
+	prs map[string][]cachedPR
+	mu  sync.Mutex
+}
+
+// newNotesCache creates a new cache for the configured repository in
+// `baseDir`.
+func newNotesCache(baseDir, org, repo string, markdownLinks bool) (*notesCache, error) {
+	dir := filepath.Join(baseDir, org, repo)
+	if err := os.MkdirAll(filepath.Join(dir, "commits"), os.FileMode(0o755)); err != nil {
+		return nil, fmt.Errorf("create cache directory: %w", err)
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d/%t", notesCacheVersion, markdownLinks))
+
+	return &notesCache{
+		dir:         dir,
+		fingerprint: hex.EncodeToString(sum[:]),
+		startTime:   time.Now(),
+		prs:         map[string][]cachedPR{},
+	}, nil
+}
+
+// recordPRs remembers the pull requests found for a commit.
+func (c *notesCache) recordPRs(sha string, prs []*gogithub.PullRequest) {
+	cached := make([]cachedPR, 0, len(prs))
+	for _, pr := range prs {
+		cached = append(cached, cachedPR{
+			Number:    pr.GetNumber(),
+			UpdatedAt: pr.GetUpdatedAt().Time,
+		})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prs[sha] = cached
+}
+
+// recordedPRs returns the pull requests found for a commit.
+func (c *notesCache) recordedPRs(sha string) []cachedPR {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.prs[sha]
+}
+
+func (c *notesCache) entryPath(sha string) string {
+	return filepath.Join(c.dir, "commits", sha+".json")
+}
+
+// get returns the cached entry for the commit, or nil if the entry does not
+// exist or has been created with different options.
+func (c *notesCache) get(sha string) *noteEntry {
+	content, err := os.ReadFile(c.entryPath(sha))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Warnf("Unable to read cached notes for commit %s: %v", sha, err)
+		}
+
+		return nil
+	}
+
+	entry := &noteEntry{}
+	if err := json.Unmarshal(content, entry); err != nil {
+		logrus.Warnf("Ignoring invalid cached notes for commit %s: %v", sha, err)
+
+		return nil
+	}
+
+	if entry.Fingerprint != c.fingerprint || entry.CachedAt.IsZero() {
+		return nil
+	}
+
+	return entry
+}
+
+// updated returns true if any pull request of the entry got updated after it
+// has been cached.
+func (entry *noteEntry) updated(updatedPRs map[int]time.Time) bool {
+	for _, pr := range entry.PRs {
+		updatedAt, ok := updatedPRs[pr.Number]
+		if ok && (updatedAt.After(pr.UpdatedAt) || updatedAt.After(entry.CachedAt)) {
+			logrus.Debugf(
+				"Invalidating cached notes for commit %s because PR #%d got updated",
+				entry.Commit, pr.Number,
+			)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// put stores the entry in the cache.
+func (c *notesCache) put(entry *noteEntry) error {
+	entry.Fingerprint = c.fingerprint
+	entry.CachedAt = c.startTime.Add(-cachedAtMargin).UTC()
+
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal cache entry: %w", err)
+	}
+
+	if err := os.WriteFile(c.entryPath(entry.Commit), content, os.FileMode(0o644)); err != nil {
+		return fmt.Errorf("write cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// updatedPRs returns the last update time of all pull requests which have
+// been updated since the provided time.
+func (g *Gatherer) updatedPRs(since time.Time) (map[int]time.Time, error) {
+	opts := &gogithub.IssueListByRepoOptions{
+		State:       "all",
+		Sort:        "updated",
+		Direction:   "desc",
+		Since:       since,
+		ListOptions: gogithub.ListOptions{PerPage: 100},
+	}
+
+	updated := map[int]time.Time{}
+
+	for {
+		var (
+			issues []*gogithub.Issue
+			resp   *gogithub.Response
+			err    error
+		)
+
+		for {
+			issues, resp, err = g.client.ListIssues(
+				g.context, g.options.GithubOrg, g.options.GithubRepo, opts,
+			)
+			if err == nil {
+				break
+			}
+
+			if !canWaitAndRetry(resp, err) {
+				return nil, err
+			}
+		}
+
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				updated[issue.GetNumber()] = issue.GetUpdatedAt().Time
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return updated, nil
+}
+
+// cachedEntries splits the commits into the cached entries and the commits
+// which have to be resolved from GitHub. The pull requests updated since the
+// oldest cached entry are used to invalidate the entries, because every entry
+// may have been cached by a run covering a different commit range.
+func (g *Gatherer) cachedEntries(commits []*gogithub.RepositoryCommit) (
+	entries []*noteEntry, uncached []*gogithub.RepositoryCommit, err error,
+) {
+	if g.cache == nil {
+		return nil, commits, nil
+	}
+
+	candidates := map[string]*noteEntry{}
+	oldest := time.Time{}
+
+	for _, commit := range commits {
+		entry := g.cache.get(commit.GetSHA())
+		if entry == nil {
+			continue
+		}
+
+		candidates[commit.GetSHA()] = entry
+
+		if oldest.IsZero() || entry.CachedAt.Before(oldest) {
+			oldest = entry.CachedAt
+		}
+	}
+
+	if len(candidates) == 0 {
+		logrus.Info("No cached notes entries found, resolving all commits")
+
+		return nil, commits, nil
+	}
+
+	updatedPRs, err := g.updatedPRs(oldest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing pull requests updated since %s: %w", oldest, err)
+	}
+
+	logrus.Infof("Found %d pull requests updated since %s", len(updatedPRs), oldest)
+
+	for _, commit := range commits {
+		if entry, ok := candidates[commit.GetSHA()]; ok && !entry.updated(updatedPRs) {
+			entries = append(entries, entry)
+		} else {
+			uncached = append(uncached, commit)
+		}
+	}
+
+	logrus.Infof(
+		"Using %d cached notes entries, resolving %d commits",
+		len(entries), len(uncached),
+	)
+
+	return entries, uncached, nil
+}
+
+// storeEntries caches the entries of the resolved commits, including the
+// ones without any release note.
+func (g *Gatherer) storeEntries(commits []*gogithub.RepositoryCommit, entries []*noteEntry) error {
+	if g.cache == nil {
+		return nil
+	}
+
+	bySHA := map[string]*noteEntry{}
+	for _, entry := range entries {
+		bySHA[entry.Commit] = entry
+	}
+
+	for _, commit := range commits {
+		entry, ok := bySHA[commit.GetSHA()]
+		if !ok {
+			entry = &noteEntry{
+				Commit:       commit.GetSHA(),
+				CommitAuthor: commit.GetAuthor().GetLogin(),
+			}
+		}
+
+		entry.PRs = g.cache.recordedPRs(commit.GetSHA())
+
+		if err := g.cache.put(entry); err != nil {
+			return fmt.Errorf("caching notes for commit %s: %w", commit.GetSHA(), err)
+		}
+	}
+
+	logrus.Infof("Cached notes entries for %d commits", len(commits))
+
+	return nil
+}
+
+// entryFromResult creates a new notes entry from a resolved result.
+func (g *Gatherer) entryFromResult(result *Result) *noteEntry {
+	entry := &noteEntry{
+		Commit:       result.commit.GetSHA(),
+		CommitAuthor: result.commit.GetAuthor().GetLogin(),
+		PrNumber:     result.pullRequest.GetNumber(),
+		Excluded:     MatchesExcludeFilter(result.pullRequest.GetBody()),
+	}
+
+	note, err := g.ReleaseNoteFromCommit(result)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Note = note
+	}
+
+	return entry
+}
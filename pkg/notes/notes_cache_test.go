@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v60/github"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/release-sdk/github/githubfakes"
+)
+
+func TestListReleaseNotesCache(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+	mapsDir := t.TempDir()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+
+	newPR := func(number int, body string, updatedAt time.Time) *github.PullRequest {
+		pr := pullRequest(number, body, "closed")
+		pr.UpdatedAt = &github.Timestamp{Time: updatedAt}
+		pr.User = &github.User{Login: strPtr("user")}
+
+		return pr
+	}
+
+	prs := map[int]*github.PullRequest{
+		1: newPR(1, "```release-note\nFirst note\n```", created),
+		2: newPR(2, "no note", created),
+		3: newPR(3, "```release-note\nNONE\n```", created),
+	}
+
+	newClient := func(updatedPRs ...*github.PullRequest) *githubfakes.FakeClient {
+		client := &githubfakes.FakeClient{}
+		client.GetCommitReturns(&github.Commit{
+			Committer: &github.CommitAuthor{Date: &github.Timestamp{}},
+		}, nil, nil)
+		client.ListCommitsReturns([]*github.RepositoryCommit{
+			repoCommit("sha-1", "Merge pull request #1"),
+			repoCommit("sha-2", "Merge pull request #2"),
+			repoCommit("sha-3", "Merge pull request #3"),
+		}, &github.Response{}, nil)
+		client.GetPullRequestStub = func(_ context.Context, _, _ string, number int) (*github.PullRequest, *github.Response, error) {
+			return prs[number], &github.Response{}, nil
+		}
+
+		issues := []*github.Issue{}
+		for _, pr := range updatedPRs {
+			issues = append(issues, &github.Issue{
+				Number:           pr.Number,
+				UpdatedAt:        pr.UpdatedAt,
+				PullRequestLinks: &github.PullRequestLinks{},
+			})
+		}
+
+		client.ListIssuesReturns(issues, &github.Response{}, nil)
+
+		return client
+	}
+
+	listNotes := func(client *githubfakes.FakeClient) *ReleaseNotes {
+		gatherer := NewGathererWithClient(context.Background(), client)
+		gatherer.options.CacheDir = cacheDir
+		gatherer.options.MapProviderStrings = []string{mapsDir}
+
+		notes, err := gatherer.ListReleaseNotes()
+		require.NoError(t, err)
+
+		return notes
+	}
+
+	// Initial run resolves all commits
+	client := newClient()
+	notes := listNotes(client)
+	require.Equal(t, 3, client.GetPullRequestCallCount())
+	require.Zero(t, client.ListIssuesCallCount())
+	require.Equal(t, ReleaseNotesHistory{1}, notes.History())
+
+	// Second run uses the cache only
+	client = newClient()
+	notes = listNotes(client)
+	require.Zero(t, client.GetPullRequestCallCount())
+	require.Equal(t, 1, client.ListIssuesCallCount())
+	require.Equal(t, ReleaseNotesHistory{1}, notes.History())
+	require.Equal(t, "First note (#1, @user)", notes.Get(1).Markdown)
+
+	// Updated PRs get resolved again
+	prs[2] = newPR(2, "```release-note\nSecond note\n```", updated)
+	client = newClient(prs[2])
+	notes = listNotes(client)
+	require.Equal(t, 1, client.GetPullRequestCallCount())
+	require.ElementsMatch(t, ReleaseNotesHistory{1, 2}, notes.History())
+
+	// Changed maps apply to the cached notes
+	require.NoError(t, os.WriteFile(filepath.Join(mapsDir, "maps.yaml"), []byte(
+		"pr: 3\nreleasenote:\n  text: Mapped note\n",
+	), os.FileMode(0o644)))
+
+	client = newClient()
+	notes = listNotes(client)
+	require.Zero(t, client.GetPullRequestCallCount())
+	require.ElementsMatch(t, ReleaseNotesHistory{1, 2, 3}, notes.History())
+	require.Equal(t, "Mapped note", notes.Get(3).Text)
+	require.True(t, notes.Get(3).IsMapped)
+}
+
+func TestCachedEntriesDifferentRanges(t *testing.T) {
+	t.Parallel()
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	t2 := t0.Add(2 * time.Hour)
+
+	cache, err := newNotesCache(t.TempDir(), "org", "repo", false)
+	require.NoError(t, err)
+
+	entry := func(sha string, pr int) *noteEntry {
+		return &noteEntry{
+			Commit:   sha,
+			PrNumber: pr,
+			PRs:      []cachedPR{{Number: pr, UpdatedAt: t0.Add(-time.Hour)}},
+		}
+	}
+
+	// Run A caches the commit of PR #1, which gets updated afterwards
+	cache.startTime = t0
+	require.NoError(t, cache.put(entry("sha-1", 1)))
+
+	// Run B covers a different commit range later on
+	cache.startTime = t2
+	require.NoError(t, cache.put(entry("sha-2", 2)))
+
+	client := &githubfakes.FakeClient{}
+	client.ListIssuesStub = func(
+		_ context.Context, _, _ string, opts *github.IssueListByRepoOptions,
+	) ([]*github.Issue, *github.Response, error) {
+		issues := []*github.Issue{}
+		if !t1.Before(opts.Since) {
+			issues = append(issues, &github.Issue{
+				Number:           intPtr(1),
+				UpdatedAt:        &github.Timestamp{Time: t1},
+				PullRequestLinks: &github.PullRequestLinks{},
+			})
+		}
+
+		return issues, &github.Response{}, nil
+	}
+
+	// Run C covers both commits again
+	gatherer := NewGathererWithClient(context.Background(), client)
+	gatherer.cache = cache
+
+	entries, uncached, err := gatherer.cachedEntries([]*github.RepositoryCommit{
+		repoCommit("sha-1", "Merge pull request #1"),
+		repoCommit("sha-2", "Merge pull request #2"),
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "sha-2", entries[0].Commit)
+	require.Len(t, uncached, 1)
+	require.Equal(t, "sha-1", uncached[0].GetSHA())
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	gitobject "github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// conventionalHeaderRE matches the header of a conventional commit, like
+	// `feat(api)!: add new field`.
+	conventionalHeaderRE = regexp.MustCompile(
+		`^(?P<type>[a-zA-Z]+)(?:\((?P<scope>[^()]+)\))?(?P<breaking>!)?: (?P<description>\S.*)$`,
+	)
+
+	// conventionalFooterRE matches a footer (git trailer) of a conventional
+	// commit, like `BREAKING CHANGE: removed field` or `Refs #123`.
+	conventionalFooterRE = regexp.MustCompile(
+		`^(?P<token>BREAKING[ -]CHANGE|[\w-]+)(?::[ \t]|[ \t]#)(?P<value>.*)$`,
+	)
+
+	// conventionalPRSuffixRE matches the pull request reference which gets
+	// appended to the subject of squash merged pull requests.
+	conventionalPRSuffixRE = regexp.MustCompile(`\s*\(#\d+\)$`)
+
+	errNoConventionalCommit = errors.New("commit message does not follow the conventional commits specification")
+)
+
+// conventionalCommitKinds maps the conventional commit types to release note
+// kinds. Commits of other types (like `chore`, `ci` or `test`) only result in
+// a release note if they contain a breaking change.
+var conventionalCommitKinds = map[string]Kind{
+	"feat":     KindFeature,
+	"fix":      KindBug,
+	"docs":     KindDocumentation,
+	"perf":     KindCleanup,
+	"refactor": KindCleanup,
+}
+
+// conventionalCommit is a parsed commit message following
+// https://www.conventionalcommits.org.
+type conventionalCommit struct {
+	// Type is the lower case type of the commit, like `feat` or `fix`.
+	Type string
+
+	// Scopes are the comma separated scopes of the header.
+	Scopes []string
+
+	// Description is the header description without the pull request
+	// reference.
+	Description string
+
+	// Body is the commit message body without the footers.
+	Body string
+
+	// Breaking is true if the header is marked with `!` or a
+	// `BREAKING CHANGE` footer exists.
+	Breaking bool
+
+	// BreakingChange is the description of the `BREAKING CHANGE` footer.
+	BreakingChange string
+
+	// Footers are the footer values indexed by their lower case token.
+	Footers map[string][]string
+}
+
+// parseConventionalCommit parses a commit message. The header of merge
+// commits (like `Merge pull request #1 from user/branch`) is skipped, which
+// means that the pull request title in the merge commit body gets used.
+func parseConventionalCommit(message string) (*conventionalCommit, error) {
+	message = strings.TrimSpace(message)
+	header, rest, _ := strings.Cut(message, "\n")
+
+	match := conventionalHeaderRE.FindStringSubmatch(strings.TrimSpace(header))
+	if match == nil {
+		if strings.HasPrefix(header, "Merge ") && strings.TrimSpace(rest) != "" {
+			return parseConventionalCommit(rest)
+		}
+
+		return nil, errNoConventionalCommit
+	}
+
+	cc := &conventionalCommit{
+		Type:        strings.ToLower(match[conventionalHeaderRE.SubexpIndex("type")]),
+		Description: conventionalPRSuffixRE.ReplaceAllString(match[conventionalHeaderRE.SubexpIndex("description")], ""),
+		Breaking:    match[conventionalHeaderRE.SubexpIndex("breaking")] != "",
+		Footers:     map[string][]string{},
+	}
+
+	for _, scope := range strings.Split(match[conventionalHeaderRE.SubexpIndex("scope")], ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			cc.Scopes = append(cc.Scopes, scope)
+		}
+	}
+
+	paragraphs := strings.Split(strings.TrimSpace(rest), "\n\n")
+	last := paragraphs[len(paragraphs)-1]
+	if firstLine, _, _ := strings.Cut(last, "\n"); conventionalFooterRE.MatchString(firstLine) {
+		cc.parseFooters(last)
+		paragraphs = paragraphs[:len(paragraphs)-1]
+	}
+
+	cc.Body = strings.TrimSpace(strings.Join(paragraphs, "\n\n"))
+
+	return cc, nil
+}
+
+// parseFooters parses the footer paragraph of the commit message, where
+// lines which do not start a new footer continue the previous one.
+func (cc *conventionalCommit) parseFooters(paragraph string) {
+	token := ""
+
+	for _, line := range strings.Split(paragraph, "\n") {
+		if match := conventionalFooterRE.FindStringSubmatch(line); match != nil {
+			token = strings.ToLower(strings.ReplaceAll(match[conventionalFooterRE.SubexpIndex("token")], " ", "-"))
+			cc.Footers[token] = append(cc.Footers[token], strings.TrimSpace(match[conventionalFooterRE.SubexpIndex("value")]))
+
+			continue
+		}
+
+		if values := cc.Footers[token]; len(values) > 0 {
+			values[len(values)-1] += "\n" + strings.TrimSpace(line)
+		}
+	}
+
+	if values := cc.Footers["breaking-change"]; len(values) > 0 {
+		cc.Breaking = true
+		cc.BreakingChange = strings.Join(values, "\n\n")
+	}
+}
+
+// Areas returns the scopes of the commit together with the values of the
+// `Area` footers.
+func (cc *conventionalCommit) Areas() []string {
+	areas := slices.Clone(cc.Scopes)
+
+	for _, token := range []string{"area", "areas"} {
+		for _, value := range cc.Footers[token] {
+			for _, area := range strings.Split(value, ",") {
+				if area = strings.TrimSpace(area); area != "" && !slices.Contains(areas, area) {
+					areas = append(areas, area)
+				}
+			}
+		}
+	}
+
+	return areas
+}
+
+// ListReleaseNotesConventionalCommits derives the release notes from the
+// conventional commit messages of the local repository, without querying
+// any forge API. The commits are walked in the same way as
+// `ListReleaseNotesV2` does.
+func (g *Gatherer) ListReleaseNotesConventionalCommits() (*ReleaseNotes, error) {
+	commits, err := leftParentCommits(g.options)
+	if err != nil {
+		return nil, fmt.Errorf("listing offline commits: %w", err)
+	}
+
+	mapProviders := []MapProvider{}
+
+	for _, initString := range g.options.MapProviderStrings {
+		provider, err := NewProviderFromInitString(initString)
+		if err != nil {
+			return nil, fmt.Errorf("while getting release notes map providers: %w", err)
+		}
+
+		mapProviders = append(mapProviders, provider)
+	}
+
+	releaseNotes := NewReleaseNotes()
+
+	for _, commit := range commits {
+		note, err := g.releaseNoteFromConventionalCommit(commit)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"sha": commit.Hash.String(),
+			}).Debugf("skip: %v", err)
+
+			continue
+		}
+
+		if note == nil {
+			continue
+		}
+
+		// Commits without a pull request are keyed by their SHA
+		if note.PrNumber == 0 {
+			releaseNotes.SetCommit(note.Commit, note)
+
+			continue
+		}
+
+		if releaseNotes.Get(note.PrNumber) != nil {
+			logrus.Debugf("Skipping duplicate release note for PR #%d", note.PrNumber)
+
+			continue
+		}
+
+		for _, provider := range mapProviders {
+			noteMaps, err := provider.GetMapsForPR(note.PrNumber)
+			if err != nil {
+				return nil, fmt.Errorf("checking if a map exists for PR %d: %w", note.PrNumber, err)
+			}
+
+			for _, noteMap := range noteMaps {
+				if err := note.ApplyMap(noteMap, g.options.AddMarkdownLinks); err != nil {
+					return nil, fmt.Errorf("applying note maps to PR #%d: %w", note.PrNumber, err)
+				}
+			}
+		}
+
+		releaseNotes.Set(note.PrNumber, note)
+	}
+
+	logrus.Infof("Found %d release notes in %d commits", len(releaseNotes.All()), len(commits))
+
+	return releaseNotes, nil
+}
+
+// releaseNoteFromConventionalCommit creates a release note from a single
+// commit. It returns nil if the type of the commit is not relevant for the
+// release notes.
+func (g *Gatherer) releaseNoteFromConventionalCommit(commit *gitobject.Commit) (*ReleaseNote, error) {
+	cc, err := parseConventionalCommit(commit.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, ok := conventionalCommitKinds[cc.Type]
+	if !ok && !cc.Breaking {
+		return nil, nil
+	}
+
+	kinds := []string{}
+	if ok {
+		kinds = append(kinds, string(kind))
+	}
+
+	text := cc.Description
+	if cc.BreakingChange != "" {
+		text += "\n\n" + cc.BreakingChange
+	}
+
+	prNumber := 0
+	if prs, err := prsNumForCommitFromMessage(commit.Message); err == nil {
+		prNumber = prs[0]
+	}
+
+	sha := commit.Hash.String()
+	baseURL := fmt.Sprintf("%s%s/%s", g.options.GithubBaseURL, g.options.GithubOrg, g.options.GithubRepo)
+
+	prURL := ""
+	reference := sha[:7]
+	referenceURL := baseURL + "/commit/" + sha
+
+	if prNumber != 0 {
+		prURL = fmt.Sprintf("%s/pull/%d", baseURL, prNumber)
+		reference = fmt.Sprintf("#%d", prNumber)
+		referenceURL = prURL
+	}
+
+	indented := strings.ReplaceAll(text, "\n", "\n  ")
+	markdown := fmt.Sprintf("%s (%s)", indented, reference)
+
+	if g.options.AddMarkdownLinks {
+		markdown = fmt.Sprintf("%s ([%s](%s))", indented, reference, referenceURL)
+	}
+
+	return &ReleaseNote{
+		Commit:         sha,
+		Text:           text,
+		Markdown:       capitalizeString(markdown),
+		Documentation:  DocumentationFromString(cc.Body),
+		Author:         commit.Author.Name,
+		PrURL:          prURL,
+		PrNumber:       prNumber,
+		Kinds:          kinds,
+		Areas:          cc.Areas(),
+		Feature:        kind == KindFeature,
+		ActionRequired: cc.Breaking,
+		DataFields:     map[string]ReleaseNotesDataField{},
+		OriginPrNumber: originPrNumFromMessage(commit.Message),
+	}, nil
+}
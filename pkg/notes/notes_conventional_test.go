@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		message     string
+		expected    *conventionalCommit
+		shouldError bool
+	}{
+		{
+			message: "feat: add widget",
+			expected: &conventionalCommit{
+				Type: "feat", Description: "add widget", Footers: map[string][]string{},
+			},
+		},
+		{
+			message: "Fix(api, cli)!: remove field (#123)\n\nSome details.\n\nMore details.",
+			expected: &conventionalCommit{
+				Type:        "fix",
+				Scopes:      []string{"api", "cli"},
+				Description: "remove field",
+				Body:        "Some details.\n\nMore details.",
+				Breaking:    true,
+				Footers:     map[string][]string{},
+			},
+		},
+		{
+			message: "refactor: rework\n\nBody\n\nBREAKING CHANGE: the old\nconfig is gone\nRefs #42\nArea: config",
+			expected: &conventionalCommit{
+				Type:           "refactor",
+				Description:    "rework",
+				Body:           "Body",
+				Breaking:       true,
+				BreakingChange: "the old\nconfig is gone",
+				Footers: map[string][]string{
+					"breaking-change": {"the old\nconfig is gone"},
+					"refs":            {"42"},
+					"area":            {"config"},
+				},
+			},
+		},
+		{
+			message: "Merge pull request #5 from user/branch\n\ndocs: update guide",
+			expected: &conventionalCommit{
+				Type: "docs", Description: "update guide", Footers: map[string][]string{},
+			},
+		},
+		{message: "Update README", shouldError: true},
+		{message: "Merge pull request #5 from user/branch\n\nUpdate guide", shouldError: true},
+		{message: "feat:missing space", shouldError: true},
+	} {
+		res, err := parseConventionalCommit(tc.message)
+		if tc.shouldError {
+			require.ErrorIs(t, err, errNoConventionalCommit, tc.message)
+
+			continue
+		}
+
+		require.NoError(t, err, tc.message)
+		require.Equal(t, tc.expected, res, tc.message)
+	}
+}
+
+func TestListReleaseNotesConventionalCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{
+			"-c", "user.name=test", "-c", "user.email=test@example.com",
+			"-c", "commit.gpgsign=false",
+		}, args...)...)
+		cmd.Dir = repo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(message string) {
+		git("commit", "--quiet", "--allow-empty", "-m", message)
+	}
+
+	git("init", "--quiet", "--initial-branch=main")
+	commit("Initial commit")
+	start := git("rev-parse", "HEAD")
+
+	commit("feat(api): add widget (#10)")
+	commit("chore: bump dependencies (#11)")
+	commit("fix!: drop legacy flag\n\nBREAKING CHANGE: the --legacy flag\nhas been removed\nArea: cli")
+	breakingSHA := git("rev-parse", "HEAD")
+
+	git("checkout", "--quiet", "-b", "feature")
+	commit("wip")
+	git("checkout", "--quiet", "main")
+	git("merge", "--quiet", "--no-ff", "feature", "-m", "Merge pull request #12 from user/feature\n\ndocs: describe widgets")
+	commit("Update README")
+
+	git("checkout", "--quiet", "-b", "cherry-pick")
+	commit("wip")
+	git("checkout", "--quiet", "main")
+	git("merge", "--quiet", "--no-ff", "cherry-pick", "-m",
+		"Merge pull request #13 from k8s-infra-cherrypick-robot/cherry-pick-9-to-release-1.30\n\nfix: crash on start")
+
+	gatherer := NewGathererWithClient(context.Background(), nil)
+	gatherer.options.RepoPath = repo
+	gatherer.options.GithubBaseURL = "https://github.com/"
+	gatherer.options.GithubOrg = "org"
+	gatherer.options.GithubRepo = "repo"
+	gatherer.options.StartSHA = start
+	gatherer.options.EndSHA = git("rev-parse", "HEAD")
+	gatherer.options.AddMarkdownLinks = true
+
+	notes, err := gatherer.ListReleaseNotesConventionalCommits()
+	require.NoError(t, err)
+	require.Equal(t, ReleaseNotesHistory{13, 12, 10}, notes.History())
+	require.Len(t, notes.ByCommit(), 1)
+	require.Len(t, notes.All(), 4)
+
+	cherryPick := notes.Get(13)
+	require.Equal(t, "crash on start", cherryPick.Text)
+	require.Equal(t, 9, cherryPick.OriginPrNumber)
+
+	docs := notes.Get(12)
+	require.Equal(t, "describe widgets", docs.Text)
+	require.Equal(t, []string{"documentation"}, docs.Kinds)
+	require.Equal(t, "Describe widgets ([#12](https://github.com/org/repo/pull/12))", docs.Markdown)
+
+	breaking := notes.ByCommit()[breakingSHA]
+	require.Equal(t, "drop legacy flag\n\nthe --legacy flag\nhas been removed", breaking.Text)
+	require.Equal(t, []string{"bug"}, breaking.Kinds)
+	require.Equal(t, []string{"cli"}, breaking.Areas)
+	require.True(t, breaking.ActionRequired)
+	require.Zero(t, breaking.PrNumber)
+	require.Equal(t, breakingSHA, breaking.Commit)
+	require.Zero(t, breaking.OriginPrNumber)
+	require.Contains(t, breaking.Markdown, "(["+breakingSHA[:7]+"](https://github.com/org/repo/commit/"+breakingSHA+"))")
+
+	feature := notes.Get(10)
+	require.Equal(t, "add widget", feature.Text)
+	require.Equal(t, []string{"feature"}, feature.Kinds)
+	require.Equal(t, []string{"api"}, feature.Areas)
+	require.True(t, feature.Feature)
+	require.False(t, feature.ActionRequired)
+	require.Equal(t, "https://github.com/org/repo/pull/10", feature.PrURL)
+}
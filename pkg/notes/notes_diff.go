@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// NotesDiff contains the differences between two sets of release notes.
+type NotesDiff struct {
+	// Added are the notes which only exist in the new set.
+	Added []*ReleaseNote `json:"added"`
+
+	// Removed are the notes which only exist in the old set.
+	Removed []*ReleaseNote `json:"removed"`
+
+	// Modified are the notes which exist in both sets but whose content
+	// changed.
+	Modified []*NoteChange `json:"modified"`
+}
+
+// NoteChange is a release note whose content changed between two sets of
+// release notes.
+type NoteChange struct {
+	// PrNumber is the pull request number of the note.
+	PrNumber int `json:"pr_number"`
+
+	// Fields are the names of the changed fields, like `text` or `kinds`.
+	Fields []string `json:"fields"`
+
+	// Old is the note of the old set.
+	Old *ReleaseNote `json:"old"`
+
+	// New is the note of the new set.
+	New *ReleaseNote `json:"new"`
+}
+
+// noteDiffFields are all fields which contribute to the content hash of a
+// release note, together with their accessor.
+var noteDiffFields = []struct {
+	name  string
+	value func(*ReleaseNote) any
+}{
+	{"text", func(rn *ReleaseNote) any { return rn.Text }},
+	{"kinds", func(rn *ReleaseNote) any { return rn.Kinds }},
+	{"sigs", func(rn *ReleaseNote) any { return rn.SIGs }},
+	{"areas", func(rn *ReleaseNote) any { return rn.Areas }},
+	{"action_required", func(rn *ReleaseNote) any { return rn.ActionRequired }},
+	{"feature", func(rn *ReleaseNote) any { return rn.Feature }},
+	{"do_not_publish", func(rn *ReleaseNote) any { return rn.DoNotPublish }},
+	{"documentation", func(rn *ReleaseNote) any { return rn.Documentation }},
+	{"author", func(rn *ReleaseNote) any { return rn.Author }},
+	{"commit", func(rn *ReleaseNote) any { return rn.Commit }},
+	{"pr_body", func(rn *ReleaseNote) any { return rn.PRBody }},
+}
+
+// DiffReleaseNotes compares two sets of release notes. Notes are considered
+// modified if their `ContentHash` differs. All results are sorted by their
+// pull request number.
+func DiffReleaseNotes(oldNotes, newNotes ReleaseNotesByPR) (*NotesDiff, error) {
+	diff := &NotesDiff{
+		Added:    []*ReleaseNote{},
+		Removed:  []*ReleaseNote{},
+		Modified: []*NoteChange{},
+	}
+
+	for _, pr := range sortedPRs(newNotes) {
+		newNote := newNotes[pr]
+
+		oldNote, ok := oldNotes[pr]
+		if !ok {
+			diff.Added = append(diff.Added, newNote)
+
+			continue
+		}
+
+		oldHash, err := oldNote.ContentHash()
+		if err != nil {
+			return nil, fmt.Errorf("hashing old note of PR #%d: %w", pr, err)
+		}
+
+		newHash, err := newNote.ContentHash()
+		if err != nil {
+			return nil, fmt.Errorf("hashing new note of PR #%d: %w", pr, err)
+		}
+
+		if oldHash == newHash {
+			continue
+		}
+
+		diff.Modified = append(diff.Modified, &NoteChange{
+			PrNumber: pr,
+			Fields:   changedNoteFields(oldNote, newNote),
+			Old:      oldNote,
+			New:      newNote,
+		})
+	}
+
+	for _, pr := range sortedPRs(oldNotes) {
+		if _, ok := newNotes[pr]; !ok {
+			diff.Removed = append(diff.Removed, oldNotes[pr])
+		}
+	}
+
+	return diff, nil
+}
+
+// Empty returns true if there are no differences.
+func (d *NotesDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+// Markdown renders the differences as markdown.
+func (d *NotesDiff) Markdown() string {
+	var s strings.Builder
+
+	s.WriteString("# Release Notes Changes\n")
+
+	if d.Empty() {
+		s.WriteString("\nNo changes.\n")
+
+		return s.String()
+	}
+
+	writeNotes := func(title string, notes []*ReleaseNote) {
+		if len(notes) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&s, "\n## %s (%d)\n\n", title, len(notes))
+
+		for _, note := range notes {
+			fmt.Fprintf(&s, "- %s\n", strings.TrimSpace(note.Markdown))
+		}
+	}
+
+	writeNotes("Added", d.Added)
+	writeNotes("Removed", d.Removed)
+
+	if len(d.Modified) == 0 {
+		return s.String()
+	}
+
+	fmt.Fprintf(&s, "\n## Modified (%d)\n", len(d.Modified))
+
+	for _, change := range d.Modified {
+		fmt.Fprintf(&s, "\n### #%d\n\n", change.PrNumber)
+		fmt.Fprintf(&s, "Changed: %s\n", strings.Join(change.Fields, ", "))
+
+		if slices.Contains(change.Fields, "text") {
+			fmt.Fprintf(&s, "\nBefore:\n\n%s\n", markdownQuote(change.Old.Text))
+			fmt.Fprintf(&s, "\nAfter:\n\n%s\n", markdownQuote(change.New.Text))
+		}
+
+		changes := []string{}
+
+		if slices.Contains(change.Fields, "kinds") {
+			changes = append(changes, fmt.Sprintf(
+				"- Kinds: %s → %s", listOrNone(change.Old.Kinds), listOrNone(change.New.Kinds),
+			))
+		}
+
+		if slices.Contains(change.Fields, "sigs") {
+			changes = append(changes, fmt.Sprintf(
+				"- SIGs: %s → %s", listOrNone(change.Old.SIGs), listOrNone(change.New.SIGs),
+			))
+		}
+
+		if slices.Contains(change.Fields, "areas") {
+			changes = append(changes, fmt.Sprintf(
+				"- Areas: %s → %s", listOrNone(change.Old.Areas), listOrNone(change.New.Areas),
+			))
+		}
+
+		if slices.Contains(change.Fields, "action_required") {
+			changes = append(changes, fmt.Sprintf(
+				"- Action required: %t → %t", change.Old.ActionRequired, change.New.ActionRequired,
+			))
+		}
+
+		if len(changes) > 0 {
+			fmt.Fprintf(&s, "\n%s\n", strings.Join(changes, "\n"))
+		}
+	}
+
+	return s.String()
+}
+
+// changedNoteFields returns the names of all fields which differ between the
+// notes.
+func changedNoteFields(oldNote, newNote *ReleaseNote) []string {
+	fields := []string{}
+
+	for _, field := range noteDiffFields {
+		if !fieldEqual(field.value(oldNote), field.value(newNote)) {
+			fields = append(fields, field.name)
+		}
+	}
+
+	return fields
+}
+
+// fieldEqual compares two field values, where nil and empty slices are
+// considered equal as they are after a JSON round trip.
+func fieldEqual(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// sortedPRs returns the pull request numbers of the notes in ascending
+// order.
+func sortedPRs(notes ReleaseNotesByPR) []int {
+	prs := make([]int, 0, len(notes))
+	for pr := range notes {
+		prs = append(prs, pr)
+	}
+
+	slices.Sort(prs)
+
+	return prs
+}
+
+// markdownQuote returns the text as markdown block quote.
+func markdownQuote(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace("> " + line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// listOrNone returns the comma separated list or `none` if it is empty.
+func listOrNone(list []string) string {
+	if len(list) == 0 {
+		return "none"
+	}
+
+	return strings.Join(list, ", ")
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffReleaseNotes(t *testing.T) {
+	t.Parallel()
+
+	oldNotes := ReleaseNotesByPR{
+		1: {PrNumber: 1, Text: "Unchanged", Markdown: "Unchanged (#1, @user)", Kinds: []string{}},
+		2: {PrNumber: 2, Text: "Removed", Markdown: "Removed (#2, @user)"},
+		3: {
+			PrNumber: 3, Text: "Old text\nsecond line", Markdown: "Old text (#3, @user)",
+			Kinds: []string{"bug"}, SIGs: []string{"node"},
+		},
+		4: {PrNumber: 4, Text: "Now required", Markdown: "Now required (#4, @user)"},
+	}
+
+	newNotes := ReleaseNotesByPR{
+		1: {PrNumber: 1, Text: "Unchanged", Markdown: "Unchanged (#1, @user)"},
+		3: {
+			PrNumber: 3, Text: "New text", Markdown: "New text (#3, @user)",
+			Kinds: []string{"feature"}, SIGs: []string{"node"},
+		},
+		4: {PrNumber: 4, Text: "Now required", Markdown: "Now required (#4, @user)", ActionRequired: true},
+		5: {PrNumber: 5, Text: "Added", Markdown: "Added (#5, @user)"},
+	}
+
+	diff, err := DiffReleaseNotes(oldNotes, newNotes)
+	require.NoError(t, err)
+	require.False(t, diff.Empty())
+
+	require.Len(t, diff.Added, 1)
+	require.Equal(t, 5, diff.Added[0].PrNumber)
+	require.Len(t, diff.Removed, 1)
+	require.Equal(t, 2, diff.Removed[0].PrNumber)
+	require.Len(t, diff.Modified, 2)
+	require.Equal(t, 3, diff.Modified[0].PrNumber)
+	require.Equal(t, []string{"text", "kinds"}, diff.Modified[0].Fields)
+	require.Equal(t, 4, diff.Modified[1].PrNumber)
+	require.Equal(t, []string{"action_required"}, diff.Modified[1].Fields)
+
+	require.Equal(t, `# Release Notes Changes
+
+## Added (1)
+
+- Added (#5, @user)
+
+## Removed (1)
+
+- Removed (#2, @user)
+
+## Modified (2)
+
+### #3
+
+Changed: text, kinds
+
+Before:
+
+> Old text
+> second line
+
+After:
+
+> New text
+
+- Kinds: bug → feature
+
+### #4
+
+Changed: action_required
+
+- Action required: false → true
+`, diff.Markdown())
+
+	diff, err = DiffReleaseNotes(oldNotes, oldNotes)
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+	require.Equal(t, "# Release Notes Changes\n\nNo changes.\n", diff.Markdown())
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes/forge"
+)
+
+func TestListReleaseNotesGitLab(t *testing.T) {
+	t.Parallel()
+
+	const project = "/api/v4/projects/group%2Fproject"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+project+"/repository/commits/{sha}", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": %q, "committed_date": "2026-01-01T00:00:00Z"}`, r.PathValue("sha"))
+	})
+	mux.HandleFunc("GET "+project+"/repository/commits", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": "sha-1", "message": "Merge branch 'feature' into 'main'\n\nSee merge request group/project!1"},
+			{"id": "sha-2", "message": "Update docs"}
+		]`)
+	})
+	mux.HandleFunc("GET "+project+"/repository/commits/{sha}/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("sha") != "sha-1" {
+			fmt.Fprint(w, `[]`)
+
+			return
+		}
+
+		fmt.Fprint(w, `[{
+			"iid": 1,
+			"description": "`+"```release-note\\nAdded a new feature\\n```"+`",
+			"state": "merged",
+			"labels": ["kind::feature", "sig::release"],
+			"web_url": "https://gitlab.example.com/group/project/-/merge_requests/1",
+			"author": {"username": "user", "web_url": "https://gitlab.example.com/user"}
+		}]`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	gatherer := NewGathererWithClient(context.Background(), forge.NewGitLab(server.URL, ""))
+	gatherer.options.GithubOrg = "group"
+	gatherer.options.GithubRepo = "project"
+	gatherer.options.StartSHA = "sha-0"
+	gatherer.options.EndSHA = "sha-2"
+
+	notes, err := gatherer.ListReleaseNotes()
+	require.NoError(t, err)
+	require.Equal(t, ReleaseNotesHistory{1}, notes.History())
+
+	note := notes.Get(1)
+	require.Equal(t, "Added a new feature", note.Text)
+	require.Equal(t, []string{"feature"}, note.Kinds)
+	require.Equal(t, []string{"release"}, note.SIGs)
+	require.Equal(t, "https://gitlab.example.com/group/project/-/merge_requests/1", note.PrURL)
+	require.Equal(t, "https://gitlab.example.com/user", note.AuthorURL)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// JSONReleaseNotesKey is the key of the release notes in a JSON document
+// which contains additional reports.
+const JSONReleaseNotesKey = "releaseNotes"
+
+// JSONDocument is the `--format=json` output of the release notes. If
+// neither release notes without PR nor a feature gate report exist, the
+// document is encoded as plain release notes by PR to stay compatible with
+// existing consumers.
+type JSONDocument struct {
+	ReleaseNotes         ReleaseNotesByPR     `json:"releaseNotes"`
+	ReleaseNotesByCommit ReleaseNotesByCommit `json:"releaseNotesByCommit,omitempty"`
+	FeatureGates         *FeatureGateReport   `json:"featureGates,omitempty"`
+}
+
+// EncodeJSONDocument writes the release notes and the optional feature gate
+// report as JSON document.
+func EncodeJSONDocument(w io.Writer, doc *JSONDocument) error {
+	var data any = doc.ReleaseNotes
+	if doc.FeatureGates != nil || len(doc.ReleaseNotesByCommit) > 0 {
+		data = doc
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(data); err != nil {
+		return fmt.Errorf("encoding JSON document: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeJSONDocument decodes a JSON document written by EncodeJSONDocument,
+// in both the plain and the extended format.
+func DecodeJSONDocument(data []byte) (*JSONDocument, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("unmarshalling JSON document: %w", err)
+	}
+
+	doc := &JSONDocument{ReleaseNotes: ReleaseNotesByPR{}}
+
+	if _, ok := fields[JSONReleaseNotesKey]; ok {
+		if err := json.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("unmarshalling JSON document: %w", err)
+		}
+
+		return doc, nil
+	}
+
+	if err := json.Unmarshal(data, &doc.ReleaseNotes); err != nil {
+		return nil, fmt.Errorf("unmarshalling release notes: %w", err)
+	}
+
+	return doc, nil
+}
+
+// notes returns the release notes by PR followed by the release notes by
+// commit of the document.
+func (d *JSONDocument) notes() []*ReleaseNote {
+	res := slices.Collect(maps.Values(d.ReleaseNotes))
+
+	return append(res, slices.Collect(maps.Values(d.ReleaseNotesByCommit))...)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONDocument(t *testing.T) {
+	t.Parallel()
+
+	releaseNotes := ReleaseNotesByPR{
+		1: {PrNumber: 1, Text: "First note"},
+	}
+	featureGates := &FeatureGateReport{
+		Changes: []*FeatureGateChange{{
+			Name:  "MyFeature",
+			Kinds: []FeatureGateChangeKind{FeatureGateAdded},
+			New:   &FeatureGate{Name: "MyFeature", Stage: "Alpha"},
+		}},
+	}
+
+	byCommit := ReleaseNotesByCommit{
+		"abc": {Commit: "abc", Text: "Second note"},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		byCommit     ReleaseNotesByCommit
+		featureGates *FeatureGateReport
+		assert       func(map[string]json.RawMessage)
+	}{
+		{
+			name: "without feature gate report",
+			assert: func(fields map[string]json.RawMessage) {
+				require.Len(t, fields, 1)
+				require.Contains(t, fields, "1")
+			},
+		},
+		{
+			name:         "with feature gate report",
+			featureGates: featureGates,
+			assert: func(fields map[string]json.RawMessage) {
+				require.Len(t, fields, 2)
+				require.Contains(t, fields, JSONReleaseNotesKey)
+				require.Contains(t, fields, "featureGates")
+			},
+		},
+		{
+			name:     "with release notes by commit",
+			byCommit: byCommit,
+			assert: func(fields map[string]json.RawMessage) {
+				require.Len(t, fields, 2)
+				require.Contains(t, fields, JSONReleaseNotesKey)
+				require.Contains(t, fields, "releaseNotesByCommit")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, EncodeJSONDocument(buf, &JSONDocument{
+				ReleaseNotes:         releaseNotes,
+				ReleaseNotesByCommit: tc.byCommit,
+				FeatureGates:         tc.featureGates,
+			}))
+
+			fields := map[string]json.RawMessage{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &fields))
+			tc.assert(fields)
+
+			doc, err := DecodeJSONDocument(buf.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, releaseNotes, doc.ReleaseNotes)
+			require.Equal(t, tc.featureGates, doc.FeatureGates)
+
+			if tc.byCommit != nil {
+				require.Equal(t, tc.byCommit, doc.ReleaseNotesByCommit)
+			}
+		})
+	}
+
+	_, err := DecodeJSONDocument([]byte("[]"))
+	require.Error(t, err)
+}
@@ -25,8 +25,6 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
-
-	"sigs.k8s.io/release-sdk/object"
 )
 
 // MapProvider interface that obtains release notes maps from a source.
@@ -36,10 +34,14 @@ type MapProvider interface {
 
 // NewProviderFromInitString creates a new map provider from an initialization string.
 func NewProviderFromInitString(initString string) (MapProvider, error) {
-	// If init string starts with gs:// return a CloudStorageProvider
-	if len(initString) >= 5 && initString[0:5] == object.GcsPrefix {
-		// Currently for illustration purposes
-		return nil, errors.New("CloudStorageProvider is not yet implemented")
+	// If init string starts with git+ return a GitMapProvider
+	if IsGitLocation(initString) {
+		return NewGitMapProvider(initString)
+	}
+
+	// If init string starts with gs:// or https:// return a CloudStorageProvider
+	if IsCloudStorageLocation(initString) {
+		return NewCloudStorageMapProvider(initString), nil
 	}
 
 	// Otherwise, build a DirectoryMapProvider using the
@@ -124,6 +126,10 @@ type ReleaseNotesMap struct {
 
 	// PRBody is the full original PR body.
 	PRBody *string `json:"pr_body,omitempty" yaml:"pr_body,omitempty"`
+
+	// Source is the pinned location the map has been read from, if the
+	// provider supports it.
+	Source string `json:"-" yaml:"-"`
 }
 
 // ReleaseNotesDataField extra data added to a release note.
@@ -0,0 +1,338 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/google"
+
+	"sigs.k8s.io/release-sdk/object"
+	khttp "sigs.k8s.io/release-utils/http"
+)
+
+const (
+	// httpsPrefix is the prefix of release notes maps served via HTTPS.
+	httpsPrefix = "https://"
+
+	// defaultStorageEndpoint is the endpoint of the GCS JSON API.
+	defaultStorageEndpoint = "https://storage.googleapis.com"
+
+	// storageReadOnlyScope is the OAuth scope required to read GCS objects.
+	storageReadOnlyScope = "https://www.googleapis.com/auth/devstorage.read_only"
+
+	// mapsRequestTimeout is the timeout of every request to list or download
+	// maps.
+	mapsRequestTimeout = time.Minute
+)
+
+// IsCloudStorageLocation returns true if the release notes maps location
+// has to be served by a `CloudStorageMapProvider`.
+func IsCloudStorageLocation(location string) bool {
+	return strings.HasPrefix(location, object.GcsPrefix) ||
+		strings.HasPrefix(location, httpsPrefix)
+}
+
+// CloudStorageMapProvider is a provider that gets maps from a `gs://` bucket
+// path or a `https://` URL. Bucket paths are recursively searched for
+// *.y[a]ml files, while URLs have to point to a single maps file.
+//
+// All maps are cached in `CacheDir` together with their ETag, which means
+// that they only get downloaded again if they changed remotely.
+type CloudStorageMapProvider struct {
+	Location string
+	CacheDir string
+	Maps     map[int][]*ReleaseNotesMap
+
+	client          *http.Client
+	storageEndpoint string
+}
+
+// NewCloudStorageMapProvider creates a new `CloudStorageMapProvider` for the
+// provided location, which caches the maps in the default user cache
+// directory.
+func NewCloudStorageMapProvider(location string) *CloudStorageMapProvider {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+
+	return &CloudStorageMapProvider{
+		Location:        location,
+		CacheDir:        filepath.Join(cacheDir, "k8s-release-notes", "maps"),
+		storageEndpoint: defaultStorageEndpoint,
+	}
+}
+
+// GetMapsForPR get the release notes maps for a specific PR number.
+func (mp *CloudStorageMapProvider) GetMapsForPR(pr int) ([]*ReleaseNotesMap, error) {
+	if mp.Maps == nil {
+		if err := mp.readMaps(); err != nil {
+			return nil, fmt.Errorf("while reading release notes maps: %w", err)
+		}
+	}
+
+	return mp.Maps[pr], nil
+}
+
+// remoteMap is a single remote maps file.
+type remoteMap struct {
+	// url to download the maps file from.
+	url string
+
+	// etag of the maps file if already known from a listing.
+	etag string
+}
+
+// readMaps downloads all changed maps into the cache and parses them.
+func (mp *CloudStorageMapProvider) readMaps() error {
+	if err := os.MkdirAll(mp.CacheDir, os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("create maps cache directory: %w", err)
+	}
+
+	var remoteMaps []remoteMap
+
+	if strings.HasPrefix(mp.Location, object.GcsPrefix) {
+		maps, err := mp.listBucketMaps()
+		if err != nil {
+			return fmt.Errorf("list maps in %s: %w", mp.Location, err)
+		}
+
+		remoteMaps = maps
+	} else {
+		remoteMaps = []remoteMap{{url: mp.Location}}
+	}
+
+	mp.Maps = map[int][]*ReleaseNotesMap{}
+
+	for _, remote := range remoteMaps {
+		fileName, err := mp.fetch(remote)
+		if err != nil {
+			return fmt.Errorf("fetch maps from %s: %w", remote.url, err)
+		}
+
+		notemaps, err := ParseReleaseNotesMap(fileName)
+		if err != nil {
+			return fmt.Errorf("while parsing note map from %s: %w", remote.url, err)
+		}
+
+		for i, notemap := range *notemaps {
+			mp.Maps[notemap.PR] = append(mp.Maps[notemap.PR], &(*notemaps)[i])
+		}
+	}
+
+	logrus.Infof(
+		"Successfully parsed release notes maps for %d PRs from %s",
+		len(mp.Maps), mp.Location,
+	)
+
+	return nil
+}
+
+// listBucketMaps lists all maps files in the GCS location by using the JSON
+// API.
+func (mp *CloudStorageMapProvider) listBucketMaps() ([]remoteMap, error) {
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(mp.Location, object.GcsPrefix), "/")
+	if bucket == "" {
+		return nil, fmt.Errorf("no bucket specified in %s", mp.Location)
+	}
+
+	// Only list objects within the directory, not those of other directories
+	// sharing the same name prefix
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	maps := []remoteMap{}
+	pageToken := ""
+
+	for {
+		query := url.Values{}
+		query.Set("prefix", prefix)
+		query.Set("fields", "items(name,etag),nextPageToken")
+
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+
+		resp, err := mp.httpClient().Get(fmt.Sprintf(
+			"%s/storage/v1/b/%s/o?%s",
+			mp.storageEndpoint, url.PathEscape(bucket), query.Encode(),
+		))
+		if err != nil {
+			return nil, fmt.Errorf("list bucket objects: %w", err)
+		}
+
+		list := struct {
+			Items []struct {
+				Name string `json:"name"`
+				ETag string `json:"etag"`
+			} `json:"items"`
+			NextPageToken string `json:"nextPageToken"`
+		}{}
+
+		err = decodeResponse(resp, &list)
+		if err != nil {
+			return nil, fmt.Errorf("decode bucket objects: %w", err)
+		}
+
+		for _, item := range list.Items {
+			if ext := path.Ext(item.Name); ext != ".yaml" && ext != ".yml" {
+				continue
+			}
+
+			maps = append(maps, remoteMap{
+				url: fmt.Sprintf(
+					"%s/storage/v1/b/%s/o/%s?alt=media",
+					mp.storageEndpoint, url.PathEscape(bucket), url.PathEscape(item.Name),
+				),
+				etag: item.ETag,
+			})
+		}
+
+		if list.NextPageToken == "" {
+			break
+		}
+
+		pageToken = list.NextPageToken
+	}
+
+	return maps, nil
+}
+
+// fetch returns the path to the cached maps file and downloads it only if
+// the remote ETag does not match the cached one.
+func (mp *CloudStorageMapProvider) fetch(remote remoteMap) (string, error) {
+	sum := sha256.Sum256([]byte(remote.url))
+	key := hex.EncodeToString(sum[:])
+	fileName := filepath.Join(mp.CacheDir, key+".yaml")
+	etagFileName := filepath.Join(mp.CacheDir, key+".etag")
+
+	cachedETag := ""
+	if _, err := os.Stat(fileName); err == nil {
+		etag, err := os.ReadFile(etagFileName)
+		if err == nil {
+			cachedETag = string(etag)
+		}
+	}
+
+	if cachedETag != "" && cachedETag == remote.etag {
+		logrus.Debugf("Using cached maps for %s", remote.url)
+
+		return fileName, nil
+	}
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, remote.url, http.NoBody,
+	)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	if cachedETag != "" {
+		req.Header.Set("If-None-Match", cachedETag)
+	}
+
+	resp, err := mp.httpClient().Do(req)
+	if err != nil {
+		return "", fmt.Errorf("download maps: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		logrus.Debugf("Maps for %s did not change, using cache", remote.url)
+
+		return fileName, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("download maps: unexpected status %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read maps: %w", err)
+	}
+
+	if err := os.WriteFile(fileName, content, os.FileMode(0o644)); err != nil {
+		return "", fmt.Errorf("write maps to cache: %w", err)
+	}
+
+	etag := remote.etag
+	if etag == "" {
+		etag = resp.Header.Get("ETag")
+	}
+
+	if err := os.WriteFile(etagFileName, []byte(etag), os.FileMode(0o644)); err != nil {
+		return "", fmt.Errorf("write ETag to cache: %w", err)
+	}
+
+	logrus.Infof("Downloaded maps from %s", remote.url)
+
+	return fileName, nil
+}
+
+// httpClient returns the client used for all requests. GCS requests use the
+// application default credentials if available.
+func (mp *CloudStorageMapProvider) httpClient() *http.Client {
+	if mp.client != nil {
+		return mp.client
+	}
+
+	mp.client = khttp.NewAgent().WithTimeout(mapsRequestTimeout).Client()
+
+	if strings.HasPrefix(mp.Location, object.GcsPrefix) {
+		client, err := google.DefaultClient(context.Background(), storageReadOnlyScope)
+		if err != nil {
+			logrus.Debugf("Using anonymous access to %s: %v", mp.Location, err)
+		} else {
+			client.Timeout = mapsRequestTimeout
+			mp.client = client
+		}
+	}
+
+	return mp.client
+}
+
+// decodeResponse decodes the JSON body of a successful response into v.
+func decodeResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("decode JSON: %w", err)
+	}
+
+	return nil
+}
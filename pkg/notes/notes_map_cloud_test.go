@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudStorageMapProviderGCS(t *testing.T) {
+	fullMap, err := os.ReadFile("maps/testdata/fullmap.yaml")
+	require.NoError(t, err)
+
+	cveMap, err := os.ReadFile("maps/testdata/cve-2020-8555.yaml")
+	require.NoError(t, err)
+
+	etag := "etag-1"
+
+	var downloads atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/storage/v1/b/bucket/o", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "maps/", r.URL.Query().Get("prefix"))
+
+		if r.URL.Query().Get("pageToken") == "" {
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]string{
+					{"name": "maps/fullmap.yaml", "etag": etag},
+					{"name": "maps/README.md", "etag": etag},
+				},
+				"nextPageToken": "next",
+			}))
+
+			return
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]string{
+				{"name": "maps/sub/cve.yml", "etag": etag},
+			},
+		}))
+	})
+	mux.HandleFunc("/storage/v1/b/bucket/o/{name}", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "media", r.URL.Query().Get("alt"))
+		downloads.Add(1)
+
+		switch r.PathValue("name") {
+		case "maps/fullmap.yaml":
+			_, err := w.Write(fullMap)
+			require.NoError(t, err)
+		case "maps/sub/cve.yml":
+			_, err := w.Write(cveMap)
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	newProvider := func(location string) *CloudStorageMapProvider {
+		provider := NewCloudStorageMapProvider(location)
+		provider.CacheDir = cacheDir
+		provider.client = server.Client()
+		provider.storageEndpoint = server.URL
+
+		return provider
+	}
+
+	// Initial download
+	maps, err := newProvider("gs://bucket/maps/").GetMapsForPR(123)
+	require.NoError(t, err)
+	require.Len(t, maps, 4)
+	require.EqualValues(t, 2, downloads.Load())
+
+	// The prefix is a directory with or without trailing slash
+	maps, err = newProvider("gs://bucket/maps").GetMapsForPR(89796)
+	require.NoError(t, err)
+	require.Len(t, maps, 1)
+	require.EqualValues(t, 2, downloads.Load())
+
+	// Changed ETag requires a new download
+	etag = "etag-2"
+
+	maps, err = newProvider("gs://bucket/maps/").GetMapsForPR(123)
+	require.NoError(t, err)
+	require.Len(t, maps, 4)
+	require.EqualValues(t, 4, downloads.Load())
+}
+
+func TestCloudStorageMapProviderHTTPS(t *testing.T) {
+	fullMap, err := os.ReadFile("maps/testdata/fullmap.yaml")
+	require.NoError(t, err)
+
+	const etag = `"fullmap"`
+
+	var downloads atomic.Int32
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fullmap.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		downloads.Add(1)
+		w.Header().Set("ETag", etag)
+		_, err := w.Write(fullMap)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	newProvider := func(location string) MapProvider {
+		provider, err := NewProviderFromInitString(location)
+		require.NoError(t, err)
+		require.IsType(t, &CloudStorageMapProvider{}, provider)
+
+		cloudProvider, ok := provider.(*CloudStorageMapProvider)
+		require.True(t, ok)
+
+		cloudProvider.CacheDir = cacheDir
+		cloudProvider.client = server.Client()
+
+		return cloudProvider
+	}
+
+	for range 2 {
+		maps, err := newProvider(server.URL + "/fullmap.yaml").GetMapsForPR(123)
+		require.NoError(t, err)
+		require.Len(t, maps, 4)
+		require.EqualValues(t, 1, downloads.Load())
+	}
+
+	_, err = newProvider(server.URL + "/missing.yaml").GetMapsForPR(123)
+	require.Error(t, err)
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-utils/command"
+)
+
+const (
+	// gitMapPrefix is the prefix of release notes maps read from a git
+	// repository.
+	gitMapPrefix = "git+"
+
+	gitExecutable = "git"
+)
+
+// IsGitLocation returns true if the release notes maps location has to be
+// served by a `GitMapProvider`.
+func IsGitLocation(location string) bool {
+	return strings.HasPrefix(location, gitMapPrefix)
+}
+
+// GitMapProvider is a provider that gets maps from a path inside of a git
+// repository. It is initialized from locations like:
+//
+//	git+https://github.com/org/repo@ref//path/to/maps
+//
+// The ref can be a branch, tag or commit and defaults to the remote HEAD,
+// while the path defaults to the repository root. The repository gets cloned
+// into `CacheDir` once and is fetched on subsequent runs.
+type GitMapProvider struct {
+	RepoURL  string
+	Ref      string
+	Path     string
+	CacheDir string
+	Maps     map[int][]*ReleaseNotesMap
+
+	// Commit is the resolved commit of `Ref` the maps have been read from.
+	Commit string
+
+	mu sync.Mutex
+}
+
+// NewGitMapProvider creates a new `GitMapProvider` from the provided
+// location, which caches the repository in the default user cache directory.
+func NewGitMapProvider(location string) (*GitMapProvider, error) {
+	repoURL, ref, path, err := parseGitLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("parse git maps location: %w", err)
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+
+	return &GitMapProvider{
+		RepoURL:  repoURL,
+		Ref:      ref,
+		Path:     path,
+		CacheDir: filepath.Join(cacheDir, "k8s-release-notes", "git"),
+	}, nil
+}
+
+// parseGitLocation splits a `git+<url>[@ref][//path]` location into its
+// parts.
+func parseGitLocation(location string) (repoURL, ref, path string, err error) {
+	if !IsGitLocation(location) {
+		return "", "", "", fmt.Errorf("location %s has no %s prefix", location, gitMapPrefix)
+	}
+
+	scheme, rest, found := strings.Cut(strings.TrimPrefix(location, gitMapPrefix), "://")
+	if !found || scheme == "" {
+		return "", "", "", fmt.Errorf("location %s has no URL scheme", location)
+	}
+
+	repo, path, _ := strings.Cut(rest, "//")
+
+	// Only consider an @ after the host to not confuse it with user info
+	if at := strings.LastIndex(repo, "@"); at > strings.Index(repo, "/") {
+		repo, ref = repo[:at], repo[at+1:]
+	}
+
+	if strings.Trim(repo, "/") == "" {
+		return "", "", "", fmt.Errorf("location %s has no repository", location)
+	}
+
+	path = filepath.Clean("/" + path)
+
+	return scheme + "://" + repo, ref, strings.TrimPrefix(path, "/"), nil
+}
+
+// Source returns the location of the maps pinned to the resolved commit, or
+// the unresolved location if the maps have not been read yet.
+func (mp *GitMapProvider) Source() string {
+	ref := mp.Ref
+	if mp.Commit != "" {
+		ref = mp.Commit
+	}
+
+	source := gitMapPrefix + mp.redactedRepoURL()
+	if ref != "" {
+		source += "@" + ref
+	}
+
+	if mp.Path != "" {
+		source += "//" + mp.Path
+	}
+
+	return source
+}
+
+// redactedRepoURL returns the repository URL without any user credentials,
+// which makes it safe to be logged or to be added to the release notes.
+func (mp *GitMapProvider) redactedRepoURL() string {
+	u, err := url.Parse(mp.RepoURL)
+	if err != nil || u.User == nil {
+		return mp.RepoURL
+	}
+
+	u.User = nil
+
+	return u.String()
+}
+
+// GetMapsForPR get the release notes maps for a specific PR number.
+func (mp *GitMapProvider) GetMapsForPR(pr int) ([]*ReleaseNotesMap, error) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if mp.Maps == nil {
+		if err := mp.readMaps(); err != nil {
+			return nil, fmt.Errorf("while reading release notes maps: %w", err)
+		}
+	}
+
+	return mp.Maps[pr], nil
+}
+
+// readMaps updates the repository, checks out the resolved ref and parses
+// all maps found in the path. It fails if any of the maps is invalid.
+func (mp *GitMapProvider) readMaps() error {
+	repoDir, err := mp.updateRepo()
+	if err != nil {
+		return fmt.Errorf("update repository %s: %w", mp.redactedRepoURL(), err)
+	}
+
+	commit, err := mp.resolveRef(repoDir)
+	if err != nil {
+		return fmt.Errorf("resolve ref %q: %w", mp.Ref, err)
+	}
+
+	if err := command.NewWithWorkDir(
+		repoDir, gitExecutable, "checkout", "--quiet", "--force", "--detach", commit,
+	).RunSilentSuccess(); err != nil {
+		return fmt.Errorf("checkout %s: %w", commit, err)
+	}
+
+	mapsDir := filepath.Join(repoDir, mp.Path)
+	if _, err := os.Stat(mapsDir); err != nil {
+		return fmt.Errorf("maps path %s in %s: %w", mp.Path, mp.redactedRepoURL(), err)
+	}
+
+	mp.Commit = commit
+	source := mp.Source()
+	maps := map[int][]*ReleaseNotesMap{}
+
+	if err := filepath.WalkDir(mapsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		notemaps, err := ParseReleaseNotesMap(path)
+		if err != nil {
+			rel, relErr := filepath.Rel(repoDir, path)
+			if relErr != nil {
+				rel = path
+			}
+
+			return fmt.Errorf("invalid note map %s at %s: %w", rel, commit, err)
+		}
+
+		for i := range *notemaps {
+			notemap := &(*notemaps)[i]
+			notemap.Source = source
+			maps[notemap.PR] = append(maps[notemap.PR], notemap)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("read maps: %w", err)
+	}
+
+	mp.Maps = maps
+
+	logrus.Infof(
+		"Successfully parsed release notes maps for %d PRs from %s",
+		len(mp.Maps), source,
+	)
+
+	return nil
+}
+
+// updateRepo clones the repository into the cache or fetches it if it
+// already exists, and returns the path to it.
+func (mp *GitMapProvider) updateRepo() (string, error) {
+	sum := sha256.Sum256([]byte(mp.RepoURL))
+	repoDir := filepath.Join(mp.CacheDir, hex.EncodeToString(sum[:]))
+
+	if _, err := os.Stat(filepath.Join(repoDir, ".git")); err == nil {
+		logrus.Infof("Fetching release notes maps repository %s", mp.redactedRepoURL())
+
+		if err := command.NewWithWorkDir(
+			repoDir, gitExecutable, "fetch", "--quiet", "--force", "--tags", "--prune", "origin",
+		).RunSilentSuccess(); err != nil {
+			return "", fmt.Errorf("fetch: %w", err)
+		}
+
+		return repoDir, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("check cached repository: %w", err)
+	}
+
+	logrus.Infof("Cloning release notes maps repository %s", mp.redactedRepoURL())
+
+	if err := os.MkdirAll(mp.CacheDir, os.FileMode(0o755)); err != nil {
+		return "", fmt.Errorf("create cache directory: %w", err)
+	}
+
+	if err := command.New(
+		gitExecutable, "clone", "--quiet", "--no-checkout", mp.RepoURL, repoDir,
+	).RunSilentSuccess(); err != nil {
+		return "", fmt.Errorf("clone: %w", err)
+	}
+
+	return repoDir, nil
+}
+
+// resolveRef returns the commit of the ref, by preferring remote branches
+// over tags and commits.
+func (mp *GitMapProvider) resolveRef(repoDir string) (string, error) {
+	candidates := []string{"refs/remotes/origin/HEAD"}
+	if mp.Ref != "" {
+		candidates = []string{
+			"refs/remotes/origin/" + mp.Ref,
+			"refs/tags/" + mp.Ref,
+			mp.Ref,
+		}
+	}
+
+	for _, candidate := range candidates {
+		res, err := command.NewWithWorkDir(
+			repoDir, gitExecutable, "rev-parse", "--verify", "--quiet", candidate+"^{commit}",
+		).RunSilent()
+		if err != nil {
+			return "", fmt.Errorf("rev-parse %s: %w", candidate, err)
+		}
+
+		if res.Success() {
+			return res.OutputTrimNL(), nil
+		}
+	}
+
+	return "", errors.New("ref not found in repository")
+}
@@ -29,8 +29,10 @@ func TestNewProviderFromInitString(t *testing.T) {
 	}{
 		{initString: "maps/testdata/applymap-unit-test/", returnsError: false},
 		{initString: "/this/shoud/not/really.exist/as/a/d33rect0ree", returnsError: true},
-		{initString: "gs://bucket-name/map/path/", returnsError: true},
+		{initString: "gs://bucket-name/map/path/", returnsError: false},
+		{initString: "https://example.com/maps.yaml", returnsError: false},
 		{initString: "github://kubernetes/sig-release/maps", returnsError: true},
+		{initString: "git+https://github.com/kubernetes/sig-release@master//maps", returnsError: false},
 	}
 	for _, testCase := range testCases {
 		provider, err := NewProviderFromInitString(testCase.initString)
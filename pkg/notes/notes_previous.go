@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-utils/util"
+)
+
+// versionFileNameRE matches the version in the file names of released JSON
+// notes, like `v1.30.1.json` or `release-notes-v1.30.1.json`.
+var versionFileNameRE = regexp.MustCompile(`v\d+\.\d+\.\d+(?:-[0-9A-Za-z.-]+)?$`)
+
+// VersionFromFileName returns the version of a released JSON notes file, like
+// `v1.30.1.json` or `release-notes-v1.30.1.json`. It returns false if the file
+// name does not contain a valid version.
+func VersionFromFileName(path string) (semver.Version, bool) {
+	match := versionFileNameRE.FindString(strings.TrimSuffix(filepath.Base(path), ".json"))
+	if match == "" {
+		return semver.Version{}, false
+	}
+
+	version, err := util.TagStringToSemver(match)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return version, true
+}
+
+// PreviousReleases are the versions which already published the note of a
+// PR, indexed by the origin PR number.
+type PreviousReleases map[int]semver.Version
+
+// LoadPreviousReleases reads the JSON release notes of previous releases from
+// a directory. The version of each release is taken from the file name. Only
+// releases of the same minor version as the target which are lower than the
+// target are considered, for example v1.30.0 and v1.30.1 for v1.30.2. If the
+// note of a PR got published multiple times, then the earliest version is
+// used.
+func LoadPreviousReleases(dir string, target semver.Version) (PreviousReleases, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("finding previous release notes: %w", err)
+	}
+
+	previous := PreviousReleases{}
+
+	for _, path := range paths {
+		version, ok := VersionFromFileName(path)
+		if !ok {
+			logrus.Debugf("Skipping previous release notes %s without version", path)
+
+			continue
+		}
+
+		if version.Major != target.Major || version.Minor != target.Minor || !version.LT(target) {
+			logrus.Debugf(
+				"Skipping release notes %s which are not a previous release of %s",
+				path, util.SemverToTagString(target),
+			)
+
+			continue
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading previous release notes: %w", err)
+		}
+
+		doc, err := DecodeJSONDocument(content)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshalling previous release notes %s: %w", path, err)
+		}
+
+		for _, note := range doc.notes() {
+			if note == nil || note.DoNotPublish {
+				continue
+			}
+
+			// Notes of commits without any PR cannot be matched
+			origin := note.originPR()
+			if origin == 0 {
+				continue
+			}
+
+			if existing, ok := previous[origin]; ok && existing.LTE(version) {
+				continue
+			}
+
+			previous[origin] = version
+		}
+	}
+
+	logrus.Infof("Loaded %d previously released notes from %s", len(previous), dir)
+
+	return previous, nil
+}
+
+// Apply annotates all release notes whose origin PR got already published in
+// a previous release. If suppress is true, then those notes will not be
+// published at all.
+func (p PreviousReleases) Apply(releaseNotes *ReleaseNotes, suppress bool) {
+	for _, note := range releaseNotes.All() {
+		// Notes might be listed multiple times in the history
+		if note.DoNotPublish || note.PreviouslyReleasedIn != "" {
+			continue
+		}
+
+		version, ok := p[note.originPR()]
+		if !ok {
+			continue
+		}
+
+		note.PreviouslyReleasedIn = util.SemverToTagString(version)
+
+		if suppress {
+			logrus.Infof(
+				"Suppressing note of PR #%d, which got previously released in %s",
+				note.PrNumber, note.PreviouslyReleasedIn,
+			)
+			note.DoNotPublish = true
+
+			continue
+		}
+
+		note.Markdown += fmt.Sprintf(" (previously released in %s)", note.PreviouslyReleasedIn)
+	}
+}
+
+// originPR returns the number of the origin PR for automated cherry-picks
+// and the number of the PR itself otherwise.
+func (r *ReleaseNote) originPR() int {
+	if r.OriginPrNumber != 0 {
+		return r.OriginPrNumber
+	}
+
+	return r.PrNumber
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notes
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	gogithub "github.com/google/go-github/v60/github"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/notes/options"
+)
+
+func writePreviousReleaseNotes(t *testing.T, dir, name string, doc any) {
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, os.FileMode(0o644)))
+}
+
+func TestLoadPreviousReleases(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	// Release notes including notes by commit and a feature gate report
+	writePreviousReleaseNotes(t, dir, "v1.30.2.json", &JSONDocument{
+		ReleaseNotes: ReleaseNotesByPR{
+			200: {PrNumber: 200, OriginPrNumber: 100},
+			201: {PrNumber: 201, OriginPrNumber: 101},
+		},
+		ReleaseNotesByCommit: ReleaseNotesByCommit{
+			"abc": {Commit: "abc", OriginPrNumber: 102},
+			"def": {Commit: "def"},
+		},
+		FeatureGates: &FeatureGateReport{Changes: []*FeatureGateChange{}},
+	})
+	writePreviousReleaseNotes(t, dir, "release-notes-v1.30.1.json", ReleaseNotesByPR{
+		190: {PrNumber: 190, OriginPrNumber: 100},
+		191: {PrNumber: 191, DoNotPublish: true, OriginPrNumber: 102},
+		192: {PrNumber: 192},
+	})
+	writePreviousReleaseNotes(t, dir, "v1.31.0-rc.1.json", ReleaseNotesByPR{
+		300: {PrNumber: 300},
+	})
+	writePreviousReleaseNotes(t, dir, "unversioned.json", ReleaseNotesByPR{
+		400: {PrNumber: 400},
+	})
+
+	// Other release lines are not considered
+	writePreviousReleaseNotes(t, dir, "v1.29.5.json", ReleaseNotesByPR{
+		500: {PrNumber: 500},
+	})
+
+	// The target release itself and newer ones are not considered
+	writePreviousReleaseNotes(t, dir, "v1.30.3.json", ReleaseNotesByPR{
+		600: {PrNumber: 600, OriginPrNumber: 101},
+	})
+	writePreviousReleaseNotes(t, dir, "v1.30.4.json", ReleaseNotesByPR{
+		601: {PrNumber: 601},
+	})
+
+	previous, err := LoadPreviousReleases(dir, semver.MustParse("1.30.3"))
+	require.NoError(t, err)
+	require.Equal(t, PreviousReleases{
+		100: semver.MustParse("1.30.1"),
+		101: semver.MustParse("1.30.2"),
+		102: semver.MustParse("1.30.2"),
+		192: semver.MustParse("1.30.1"),
+	}, previous)
+
+	previous, err = LoadPreviousReleases(dir, semver.MustParse("1.31.0"))
+	require.NoError(t, err)
+	require.Equal(t, PreviousReleases{300: semver.MustParse("1.31.0-rc.1")}, previous)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v1.30.0.json"), []byte("invalid"), os.FileMode(0o644)))
+	_, err = LoadPreviousReleases(dir, semver.MustParse("1.30.3"))
+	require.Error(t, err)
+}
+
+func TestPreviousReleasesApply(t *testing.T) {
+	t.Parallel()
+
+	previous := PreviousReleases{100: semver.MustParse("1.30.1")}
+
+	for _, suppress := range []bool{false, true} {
+		releaseNotes := NewReleaseNotes()
+		releaseNotes.Set(300, &ReleaseNote{PrNumber: 300, OriginPrNumber: 100, Markdown: "Fixed a bug. (#300, @user)"})
+		releaseNotes.Set(301, &ReleaseNote{PrNumber: 301, OriginPrNumber: 101, Markdown: "Fixed another bug. (#301, @user)"})
+		releaseNotes.Set(100, &ReleaseNote{PrNumber: 100, DoNotPublish: true})
+		releaseNotes.SetCommit("abc", &ReleaseNote{Commit: "abc", OriginPrNumber: 100, Markdown: "Fixed a bug. (abc)"})
+		releaseNotes.SetCommit("def", &ReleaseNote{Commit: "def", Markdown: "Fixed a crash. (def)"})
+
+		previous.Apply(releaseNotes, suppress)
+
+		require.Equal(t, "v1.30.1", releaseNotes.ByCommit()["abc"].PreviouslyReleasedIn)
+		require.Equal(t, suppress, releaseNotes.ByCommit()["abc"].DoNotPublish)
+		require.Empty(t, releaseNotes.ByCommit()["def"].PreviouslyReleasedIn)
+
+		cherryPick := releaseNotes.Get(300)
+		require.Equal(t, "v1.30.1", cherryPick.PreviouslyReleasedIn)
+		require.Equal(t, suppress, cherryPick.DoNotPublish)
+
+		if suppress {
+			require.Equal(t, "Fixed a bug. (#300, @user)", cherryPick.Markdown)
+		} else {
+			require.Equal(t, "Fixed a bug. (#300, @user) (previously released in v1.30.1)", cherryPick.Markdown)
+		}
+
+		require.Empty(t, releaseNotes.Get(301).PreviouslyReleasedIn)
+		require.False(t, releaseNotes.Get(301).DoNotPublish)
+		require.Empty(t, releaseNotes.Get(100).PreviouslyReleasedIn)
+	}
+}
+
+func TestReleaseNoteFromCommitOriginPR(t *testing.T) {
+	t.Parallel()
+
+	gatherer := &Gatherer{options: options.New()}
+
+	for _, tc := range []struct {
+		label    string
+		expected int
+	}{
+		{"k8s-infra-cherrypick-robot:cherry-pick-122-to-release-1.30", 122},
+		{"user:my-branch", 0},
+	} {
+		note, err := gatherer.ReleaseNoteFromCommit(&Result{
+			commit: &gogithub.RepositoryCommit{SHA: strPtr("abc")},
+			pullRequest: &gogithub.PullRequest{
+				Number: intPtr(200),
+				Body:   strPtr("```release-note\nFixed a bug.\n```"),
+				User:   &gogithub.User{Login: strPtr("user")},
+				Head:   &gogithub.PullRequestBranch{Label: strPtr(tc.label)},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, note.OriginPrNumber, tc.label)
+	}
+}
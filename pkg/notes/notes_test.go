@@ -24,6 +24,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-github/v60/github"
 	"github.com/stretchr/testify/require"
 
 	kgithub "sigs.k8s.io/release-sdk/github"
@@ -247,6 +248,29 @@ This reverts commit abcdef1234567890abcdef1234567890abcdef12.`,
 	}
 }
 
+func TestOriginPrNumber(t *testing.T) {
+	for _, tc := range []struct {
+		label, title string
+		expected     int
+	}{
+		{label: "k8s-infra-cherrypick-robot:cherry-pick-100-to-release-1.30", expected: 100},
+		{title: "Automated cherry pick of #101: Fix the thing", expected: 101},
+		{label: "user:fix", title: "Fix the thing (#102)", expected: 0},
+	} {
+		pr := &github.PullRequest{
+			Title: &tc.title,
+			Head:  &github.PullRequestBranch{Label: &tc.label},
+		}
+		require.Equal(t, tc.expected, originPrNumber(pr))
+	}
+
+	require.Equal(t, 103, originPrNumFromMessage(
+		"Merge pull request #200 from k8s-infra-cherrypick-robot/cherry-pick-103-to-release-1.30",
+	))
+	require.Equal(t, 104, originPrNumFromMessage("Automated cherry pick of #104: Fix (#201)"))
+	require.Zero(t, originPrNumFromMessage("Fix the thing (#202)"))
+}
+
 func TestPrettySIG(t *testing.T) {
 	cases := map[string]string{
 		"scheduling":        "Scheduling",
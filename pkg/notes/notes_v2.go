@@ -214,10 +214,55 @@ func (g *Gatherer) buildReleaseNote(pair *commitPrPair) (*ReleaseNote, error) {
 		DuplicateKind:  isDuplicateKind,
 		ActionRequired: labelExactMatch(pr, "release-note-action-required"),
 		DoNotPublish:   labelExactMatch(pr, "release-note-none"),
+		OriginPrNumber: originPrNumber(pr),
 	}, nil
 }
 
 func (g *Gatherer) listLeftParentCommits(opts *options.Options) ([]*commitPrPair, error) {
+	commits, err := leftParentCommits(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := []*commitPrPair{}
+
+	for _, commit := range commits {
+		hashString := commit.Hash.String()
+
+		// Find and collect PR number from commit message
+		prNums, err := prsNumForCommitFromMessage(commit.Message)
+		if errors.Is(err, errNoPRIDFoundInCommitMessage) {
+			logrus.WithFields(logrus.Fields{
+				"sha": hashString,
+			}).Debug("no associated PR found")
+
+			continue
+		}
+
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"sha": hashString,
+			}).Warnf("ignore err: %v", err)
+
+			continue
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"sha": hashString,
+			"prs": prNums,
+		}).Debug("found PR from commit")
+
+		// Only taking the first one, assuming they are merged by Prow
+		pairs = append(pairs, &commitPrPair{Commit: commit, PrNum: prNums[0]})
+	}
+
+	return pairs, nil
+}
+
+// leftParentCommits walks the local repository from opts.EndSHA along the
+// left parents until the merge base with opts.StartSHA and returns all visited
+// commits.
+func leftParentCommits(opts *options.Options) ([]*gitobject.Commit, error) {
 	localRepository, err := git.PlainOpen(opts.RepoPath)
 	if err != nil {
 		return nil, err
@@ -273,53 +318,21 @@ func (g *Gatherer) listLeftParentCommits(opts *options.Options) ([]*commitPrPair
 
 	currentTagHash := plumbing.NewHash(opts.EndSHA)
 
-	pairs := []*commitPrPair{}
+	commits := []*gitobject.Commit{}
 
 	hashPointer := currentTagHash
 	for hashPointer != stopHash {
-		hashString := hashPointer.String()
-
 		// Find and collect commit objects
 		commitPointer, err := localRepository.CommitObject(hashPointer)
 		if err != nil {
 			return nil, fmt.Errorf("finding CommitObject: %w", err)
 		}
 
-		// Find and collect PR number from commit message
-		prNums, err := prsNumForCommitFromMessage(commitPointer.Message)
-		if errors.Is(err, errNoPRIDFoundInCommitMessage) {
-			logrus.WithFields(logrus.Fields{
-				"sha": hashString,
-			}).Debug("no associated PR found")
-
-			// Advance pointer based on left parent
-			hashPointer = commitPointer.ParentHashes[0]
-
-			continue
-		}
-
-		if err != nil {
-			logrus.WithFields(logrus.Fields{
-				"sha": hashString,
-			}).Warnf("ignore err: %v", err)
-
-			// Advance pointer based on left parent
-			hashPointer = commitPointer.ParentHashes[0]
-
-			continue
-		}
-
-		logrus.WithFields(logrus.Fields{
-			"sha": hashString,
-			"prs": prNums,
-		}).Debug("found PR from commit")
-
-		// Only taking the first one, assuming they are merged by Prow
-		pairs = append(pairs, &commitPrPair{Commit: commitPointer, PrNum: prNums[0]})
+		commits = append(commits, commitPointer)
 
 		// Advance pointer based on left parent
 		hashPointer = commitPointer.ParentHashes[0]
 	}
 
-	return pairs, nil
+	return commits, nil
 }
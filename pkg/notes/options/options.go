@@ -21,12 +21,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/sirupsen/logrus"
 
 	"sigs.k8s.io/release-sdk/git"
 	"sigs.k8s.io/release-sdk/github"
+
+	"k8s.io/release/pkg/notes/forge"
 )
 
 // Options is the global options structure which can be used to build release
@@ -38,6 +41,14 @@ type Options struct {
 	// GithubUploadURL specifies the Github upload URL.
 	GithubUploadURL string
 
+	// Forge specifies the forge hosting the repository. Can be one of
+	// `github` (default), `gitlab` or `gitea`.
+	Forge string
+
+	// ForgeURL specifies the base URL of the forge if it is not GitHub.
+	// Defaults to the public instance of the forge.
+	ForgeURL string
+
 	// GithubOrg specifies the GitHub organization from which will be
 	// cloned/pulled if Pull is true.
 	GithubOrg string
@@ -75,8 +86,8 @@ type Options struct {
 	// release notes.
 	SkipFirstCommit bool
 
-	// Format specifies the format of the release notes. Can be either
-	// `json` or `markdown`.
+	// Format specifies the format of the release notes. Can be one of
+	// `json`, `markdown`, `keep-a-changelog`, `asciidoc` or `rst`.
 	Format string
 
 	// If the `Format` is `markdown`, then this specifies the selected go
@@ -115,6 +126,12 @@ type Options struct {
 	// EXPERIMENTAL: Feature flag for using v2 implementation to list commits
 	ListReleaseNotesV2 bool
 
+	// NoteSource specifies where the release notes are taken from. Can be
+	// `pull-request` (default) for the release-note block of the pull request
+	// body or `conventional-commits` for deriving the notes from the commit
+	// messages of the local repository in RepoPath.
+	NoteSource string
+
 	// RecordDir specifies the directory for API call recordings. Cannot be
 	// used together with ReplayDir.
 	RecordDir string
@@ -123,7 +140,31 @@ type Options struct {
 	// API. Cannot be used together with RecordDir.
 	ReplayDir string
 
+	// CacheDir specifies the directory for caching the release notes per
+	// commit between runs. The cache is disabled if empty and cannot be used
+	// together with RecordDir or ReplayDir.
+	CacheDir string
+
+	// EnhancementsDir is the path to a local checkout of the
+	// kubernetes/enhancements repository. If set, the KEP links of the
+	// release notes are enriched with the metadata of the kep.yaml files.
+	EnhancementsDir string
+
+	// PreviousReleaseNotesDir is the path to a directory containing the JSON
+	// release notes of previous releases, like `v1.30.1.json`. Only releases
+	// of the same minor version lower than the EndRev tag are considered.
+	// Notes whose origin PR got already published in one of them are handled
+	// according to PreviouslyReleased.
+	PreviousReleaseNotesDir string
+
+	// PreviouslyReleased specifies how to handle notes which got already
+	// published in a previous release. Can be `mark` (default) for adding a
+	// "previously released in" annotation or `suppress` for not publishing
+	// them at all.
+	PreviouslyReleased string
+
 	githubToken string
+	forgeToken  string
 	gitCloneFn  func(string, string, string, bool) (*git.Repo, error)
 
 	// MapProviders list of release notes map providers to query during generations
@@ -146,8 +187,37 @@ const (
 )
 
 const (
-	FormatJSON     = "json"
-	FormatMarkdown = "markdown"
+	// NoteSourcePullRequest takes the release notes from the pull request
+	// bodies.
+	NoteSourcePullRequest = "pull-request"
+
+	// NoteSourceConventionalCommits derives the release notes from commit
+	// messages following https://www.conventionalcommits.org.
+	NoteSourceConventionalCommits = "conventional-commits"
+)
+
+const (
+	PreviouslyReleasedMark     = "mark"
+	PreviouslyReleasedSuppress = "suppress"
+)
+
+// PreviouslyReleasedActions returns all supported actions for previously
+// released notes.
+func PreviouslyReleasedActions() []string {
+	return []string{PreviouslyReleasedMark, PreviouslyReleasedSuppress}
+}
+
+// NoteSources returns all supported release note sources.
+func NoteSources() []string {
+	return []string{NoteSourcePullRequest, NoteSourceConventionalCommits}
+}
+
+const (
+	FormatJSON           = "json"
+	FormatMarkdown       = "markdown"
+	FormatKeepAChangelog = "keep-a-changelog"
+	FormatAsciiDoc       = "asciidoc"
+	FormatRST            = "rst"
 
 	GoTemplatePrefix       = "go-template:"
 	GoTemplatePrefixInline = "inline:"
@@ -155,10 +225,20 @@ const (
 	GoTemplateInline       = GoTemplatePrefix + GoTemplatePrefixInline
 )
 
+// Formats returns all supported release notes output formats.
+func Formats() []string {
+	return []string{
+		FormatJSON, FormatMarkdown, FormatKeepAChangelog, FormatAsciiDoc, FormatRST,
+	}
+}
+
 // New creates a new Options instance with the default values.
 func New() *Options {
 	return &Options{
 		DiscoverMode:       RevisionDiscoveryModeNONE,
+		Forge:              forge.GitHub,
+		NoteSource:         NoteSourcePullRequest,
+		PreviouslyReleased: PreviouslyReleasedMark,
 		GithubOrg:          git.DefaultGithubOrg,
 		GithubRepo:         git.DefaultGithubRepo,
 		Format:             FormatMarkdown,
@@ -183,6 +263,33 @@ func (o *Options) ValidateAndFinish() (err error) {
 		return errors.New("please do not use record and replay together")
 	}
 
+	if o.CacheDir != "" && (o.ReplayDir != "" || o.RecordDir != "") {
+		return errors.New("please do not use the cache together with record or replay")
+	}
+
+	if o.EnhancementsDir != "" {
+		if _, err := os.Stat(filepath.Join(o.EnhancementsDir, "keps")); err != nil {
+			return fmt.Errorf("checking enhancements repository %s: %w", o.EnhancementsDir, err)
+		}
+	}
+
+	if o.PreviousReleaseNotesDir != "" {
+		if _, err := os.Stat(o.PreviousReleaseNotesDir); err != nil {
+			return fmt.Errorf("checking previous release notes directory %s: %w", o.PreviousReleaseNotesDir, err)
+		}
+	}
+
+	if o.PreviouslyReleased == "" {
+		o.PreviouslyReleased = PreviouslyReleasedMark
+	}
+
+	if !slices.Contains(PreviouslyReleasedActions(), o.PreviouslyReleased) {
+		return fmt.Errorf(
+			"invalid previously released action %q, must be one of: %s",
+			o.PreviouslyReleased, strings.Join(PreviouslyReleasedActions(), ", "),
+		)
+	}
+
 	// Recover for replay if needed
 	if o.ReplayDir != "" {
 		logrus.Info("Using replay mode")
@@ -190,17 +297,37 @@ func (o *Options) ValidateAndFinish() (err error) {
 		return nil
 	}
 
-	// The GitHub Token is required if replay is not specified
-	token, ok := os.LookupEnv(github.TokenEnvKey)
-	if ok {
-		o.githubToken = token
-	} else if o.ReplayDir == "" {
+	if o.Forge == "" {
+		o.Forge = forge.GitHub
+	}
+
+	if o.NoteSource == "" {
+		o.NoteSource = NoteSourcePullRequest
+	}
+
+	if !slices.Contains(NoteSources(), o.NoteSource) {
 		return fmt.Errorf(
-			"neither environment variable `%s` nor `replay` option is set",
-			github.TokenEnvKey,
+			"invalid note source %q, must be one of: %s",
+			o.NoteSource, strings.Join(NoteSources(), ", "),
 		)
 	}
 
+	if o.Forge == forge.GitHub {
+		// The GitHub Token is required if replay is not specified and the
+		// notes are not taken from the local repository
+		token, ok := os.LookupEnv(github.TokenEnvKey)
+		if ok {
+			o.githubToken = token
+		} else if o.ReplayDir == "" && o.NoteSource != NoteSourceConventionalCommits {
+			return fmt.Errorf(
+				"neither environment variable `%s` nor `replay` option is set",
+				github.TokenEnvKey,
+			)
+		}
+	} else if err := o.validateForge(); err != nil {
+		return err
+	}
+
 	// Set RepoPath to <tempdir>/<gh-org>-<gh-repo> if empty
 	if o.RepoPath == "" {
 		o.RepoPath = filepath.Join(os.TempDir(), fmt.Sprintf("%s-%s", o.GithubOrg, o.GithubRepo))
@@ -264,6 +391,18 @@ func (o *Options) ValidateAndFinish() (err error) {
 		}
 	}
 
+	// Conventional commits are read from the local repository, which has to
+	// be cloned or updated if it should be pulled
+	if o.NoteSource == NoteSourceConventionalCommits {
+		if o.CacheDir != "" {
+			logrus.Warn("The notes cache is not supported for conventional commits")
+		}
+
+		if _, err := o.repo(); err != nil {
+			return fmt.Errorf("preparing repository for conventional commits: %w", err)
+		}
+	}
+
 	// Create the record dir
 	if o.RecordDir != "" {
 		logrus.Info("Using record mode")
@@ -309,12 +448,61 @@ func (o *Options) checkFormatOptions() error {
 		}
 	}
 
-	if o.Format == FormatJSON && o.GoTemplate != GoTemplateDefault {
-		return errors.New("go-template cannot be defined when in JSON mode")
+	if !slices.Contains(Formats(), o.Format) {
+		return fmt.Errorf("invalid format: %s", o.Format)
 	}
 
-	if o.Format != FormatJSON && o.Format != FormatMarkdown {
-		return fmt.Errorf("invalid format: %s", o.Format)
+	if o.Format != FormatMarkdown && o.GoTemplate != GoTemplateDefault {
+		return fmt.Errorf("go-template cannot be defined when in %s mode", o.Format)
+	}
+
+	return nil
+}
+
+// validateForge verifies the options of forges other than GitHub.
+func (o *Options) validateForge() error {
+	tokenEnvKey := ""
+
+	switch o.Forge {
+	case forge.GitLab:
+		tokenEnvKey = forge.GitLabTokenEnvKey
+	case forge.Gitea:
+		tokenEnvKey = forge.GiteaTokenEnvKey
+	default:
+		return fmt.Errorf(
+			"invalid forge %q, must be one of: %s",
+			o.Forge, strings.Join(forge.Names(), ", "),
+		)
+	}
+
+	if o.RecordDir != "" {
+		return fmt.Errorf("record mode is not supported for forge %s", o.Forge)
+	}
+
+	if o.ListReleaseNotesV2 {
+		return fmt.Errorf("the v2 implementation is not supported for forge %s", o.Forge)
+	}
+
+	if o.ForgeURL == "" {
+		o.ForgeURL = forge.DefaultURL(o.Forge)
+	}
+
+	o.ForgeURL = strings.TrimSuffix(o.ForgeURL, "/")
+
+	// Public repositories can be accessed without a token
+	token, ok := os.LookupEnv(tokenEnvKey)
+	if ok {
+		o.forgeToken = token
+	} else {
+		logrus.Warnf(
+			"Environment variable `%s` is not set, accessing the %s API without authentication",
+			tokenEnvKey, o.Forge,
+		)
+	}
+
+	// Users are linked relative to the GitHub base URL
+	if o.GithubBaseURL == "" {
+		o.GithubBaseURL = o.ForgeURL + "/"
 	}
 
 	return nil
@@ -363,12 +551,21 @@ func (o *Options) resolveDiscoverMode() error {
 func (o *Options) repo() (repo *git.Repo, err error) {
 	if o.Pull {
 		logrus.Infof("Cloning/updating repository %s/%s", o.GithubOrg, o.GithubRepo)
-		repo, err = o.gitCloneFn(
-			o.RepoPath,
-			o.GithubOrg,
-			o.GithubRepo,
-			false,
-		)
+
+		if o.Forge != forge.GitHub && o.Forge != "" {
+			repo, err = git.CloneOrOpenRepo(
+				o.RepoPath,
+				fmt.Sprintf("%s/%s/%s.git", o.ForgeURL, o.GithubOrg, o.GithubRepo),
+				false, true, nil,
+			)
+		} else {
+			repo, err = o.gitCloneFn(
+				o.RepoPath,
+				o.GithubOrg,
+				o.GithubRepo,
+				false,
+			)
+		}
 	} else {
 		logrus.Infof("Re-using local repo %s", o.RepoPath)
 		repo, err = git.OpenRepo(o.RepoPath)
@@ -411,3 +608,19 @@ func (o *Options) Client() (github.Client, error) {
 
 	return gh.Client(), nil
 }
+
+// ForgeClient returns the client to be used by the Gatherer for the
+// configured forge. The GitHub forge uses the client returned by `Client`,
+// while GitLab and Gitea use their REST API directly.
+func (o *Options) ForgeClient() (forge.Client, error) {
+	switch o.Forge {
+	case forge.GitHub, "":
+		return o.Client()
+	case forge.GitLab:
+		return forge.NewGitLab(o.ForgeURL, o.forgeToken), nil
+	case forge.Gitea:
+		return forge.NewGitea(o.ForgeURL, o.forgeToken), nil
+	default:
+		return nil, fmt.Errorf("unsupported forge: %s", o.Forge)
+	}
+}
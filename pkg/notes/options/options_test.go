@@ -32,6 +32,8 @@ import (
 	kgit "sigs.k8s.io/release-sdk/git"
 	"sigs.k8s.io/release-sdk/github"
 	"sigs.k8s.io/release-utils/command"
+
+	"k8s.io/release/pkg/notes/forge"
 )
 
 type testOptions struct {
@@ -395,6 +397,152 @@ func TestValidateAndFinishFailureFormat(t *testing.T) {
 	require.Error(t, options.ValidateAndFinish())
 }
 
+func TestValidateAndFinishFailureCacheWithReplay(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.CacheDir = t.TempDir()
+	options.ReplayDir = t.TempDir()
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishSuccessForge(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Forge = forge.GitLab
+	t.Setenv(forge.GitLabTokenEnvKey, "token")
+
+	// When
+	require.NoError(t, options.ValidateAndFinish())
+
+	// Then
+	require.Equal(t, forge.DefaultGitLabURL, options.ForgeURL)
+	require.Equal(t, forge.DefaultGitLabURL+"/", options.GithubBaseURL)
+	require.Equal(t, "token", options.forgeToken)
+
+	client, err := options.ForgeClient()
+	require.NoError(t, err)
+	require.IsType(t, &forge.GitLabClient{}, client)
+}
+
+func TestValidateAndFinishFailureForge(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Forge = "invalid"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishFailureForgeWithRecord(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Forge = forge.Gitea
+	options.RecordDir = t.TempDir()
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishEnhancementsDir(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.EnhancementsDir = t.TempDir()
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+
+	// Given
+	require.NoError(t, os.Mkdir(filepath.Join(options.EnhancementsDir, "keps"), os.FileMode(0o755)))
+
+	// When
+	require.NoError(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishPreviousReleaseNotes(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.PreviousReleaseNotesDir = filepath.Join(t.TempDir(), "missing")
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+
+	// Given
+	options.PreviousReleaseNotesDir = t.TempDir()
+	options.PreviouslyReleased = "invalid"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+
+	// Given
+	options.PreviouslyReleased = ""
+
+	// When
+	require.NoError(t, options.ValidateAndFinish())
+	require.Equal(t, PreviouslyReleasedMark, options.PreviouslyReleased)
+}
+
+func TestValidateAndFinishSuccessNoteSourceWithoutToken(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	require.NoError(t, os.Unsetenv(github.TokenEnvKey))
+	options.NoteSource = NoteSourceConventionalCommits
+
+	// When
+	require.NoError(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishFailureNoteSource(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.NoteSource = "invalid"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
+func TestValidateAndFinishSuccessFormats(t *testing.T) {
+	for _, format := range Formats() {
+		options := newTestOptions(t)
+
+		// Given
+		options.Format = format
+
+		// When
+		require.NoError(t, options.ValidateAndFinish(), format)
+		options.testRepo.cleanup(t)
+	}
+}
+
+func TestValidateAndFinishFailureFormatGoTemplate(t *testing.T) {
+	options := newTestOptions(t)
+	defer options.testRepo.cleanup(t)
+
+	// Given
+	options.Format = FormatRST
+	options.GoTemplate = GoTemplateInline + "{{.}}"
+
+	// When
+	require.Error(t, options.ValidateAndFinish())
+}
+
 func TestValidateAndFinishFailureGoTemplate(t *testing.T) {
 	options := newTestOptions(t)
 	defer options.testRepo.cleanup(t)
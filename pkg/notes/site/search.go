@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package site
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minTermLength is the minimum length of a term to be part of the search
+// index.
+const minTermLength = 2
+
+// SearchIndex is the prebuilt search index of all release notes, which is
+// used by the client side search.
+type SearchIndex struct {
+	// Documents are all indexed notes.
+	Documents []*SearchDocument `json:"documents"`
+
+	// Terms maps the lower case terms to the indices of the documents
+	// containing them.
+	Terms map[string][]int `json:"terms"`
+}
+
+// SearchDocument is a single note of the search index.
+type SearchDocument struct {
+	Version        string   `json:"version"`
+	PrNumber       int      `json:"pr,omitempty"`
+	URL            string   `json:"url"`
+	Text           string   `json:"text"`
+	SIGs           []string `json:"sigs,omitempty"`
+	Kinds          []string `json:"kinds,omitempty"`
+	Areas          []string `json:"areas,omitempty"`
+	ActionRequired bool     `json:"action_required,omitempty"`
+}
+
+// BuildSearchIndex creates the search index for the releases. The terms are
+// taken from the note text, the PR number or commit and the labels.
+func BuildSearchIndex(releases []*Release) *SearchIndex {
+	index := &SearchIndex{
+		Documents: []*SearchDocument{},
+		Terms:     map[string][]int{},
+	}
+
+	for _, release := range releases {
+		for _, note := range release.Notes {
+			id := len(index.Documents)
+			index.Documents = append(index.Documents, &SearchDocument{
+				Version:        release.Version,
+				PrNumber:       note.PrNumber,
+				URL:            release.Page() + "#" + note.Anchor(),
+				Text:           note.Text,
+				SIGs:           note.SIGs,
+				Kinds:          note.Kinds,
+				Areas:          note.Areas,
+				ActionRequired: note.ActionRequired,
+			})
+
+			fields := []string{note.Text, note.Reference()}
+			fields = append(fields, note.SIGs...)
+			fields = append(fields, note.Kinds...)
+			fields = append(fields, note.Areas...)
+
+			seen := map[string]bool{}
+
+			for _, term := range Terms(strings.Join(fields, " ")) {
+				if seen[term] {
+					continue
+				}
+
+				seen[term] = true
+				index.Terms[term] = append(index.Terms[term], id)
+			}
+		}
+	}
+
+	return index
+}
+
+// Terms splits a text into lower case terms, which contain only letters and
+// digits. Terms shorter than two characters are ignored.
+func Terms(text string) []string {
+	terms := []string{}
+
+	for _, term := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(term) >= minTermLength {
+			terms = append(terms, term)
+		}
+	}
+
+	return terms
+}
@@ -0,0 +1,369 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package site
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+
+	"sigs.k8s.io/release-utils/util"
+
+	"k8s.io/release/pkg/notes"
+)
+
+const (
+	// DefaultTitle is the default title of the site.
+	DefaultTitle = "Kubernetes Release Notes"
+
+	// SearchIndexFile is the name of the prebuilt search index file.
+	SearchIndexFile = "search-index.json"
+
+	// ReleasesDir is the directory of the per release pages.
+	ReleasesDir = "releases"
+
+	// StaticDir is the directory of the style sheet and scripts.
+	StaticDir = "static"
+)
+
+//go:embed templates/*.html.tmpl static/*
+var assets embed.FS
+
+// Options are the settings for generating the site.
+type Options struct {
+	// NotesDir is the directory containing the JSON release notes of all
+	// versions, named by their version like `v1.30.1.json`.
+	NotesDir string
+
+	// OutputDir is the directory where the site will be written.
+	OutputDir string
+
+	// Title is the title of the site.
+	Title string
+}
+
+// DefaultOptions returns the default site options.
+func DefaultOptions() *Options {
+	return &Options{Title: DefaultTitle}
+}
+
+// Release contains the published notes of a single version.
+type Release struct {
+	// Version is the version tag, like `v1.30.1`.
+	Version string
+
+	// Notes are the published notes sorted by PR number.
+	Notes []*Note
+
+	// SIGs, Kinds and Areas are the unique labels of all notes, which are
+	// used for filtering.
+	SIGs, Kinds, Areas []string
+
+	semver semver.Version
+}
+
+// ActionRequired returns all notes of the release which require an action.
+func (r *Release) ActionRequired() []*Note {
+	res := []*Note{}
+
+	for _, note := range r.Notes {
+		if note.ActionRequired {
+			res = append(res, note)
+		}
+	}
+
+	return res
+}
+
+// Page returns the path of the release page relative to the site root.
+func (r *Release) Page() string {
+	return path.Join(ReleasesDir, r.Version+".html")
+}
+
+// Note is a single published release note.
+type Note struct {
+	PrNumber       int
+	PrURL          string
+	Commit         string
+	Text           string
+	HTML           template.HTML
+	SIGs           []string
+	Kinds          []string
+	Areas          []string
+	ActionRequired bool
+}
+
+// Anchor returns the HTML anchor of the note on the release page.
+func (n *Note) Anchor() string {
+	if n.PrNumber == 0 {
+		return "commit-" + n.Commit
+	}
+
+	return fmt.Sprintf("pr-%d", n.PrNumber)
+}
+
+// Reference returns the PR number or the abbreviated commit SHA for notes
+// without PR.
+func (n *Note) Reference() string {
+	if n.PrNumber == 0 {
+		return n.Commit[:min(len(n.Commit), 7)]
+	}
+
+	return fmt.Sprintf("#%d", n.PrNumber)
+}
+
+// Site generates a static release notes site.
+type Site struct {
+	options *Options
+}
+
+// New creates a new site generator.
+func New(opts *Options) *Site {
+	return &Site{options: opts}
+}
+
+// Generate loads the release notes and writes the site to the output
+// directory.
+func (s *Site) Generate() error {
+	if s.options.OutputDir == "" {
+		return errors.New("no output directory provided")
+	}
+
+	releases, err := LoadReleases(s.options.NotesDir)
+	if err != nil {
+		return fmt.Errorf("loading releases: %w", err)
+	}
+
+	if len(releases) == 0 {
+		return fmt.Errorf("no release notes found in %s", s.options.NotesDir)
+	}
+
+	tpl, err := template.New("site").Funcs(template.FuncMap{
+		"join": strings.Join,
+		"dict": dict,
+	}).ParseFS(assets, "templates/*.html.tmpl")
+	if err != nil {
+		return fmt.Errorf("parsing templates: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(s.options.OutputDir, ReleasesDir), os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	if err := s.render(tpl, "index.html.tmpl", "index.html", map[string]any{
+		"Title":    s.options.Title,
+		"Root":     ".",
+		"Releases": releases,
+	}); err != nil {
+		return err
+	}
+
+	for _, release := range releases {
+		if err := s.render(tpl, "release.html.tmpl", release.Page(), map[string]any{
+			"Title":   s.options.Title,
+			"Root":    "..",
+			"Release": release,
+		}); err != nil {
+			return err
+		}
+	}
+
+	index, err := json.Marshal(BuildSearchIndex(releases))
+	if err != nil {
+		return fmt.Errorf("marshalling search index: %w", err)
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(s.options.OutputDir, SearchIndexFile), index, os.FileMode(0o644),
+	); err != nil {
+		return fmt.Errorf("writing search index: %w", err)
+	}
+
+	static, err := fs.Sub(assets, StaticDir)
+	if err != nil {
+		return fmt.Errorf("getting static assets: %w", err)
+	}
+
+	staticDir := filepath.Join(s.options.OutputDir, StaticDir)
+	if err := os.RemoveAll(staticDir); err != nil {
+		return fmt.Errorf("removing static assets: %w", err)
+	}
+
+	if err := os.CopyFS(staticDir, static); err != nil {
+		return fmt.Errorf("writing static assets: %w", err)
+	}
+
+	logrus.Infof("Wrote site for %d releases to %s", len(releases), s.options.OutputDir)
+
+	return nil
+}
+
+// render executes a template and writes the result to the output directory.
+func (s *Site) render(tpl *template.Template, name, target string, data any) error {
+	buf := &bytes.Buffer{}
+	if err := tpl.ExecuteTemplate(buf, name, data); err != nil {
+		return fmt.Errorf("executing template %s: %w", name, err)
+	}
+
+	if err := os.WriteFile(
+		filepath.Join(s.options.OutputDir, filepath.FromSlash(target)), buf.Bytes(), os.FileMode(0o644),
+	); err != nil {
+		return fmt.Errorf("writing %s: %w", target, err)
+	}
+
+	return nil
+}
+
+// LoadReleases reads the JSON release notes of all versions from a
+// directory. The version of each file is taken from its name and files
+// without a version are skipped. The releases are sorted from the latest to
+// the oldest version.
+func LoadReleases(dir string) ([]*Release, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("finding release notes: %w", err)
+	}
+
+	markdown := goldmark.New(goldmark.WithExtensions(extension.GFM))
+	releases := []*Release{}
+
+	for _, p := range paths {
+		version, ok := notes.VersionFromFileName(p)
+		if !ok {
+			logrus.Debugf("Skipping release notes %s without version", p)
+
+			continue
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return nil, fmt.Errorf("reading release notes: %w", err)
+		}
+
+		doc, err := notes.DecodeJSONDocument(content)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshalling release notes %s: %w", p, err)
+		}
+
+		release := &Release{
+			Version: util.SemverToTagString(version),
+			Notes:   []*Note{},
+			semver:  version,
+		}
+
+		releaseNotes := []*notes.ReleaseNote{}
+		for _, pr := range slices.Sorted(maps.Keys(doc.ReleaseNotes)) {
+			releaseNotes = append(releaseNotes, doc.ReleaseNotes[pr])
+		}
+
+		for _, sha := range slices.Sorted(maps.Keys(doc.ReleaseNotesByCommit)) {
+			releaseNotes = append(releaseNotes, doc.ReleaseNotesByCommit[sha])
+		}
+
+		for _, releaseNote := range releaseNotes {
+			if releaseNote == nil || releaseNote.DoNotPublish {
+				continue
+			}
+
+			text := releaseNote.Markdown
+			if text == "" {
+				text = releaseNote.Text
+			}
+
+			note := &Note{
+				PrNumber:       releaseNote.PrNumber,
+				PrURL:          releaseNote.PrURL,
+				Commit:         releaseNote.Commit,
+				Text:           releaseNote.Text,
+				SIGs:           releaseNote.SIGs,
+				Kinds:          releaseNote.Kinds,
+				Areas:          releaseNote.Areas,
+				ActionRequired: releaseNote.ActionRequired,
+			}
+
+			html := &bytes.Buffer{}
+			if err := markdown.Convert([]byte(text), html); err != nil {
+				return nil, fmt.Errorf("rendering note %s: %w", note.Reference(), err)
+			}
+
+			note.HTML = template.HTML(html.String()) //nolint:gosec // goldmark omits raw HTML
+			release.Notes = append(release.Notes, note)
+
+			release.SIGs = appendUnique(release.SIGs, releaseNote.SIGs...)
+			release.Kinds = appendUnique(release.Kinds, releaseNote.Kinds...)
+			release.Areas = appendUnique(release.Areas, releaseNote.Areas...)
+		}
+
+		slices.Sort(release.SIGs)
+		slices.Sort(release.Kinds)
+		slices.Sort(release.Areas)
+
+		releases = append(releases, release)
+	}
+
+	slices.SortFunc(releases, func(a, b *Release) int {
+		return b.semver.Compare(a.semver)
+	})
+
+	return releases, nil
+}
+
+// dict creates a map from key value pairs, which is used to pass multiple
+// values to a template.
+func dict(values ...any) (map[string]any, error) {
+	if len(values)%2 != 0 {
+		return nil, errors.New("odd number of dict values")
+	}
+
+	res := map[string]any{}
+
+	for i := 0; i < len(values); i += 2 {
+		key, ok := values[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key %v is not a string", values[i])
+		}
+
+		res[key] = values[i+1]
+	}
+
+	return res, nil
+}
+
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
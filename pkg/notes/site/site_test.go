@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package site
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadReleases(t *testing.T) {
+	t.Parallel()
+
+	releases, err := LoadReleases("testdata")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+
+	require.Equal(t, "v1.30.1", releases[0].Version)
+	require.Equal(t, "releases/v1.30.1.html", releases[0].Page())
+	require.Len(t, releases[0].Notes, 2)
+	require.Empty(t, releases[0].ActionRequired())
+	require.Equal(t, "#200", releases[0].Notes[0].Reference())
+
+	// Notes of commits without PR are referenced by their commit
+	require.Equal(t, "5555555", releases[0].Notes[1].Reference())
+	require.Equal(t, "commit-5555555555555555555555555555555555555555", releases[0].Notes[1].Anchor())
+
+	release := releases[1]
+	require.Equal(t, "v1.30.0", release.Version)
+	require.Len(t, release.Notes, 2)
+	require.Equal(t, []string{"node"}, release.SIGs)
+	require.Equal(t, []string{"cleanup", "feature"}, release.Kinds)
+	require.Equal(t, []string{"kubelet"}, release.Areas)
+	require.Equal(t, []*Note{release.Notes[0]}, release.ActionRequired())
+
+	// Raw HTML of the notes is omitted
+	require.NotContains(t, string(release.Notes[1].HTML), "<swap>")
+	require.Contains(t, string(release.Notes[1].HTML), `<a href="https://github.com/kubernetes/kubernetes/pull/101">#101</a>`)
+}
+
+func TestBuildSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	releases, err := LoadReleases("testdata")
+	require.NoError(t, err)
+
+	index := BuildSearchIndex(releases)
+	require.Len(t, index.Documents, 4)
+	require.Equal(t, &SearchDocument{
+		Version:  "v1.30.1",
+		PrNumber: 200,
+		URL:      "releases/v1.30.1.html#pr-200",
+		Text:     "Fixed a kubectl panic when the server is unavailable.",
+		SIGs:     []string{"cli"},
+		Kinds:    []string{"bug"},
+	}, index.Documents[0])
+
+	require.Equal(t, "releases/v1.30.1.html#commit-5555555555555555555555555555555555555555", index.Documents[1].URL)
+	require.Zero(t, index.Documents[1].PrNumber)
+
+	require.Equal(t, []int{0, 1}, index.Terms["kubectl"])
+	require.Equal(t, []int{2, 3}, index.Terms["node"])
+	require.Equal(t, []int{3}, index.Terms["101"])
+	require.Equal(t, []int{1}, index.Terms["5555555"])
+	require.NotContains(t, index.Terms, "a")
+}
+
+func TestTerms(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		[]string{"removed", "the", "deprecated", "foo", "flag", "use", "bar"},
+		Terms("Removed the deprecated `--foo` flag, use `--bar` a."),
+	)
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	opts := DefaultOptions()
+	opts.NotesDir = "testdata"
+	opts.OutputDir = t.TempDir()
+	require.NoError(t, New(opts).Generate())
+
+	for _, file := range []string{
+		"index.html",
+		"releases/v1.30.0.html",
+		"releases/v1.30.1.html",
+		"static/style.css",
+		"static/site.js",
+		SearchIndexFile,
+	} {
+		require.FileExists(t, filepath.Join(opts.OutputDir, file))
+	}
+
+	index, err := os.ReadFile(filepath.Join(opts.OutputDir, "index.html"))
+	require.NoError(t, err)
+	require.Contains(t, string(index), `<title>Kubernetes Release Notes</title>`)
+	require.Contains(t, string(index), `<a href="releases/v1.30.0.html">v1.30.0</a>`)
+	require.Contains(t, string(index), `<link rel="stylesheet" href="./static/style.css" />`)
+
+	page, err := os.ReadFile(filepath.Join(opts.OutputDir, "releases/v1.30.0.html"))
+	require.NoError(t, err)
+	require.Contains(t, string(page), `<title>v1.30.0 - Kubernetes Release Notes</title>`)
+	require.Contains(t, string(page), `<body data-root="..">`)
+	require.Contains(t, string(page), `<h2>Urgent Upgrade Notes</h2>`)
+	require.Contains(t, string(page), `<li class="note action-required" id="pr-100"`)
+	require.Contains(t, string(page), `data-sigs="node" data-kinds="feature" data-areas="kubelet"`)
+	require.Contains(t, string(page), `<option>cleanup</option>`)
+	require.NotContains(t, string(page), "Not published.")
+
+	page, err = os.ReadFile(filepath.Join(opts.OutputDir, "releases/v1.30.1.html"))
+	require.NoError(t, err)
+	require.NotContains(t, string(page), `Urgent Upgrade Notes`)
+
+	searchIndex, err := os.ReadFile(filepath.Join(opts.OutputDir, SearchIndexFile))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(searchIndex, &SearchIndex{}))
+
+	// Missing release notes or output
+	opts.NotesDir = t.TempDir()
+	require.Error(t, New(opts).Generate())
+
+	opts.NotesDir = "testdata"
+	opts.OutputDir = ""
+	require.Error(t, New(opts).Generate())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+(function () {
+  "use strict";
+
+  const root = document.body.dataset.root || ".";
+  const maxResults = 50;
+
+  // Filter the notes of a release page by SIG, kind, area and action required.
+  function applyFilters() {
+    const selects = document.querySelectorAll("select[data-filter]");
+    const actionRequired = document.getElementById("filter-action-required");
+
+    document.querySelectorAll("#notes > li.note").forEach((note) => {
+      let visible = !actionRequired || !actionRequired.checked ||
+        note.classList.contains("action-required");
+
+      selects.forEach((select) => {
+        const values = (note.dataset[select.dataset.filter] || "").split(" ");
+        if (select.value !== "" && !values.includes(select.value)) {
+          visible = false;
+        }
+      });
+
+      note.hidden = !visible;
+    });
+  }
+
+  // Split a text into lower case terms like the site generator does.
+  function terms(text) {
+    return text.toLowerCase().split(/[^\p{L}\p{N}]+/u).filter((t) => t.length >= 2);
+  }
+
+  // Search the prebuilt index, where every query term has to match the
+  // prefix of an indexed term.
+  function search(index, query) {
+    let result = null;
+
+    for (const term of terms(query)) {
+      const matches = new Set();
+
+      for (const [indexed, ids] of Object.entries(index.terms)) {
+        if (indexed.startsWith(term)) {
+          ids.forEach((id) => matches.add(id));
+        }
+      }
+
+      result = result === null ? matches : new Set([...result].filter((id) => matches.has(id)));
+    }
+
+    return result === null ? [] : [...result].sort((a, b) => a - b);
+  }
+
+  function renderResults(index, ids) {
+    const results = document.getElementById("search-results");
+    results.replaceChildren();
+    results.hidden = ids === null;
+
+    if (ids === null) {
+      return;
+    }
+
+    if (ids.length === 0) {
+      const item = document.createElement("li");
+      item.textContent = "No results";
+      results.appendChild(item);
+
+      return;
+    }
+
+    ids.slice(0, maxResults).forEach((id) => {
+      const doc = index.documents[id];
+      const item = document.createElement("li");
+      const version = document.createElement("span");
+      const link = document.createElement("a");
+
+      version.className = "version";
+      version.textContent = doc.version + " ";
+      link.href = root + "/" + doc.url;
+      link.textContent = doc.text;
+
+      item.append(version, link);
+      results.appendChild(item);
+    });
+  }
+
+  document.querySelectorAll(".filters select, .filters input").forEach((input) => {
+    input.addEventListener("change", applyFilters);
+  });
+
+  const searchInput = document.getElementById("search");
+  let indexPromise = null;
+
+  searchInput.addEventListener("input", () => {
+    if (indexPromise === null) {
+      indexPromise = fetch(root + "/search-index.json").then((res) => res.json());
+    }
+
+    indexPromise.then((index) => {
+      const query = searchInput.value.trim();
+      renderResults(index, query === "" ? null : search(index, query));
+    });
+  });
+})();
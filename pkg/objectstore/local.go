@@ -0,0 +1,480 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/object"
+)
+
+// FilePrefix is the URL prefix for object store paths on the local file
+// system, for example `file:///tmp/bucket`.
+const FilePrefix = "file://"
+
+// filePrefixJoined is the prefix of a `FilePrefix` path after it went through
+// `filepath.Join()`, which collapses the double slash.
+const filePrefixJoined = "file:/"
+
+// IsLocal returns true if the provided path or bucket refers to the local
+// file system backend.
+func IsLocal(path string) bool {
+	return strings.HasPrefix(path, filePrefixJoined)
+}
+
+// Path converts a `file://` object store path into a local file system path.
+// Other paths are returned unchanged.
+func Path(path string) string {
+	if !IsLocal(path) {
+		return path
+	}
+
+	return localPath(path)
+}
+
+// localPath strips an optional `file:` prefix and returns the cleaned
+// absolute path.
+func localPath(path string) string {
+	return filepath.Clean("/" + strings.TrimLeft(strings.TrimPrefix(path, "file:"), "/"))
+}
+
+// Local is an `object.Store` which uses a directory on the local file system
+// as bucket. It mirrors the copy semantics of `gsutil` to be usable as drop-in
+// replacement for GCS in hermetic mock runs.
+type Local struct {
+	noClobber    bool
+	allowMissing bool
+}
+
+// NewLocal creates a new `Local` object store with the same defaults as
+// `object.NewGCS()`.
+func NewLocal() *Local {
+	return &Local{
+		noClobber:    true,
+		allowMissing: true,
+	}
+}
+
+func (l *Local) SetOptions(opts ...object.OptFn) {
+	for _, f := range opts {
+		f(l)
+	}
+}
+
+// WithConcurrent is a no-op and only exists for compatibility with GCS.
+func (l *Local) WithConcurrent(bool) object.OptFn {
+	return func(object.Store) {}
+}
+
+// WithRecursive is a no-op, because copies are always recursive.
+func (l *Local) WithRecursive(bool) object.OptFn {
+	return func(object.Store) {}
+}
+
+func (l *Local) WithNoClobber(noClobber bool) object.OptFn {
+	return func(object.Store) {
+		l.noClobber = noClobber
+	}
+}
+
+func (l *Local) WithAllowMissing(allowMissing bool) object.OptFn {
+	return func(object.Store) {
+		l.allowMissing = allowMissing
+	}
+}
+
+func (l *Local) NoClobber() bool {
+	return l.noClobber
+}
+
+func (l *Local) AllowMissing() bool {
+	return l.allowMissing
+}
+
+// NormalizePath joins the path parts and ensures that the result is an
+// absolute path prefixed with `FilePrefix`.
+func (l *Local) NormalizePath(pathParts ...string) (string, error) {
+	switch len(pathParts) {
+	case 0:
+		return "", errors.New("must contain at least one path part")
+	case 1:
+		if pathParts[0] == "" {
+			return "", errors.New("path should not be an empty string")
+		}
+	}
+
+	for i, part := range pathParts {
+		if i > 0 && IsLocal(part) {
+			return "", fmt.Errorf(
+				"one of the path parts contained a `%s`, which may suggest a filepath.Join() error in the caller",
+				filePrefixJoined,
+			)
+		}
+	}
+
+	joined := filepath.Join(pathParts...)
+	if joined == "" || joined == "." {
+		return "", errors.New("all paths provided were empty")
+	}
+
+	return FilePrefix + localPath(joined), nil
+}
+
+// IsPathNormalized determines if a path is prefixed with `file:///`.
+func (l *Local) IsPathNormalized(path string) bool {
+	return strings.HasPrefix(path, FilePrefix+"/")
+}
+
+// PathExists returns true if the specified path exists.
+func (l *Local) PathExists(path string) (bool, error) {
+	if !l.IsPathNormalized(path) {
+		return false, fmt.Errorf("path %s does not begin with `%s`", path, FilePrefix)
+	}
+
+	if _, err := os.Stat(Path(path)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("stat %s: %w", path, err)
+	}
+
+	logrus.Infof("Found %s", path)
+
+	return true, nil
+}
+
+// CopyToRemote copies a local file or directory to the specified bucket path.
+func (l *Local) CopyToRemote(src, remote string) error {
+	logrus.Infof("Copying %s to local bucket (%s)", src, remote)
+
+	remote, err := l.NormalizePath(remote)
+	if err != nil {
+		return fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	if _, err := os.Stat(src); err != nil {
+		if l.allowMissing {
+			logrus.Infof("Source directory (%s) does not exist. Skipping upload.", src)
+
+			return nil
+		}
+
+		return errors.New("source directory does not exist")
+	}
+
+	return l.copy(src, Path(remote))
+}
+
+// CopyToLocal copies a bucket path to the specified local file or directory.
+func (l *Local) CopyToLocal(remote, dst string) error {
+	logrus.Infof("Copying local bucket (%s) to %s", remote, dst)
+
+	remote, err := l.NormalizePath(remote)
+	if err != nil {
+		return fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	return l.copy(Path(remote), dst)
+}
+
+// CopyBucketToBucket copies between two bucket paths.
+func (l *Local) CopyBucketToBucket(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	src, err := l.NormalizePath(src)
+	if err != nil {
+		return fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	dst, err = l.NormalizePath(dst)
+	if err != nil {
+		return fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	return l.copy(Path(src), Path(dst))
+}
+
+// RsyncRecursive synchronizes the contents of the `src` directory into `dst`.
+// Both paths can either be bucket paths or plain local directories. Like
+// `gsutil rsync`, files which only exist in `dst` are kept.
+func (l *Local) RsyncRecursive(src, dst string) error {
+	src, dst = Path(src), Path(dst)
+	logrus.Infof("Syncing %s to %s", src, dst)
+
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return fmt.Errorf("get relative path of %s: %w", path, err)
+		}
+
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, os.FileMode(0o755))
+		}
+
+		return copyFile(path, target)
+	})
+}
+
+// GetReleasePath returns a bucket path to retrieve builds from or push builds
+// to.
+//
+// Expected destination format:
+//
+//	file:///<bucket>/<gcsRoot>[/fast][/<version>]
+func (l *Local) GetReleasePath(
+	bucket, gcsRoot, version string, fast bool,
+) (string, error) {
+	parts, err := releasePathParts(bucket, gcsRoot, version, fast)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := l.NormalizePath(parts...)
+	if err != nil {
+		return "", fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	logrus.Infof("Release path is %s", path)
+
+	return path, nil
+}
+
+// GetMarkerPath returns a bucket path where version markers should be stored.
+//
+// Expected destination format:
+//
+//	file:///<bucket>/<gcsRoot>[/fast]
+func (l *Local) GetMarkerPath(
+	bucket, gcsRoot string, fast bool,
+) (string, error) {
+	parts, err := releasePathParts(bucket, gcsRoot, "", fast)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := l.NormalizePath(parts...)
+	if err != nil {
+		return "", fmt.Errorf("normalize local bucket path: %w", err)
+	}
+
+	logrus.Infof("Version marker path is %s", path)
+
+	return path, nil
+}
+
+// CheckBucket verifies that the bucket directory exists and is writable.
+func (l *Local) CheckBucket(bucket string) error {
+	dir := Path(bucket)
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("stat local bucket %s: %w", dir, err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("local bucket %s is not a directory", dir)
+	}
+
+	f, err := os.CreateTemp(dir, ".write-check-")
+	if err != nil {
+		return fmt.Errorf("local bucket %s is not writable: %w", dir, err)
+	}
+
+	f.Close()
+
+	return os.Remove(f.Name())
+}
+
+// GSUtilOutput runs the subset of `gsutil` commands used by the release
+// tooling against the local file system: `ls`, `cat`, `cp` and `stat`.
+// Global and command flags like `-m` or `-h` are ignored, except that `cp -n`
+// does not overwrite existing files.
+func (l *Local) GSUtilOutput(args ...string) (string, error) {
+	cmd, paths, noClobber := parseGSUtilArgs(args)
+	for i := range paths {
+		paths[i] = Path(paths[i])
+	}
+
+	switch cmd {
+	case "ls":
+		if len(paths) != 1 {
+			return "", errors.New("ls requires exactly one path")
+		}
+
+		return list(paths[0])
+
+	case "cat":
+		if len(paths) != 1 {
+			return "", errors.New("cat requires exactly one path")
+		}
+
+		content, err := os.ReadFile(paths[0])
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", paths[0], err)
+		}
+
+		return strings.TrimSpace(string(content)), nil
+
+	case "stat":
+		if len(paths) != 1 {
+			return "", errors.New("stat requires exactly one path")
+		}
+
+		if _, err := os.Stat(paths[0]); err != nil {
+			return "", fmt.Errorf("stat %s: %w", paths[0], err)
+		}
+
+		return "", nil
+
+	case "cp":
+		if len(paths) != 2 {
+			return "", errors.New("cp requires a source and a destination")
+		}
+
+		store := &Local{noClobber: noClobber}
+
+		return "", store.copy(paths[0], paths[1])
+	}
+
+	return "", fmt.Errorf("unsupported gsutil command for local bucket: %q", cmd)
+}
+
+// list returns the entries of a directory or the path itself if it is a
+// file, similar to `gsutil ls`.
+func list(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("%s matched no objects: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return FilePrefix + path, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return "", fmt.Errorf("read dir %s: %w", path, err)
+	}
+
+	res := []string{}
+
+	for _, entry := range entries {
+		name := FilePrefix + filepath.Join(path, entry.Name())
+		if entry.IsDir() {
+			name += "/"
+		}
+
+		res = append(res, name)
+	}
+
+	sort.Strings(res)
+
+	return strings.Join(res, "\n"), nil
+}
+
+// copy copies `src` to `dst` by using the semantics of `gsutil cp -r`: if
+// `dst` is an existing directory, then `src` gets copied into it.
+func (l *Local) copy(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("stat source %s: %w", src, err)
+	}
+
+	if dstInfo, err := os.Stat(dst); err == nil && dstInfo.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(src))
+	}
+
+	if !info.IsDir() {
+		return l.copyFile(src, dst)
+	}
+
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return fmt.Errorf("get relative path of %s: %w", path, err)
+		}
+
+		target := filepath.Join(dst, rel)
+
+		if d.IsDir() {
+			return os.MkdirAll(target, os.FileMode(0o755))
+		}
+
+		return l.copyFile(path, target)
+	})
+}
+
+func (l *Local) copyFile(src, dst string) error {
+	if l.noClobber {
+		if _, err := os.Stat(dst); err == nil {
+			logrus.Infof("Skipping existing file %s", dst)
+
+			return nil
+		}
+	}
+
+	return copyFile(src, dst)
+}
+
+func copyFile(src, dst string) (err error) {
+	if err := os.MkdirAll(filepath.Dir(dst), os.FileMode(0o755)); err != nil {
+		return fmt.Errorf("create destination dir: %w", err)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open source file: %w", err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("create destination file: %w", err)
+	}
+
+	defer func() {
+		if closeErr := out.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close destination file: %w", closeErr)
+		}
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("copy %s to %s: %w", src, dst, err)
+	}
+
+	return nil
+}
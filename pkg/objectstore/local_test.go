@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/objectstore"
+)
+
+func TestIsLocal(t *testing.T) {
+	require.True(t, objectstore.IsLocal("file:///tmp/bucket"))
+	require.True(t, objectstore.IsLocal(filepath.Join("file:///tmp/bucket", "release")))
+	require.False(t, objectstore.IsLocal("gs://bucket"))
+	require.False(t, objectstore.IsLocal("/tmp/bucket"))
+
+	require.Equal(t, "/tmp/bucket/release", objectstore.Path("file:/tmp/bucket/release"))
+	require.Equal(t, "gs://bucket", objectstore.Path("gs://bucket"))
+}
+
+func TestLocalNormalizePath(t *testing.T) {
+	for _, tc := range []struct {
+		parts       []string
+		expected    string
+		shouldError bool
+	}{
+		{ // single path
+			parts:    []string{"file:///tmp/bucket"},
+			expected: "file:///tmp/bucket",
+		},
+		{ // multiple parts
+			parts:    []string{"file:///tmp/bucket", "release", "v1.20.0"},
+			expected: "file:///tmp/bucket/release/v1.20.0",
+		},
+		{ // joined path
+			parts:    []string{filepath.Join("file:///tmp/bucket", "stage")},
+			expected: "file:///tmp/bucket/stage",
+		},
+		{ // no parts
+			shouldError: true,
+		},
+		{ // empty path
+			parts:       []string{""},
+			shouldError: true,
+		},
+		{ // prefix in later part
+			parts:       []string{"file:///tmp/bucket", "file:///tmp/other"},
+			shouldError: true,
+		},
+	} {
+		res, err := objectstore.NewLocal().NormalizePath(tc.parts...)
+		if tc.shouldError {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, res)
+	}
+}
+
+func TestLocalCopy(t *testing.T) {
+	bucketDir := t.TempDir()
+	bucket := objectstore.FilePrefix + bucketDir
+	sut := objectstore.NewLocal()
+
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "bin"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "bin", "kubectl"), []byte("kubectl"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "kubernetes.tar.gz"), []byte("tar"), 0o600))
+
+	// Rsync a local directory into the bucket
+	dst := filepath.Join(bucket, "release", "v1.20.0")
+	require.NoError(t, sut.RsyncRecursive(srcDir, dst))
+	exists, err := sut.PathExists(objectstore.FilePrefix + filepath.Join(bucketDir, "release", "v1.20.0", "bin", "kubectl"))
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// Copy a single file to the bucket, no clobber keeps existing files
+	newFile := filepath.Join(t.TempDir(), "kubernetes.tar.gz")
+	require.NoError(t, os.WriteFile(newFile, []byte("new"), 0o600))
+	require.NoError(t, sut.CopyToRemote(newFile, filepath.Join(dst, "kubernetes.tar.gz")))
+	content, err := os.ReadFile(filepath.Join(bucketDir, "release", "v1.20.0", "kubernetes.tar.gz"))
+	require.NoError(t, err)
+	require.Equal(t, "tar", string(content))
+
+	sut.SetOptions(sut.WithNoClobber(false))
+	require.NoError(t, sut.CopyToRemote(newFile, filepath.Join(dst, "kubernetes.tar.gz")))
+	content, err = os.ReadFile(filepath.Join(bucketDir, "release", "v1.20.0", "kubernetes.tar.gz"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+
+	// Copy a directory into an existing local directory
+	localDir := t.TempDir()
+	require.NoError(t, sut.CopyToLocal(filepath.Join(dst, "bin"), localDir))
+	require.FileExists(t, filepath.Join(localDir, "bin", "kubectl"))
+
+	// Missing sources
+	require.NoError(t, sut.CopyToRemote(filepath.Join(srcDir, "missing"), dst))
+	sut.SetOptions(sut.WithAllowMissing(false))
+	require.Error(t, sut.CopyToRemote(filepath.Join(srcDir, "missing"), dst))
+	require.Error(t, sut.CopyToLocal(filepath.Join(dst, "missing"), localDir))
+
+	exists, err = sut.PathExists(filepath.Join(bucket, "missing"))
+	require.Error(t, err)
+	require.False(t, exists)
+	exists, err = sut.PathExists(objectstore.FilePrefix + filepath.Join(bucketDir, "missing"))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, sut.CheckBucket(bucket))
+	require.Error(t, sut.CheckBucket(objectstore.FilePrefix+filepath.Join(bucketDir, "missing")))
+}
+
+func TestLocalGSUtilOutput(t *testing.T) {
+	bucketDir := t.TempDir()
+	bucket := objectstore.FilePrefix + bucketDir
+	sut := objectstore.NewLocal()
+
+	src := filepath.Join(t.TempDir(), "latest")
+	require.NoError(t, os.WriteFile(src, []byte("v1.20.0\n"), 0o600))
+
+	_, err := sut.GSUtilOutput(
+		"-m", "-h", "Content-Type:text/plain", "cp", src, bucket+"/release/latest.txt",
+	)
+	require.NoError(t, err)
+
+	res, err := sut.GSUtilOutput("cat", bucket+"/release/latest.txt")
+	require.NoError(t, err)
+	require.Equal(t, "v1.20.0", res)
+
+	res, err = sut.GSUtilOutput("ls", bucket+"/release")
+	require.NoError(t, err)
+	require.Equal(t, bucket+"/release/latest.txt", res)
+
+	_, err = sut.GSUtilOutput("-q", "stat", bucket+"/release/latest.txt")
+	require.NoError(t, err)
+
+	_, err = sut.GSUtilOutput("-q", "stat", bucket+"/release/stable.txt")
+	require.Error(t, err)
+
+	_, err = sut.GSUtilOutput("acl", "ch", bucket)
+	require.Error(t, err)
+}
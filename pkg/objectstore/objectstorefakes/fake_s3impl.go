@@ -0,0 +1,281 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by counterfeiter. DO NOT EDIT.
+package objectstorefakes
+
+import (
+	"sync"
+)
+
+type FakeS3Impl struct {
+	AWSStub        func(...string) error
+	aWSMutex       sync.RWMutex
+	aWSArgsForCall []struct {
+		arg1 []string
+	}
+	aWSReturns struct {
+		result1 error
+	}
+	aWSReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AWSOutputStub        func(...string) (string, error)
+	aWSOutputMutex       sync.RWMutex
+	aWSOutputArgsForCall []struct {
+		arg1 []string
+	}
+	aWSOutputReturns struct {
+		result1 string
+		result2 error
+	}
+	aWSOutputReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	AWSStatusStub        func(...string) (bool, error)
+	aWSStatusMutex       sync.RWMutex
+	aWSStatusArgsForCall []struct {
+		arg1 []string
+	}
+	aWSStatusReturns struct {
+		result1 bool
+		result2 error
+	}
+	aWSStatusReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeS3Impl) AWS(arg1 ...string) error {
+	fake.aWSMutex.Lock()
+	ret, specificReturn := fake.aWSReturnsOnCall[len(fake.aWSArgsForCall)]
+	fake.aWSArgsForCall = append(fake.aWSArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.AWSStub
+	fakeReturns := fake.aWSReturns
+	fake.recordInvocation("AWS", []interface{}{arg1})
+	fake.aWSMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeS3Impl) AWSCallCount() int {
+	fake.aWSMutex.RLock()
+	defer fake.aWSMutex.RUnlock()
+	return len(fake.aWSArgsForCall)
+}
+
+func (fake *FakeS3Impl) AWSCalls(stub func(...string) error) {
+	fake.aWSMutex.Lock()
+	defer fake.aWSMutex.Unlock()
+	fake.AWSStub = stub
+}
+
+func (fake *FakeS3Impl) AWSArgsForCall(i int) []string {
+	fake.aWSMutex.RLock()
+	defer fake.aWSMutex.RUnlock()
+	argsForCall := fake.aWSArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3Impl) AWSReturns(result1 error) {
+	fake.aWSMutex.Lock()
+	defer fake.aWSMutex.Unlock()
+	fake.AWSStub = nil
+	fake.aWSReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3Impl) AWSReturnsOnCall(i int, result1 error) {
+	fake.aWSMutex.Lock()
+	defer fake.aWSMutex.Unlock()
+	fake.AWSStub = nil
+	if fake.aWSReturnsOnCall == nil {
+		fake.aWSReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.aWSReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeS3Impl) AWSOutput(arg1 ...string) (string, error) {
+	fake.aWSOutputMutex.Lock()
+	ret, specificReturn := fake.aWSOutputReturnsOnCall[len(fake.aWSOutputArgsForCall)]
+	fake.aWSOutputArgsForCall = append(fake.aWSOutputArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.AWSOutputStub
+	fakeReturns := fake.aWSOutputReturns
+	fake.recordInvocation("AWSOutput", []interface{}{arg1})
+	fake.aWSOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Impl) AWSOutputCallCount() int {
+	fake.aWSOutputMutex.RLock()
+	defer fake.aWSOutputMutex.RUnlock()
+	return len(fake.aWSOutputArgsForCall)
+}
+
+func (fake *FakeS3Impl) AWSOutputCalls(stub func(...string) (string, error)) {
+	fake.aWSOutputMutex.Lock()
+	defer fake.aWSOutputMutex.Unlock()
+	fake.AWSOutputStub = stub
+}
+
+func (fake *FakeS3Impl) AWSOutputArgsForCall(i int) []string {
+	fake.aWSOutputMutex.RLock()
+	defer fake.aWSOutputMutex.RUnlock()
+	argsForCall := fake.aWSOutputArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3Impl) AWSOutputReturns(result1 string, result2 error) {
+	fake.aWSOutputMutex.Lock()
+	defer fake.aWSOutputMutex.Unlock()
+	fake.AWSOutputStub = nil
+	fake.aWSOutputReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Impl) AWSOutputReturnsOnCall(i int, result1 string, result2 error) {
+	fake.aWSOutputMutex.Lock()
+	defer fake.aWSOutputMutex.Unlock()
+	fake.AWSOutputStub = nil
+	if fake.aWSOutputReturnsOnCall == nil {
+		fake.aWSOutputReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.aWSOutputReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Impl) AWSStatus(arg1 ...string) (bool, error) {
+	fake.aWSStatusMutex.Lock()
+	ret, specificReturn := fake.aWSStatusReturnsOnCall[len(fake.aWSStatusArgsForCall)]
+	fake.aWSStatusArgsForCall = append(fake.aWSStatusArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.AWSStatusStub
+	fakeReturns := fake.aWSStatusReturns
+	fake.recordInvocation("AWSStatus", []interface{}{arg1})
+	fake.aWSStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeS3Impl) AWSStatusCallCount() int {
+	fake.aWSStatusMutex.RLock()
+	defer fake.aWSStatusMutex.RUnlock()
+	return len(fake.aWSStatusArgsForCall)
+}
+
+func (fake *FakeS3Impl) AWSStatusCalls(stub func(...string) (bool, error)) {
+	fake.aWSStatusMutex.Lock()
+	defer fake.aWSStatusMutex.Unlock()
+	fake.AWSStatusStub = stub
+}
+
+func (fake *FakeS3Impl) AWSStatusArgsForCall(i int) []string {
+	fake.aWSStatusMutex.RLock()
+	defer fake.aWSStatusMutex.RUnlock()
+	argsForCall := fake.aWSStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeS3Impl) AWSStatusReturns(result1 bool, result2 error) {
+	fake.aWSStatusMutex.Lock()
+	defer fake.aWSStatusMutex.Unlock()
+	fake.AWSStatusStub = nil
+	fake.aWSStatusReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Impl) AWSStatusReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.aWSStatusMutex.Lock()
+	defer fake.aWSStatusMutex.Unlock()
+	fake.AWSStatusStub = nil
+	if fake.aWSStatusReturnsOnCall == nil {
+		fake.aWSStatusReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.aWSStatusReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeS3Impl) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.aWSMutex.RLock()
+	defer fake.aWSMutex.RUnlock()
+	fake.aWSOutputMutex.RLock()
+	defer fake.aWSOutputMutex.RUnlock()
+	fake.aWSStatusMutex.RLock()
+	defer fake.aWSStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeS3Impl) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
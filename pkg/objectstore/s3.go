@@ -0,0 +1,479 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/release-sdk/object"
+	"sigs.k8s.io/release-utils/command"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//go:generate /usr/bin/env bash -c "cat ../../hack/boilerplate/boilerplate.generatego.txt objectstorefakes/fake_s3impl.go > objectstorefakes/_fake_s3impl.go && mv objectstorefakes/_fake_s3impl.go objectstorefakes/fake_s3impl.go"
+
+const (
+	// S3Prefix is the URL prefix for S3 compatible object stores.
+	S3Prefix = "s3://"
+
+	// S3EndpointEnvKey is the environment variable used by the `aws` CLI to
+	// select a custom S3 compatible endpoint, for example a MinIO server.
+	S3EndpointEnvKey = "AWS_ENDPOINT_URL"
+
+	// S3DefaultEndpoint is the endpoint used for public URLs if
+	// `S3EndpointEnvKey` is not set.
+	S3DefaultEndpoint = "https://s3.amazonaws.com"
+
+	awsExecutable = "aws"
+
+	// s3PrefixJoined is the prefix of a `S3Prefix` path after it went
+	// through `filepath.Join()`.
+	s3PrefixJoined = "s3:/"
+)
+
+// IsS3 returns true if the provided path or bucket refers to an S3 compatible
+// object store.
+func IsS3(path string) bool {
+	return strings.HasPrefix(path, s3PrefixJoined)
+}
+
+// IsGCS returns true if the provided path or bucket neither refers to a local
+// nor to an S3 bucket.
+func IsGCS(path string) bool {
+	return !IsLocal(path) && !IsS3(path)
+}
+
+// S3PublicURL returns the public path-style URL of the provided S3 path by
+// using the endpoint from `S3EndpointEnvKey` or `S3DefaultEndpoint`.
+func S3PublicURL(path string) string {
+	endpoint := os.Getenv(S3EndpointEnvKey)
+	if endpoint == "" {
+		endpoint = S3DefaultEndpoint
+	}
+
+	return strings.TrimSuffix(endpoint, "/") + "/" + trimS3Prefix(path)
+}
+
+func trimS3Prefix(path string) string {
+	return strings.TrimLeft(strings.TrimPrefix(path, s3PrefixJoined), "/")
+}
+
+// S3 is an `object.Store` for S3 compatible object stores which uses the
+// `aws` CLI. Custom endpoints like MinIO can be selected by setting
+// `S3EndpointEnvKey`.
+type S3 struct {
+	impl         s3Impl
+	noClobber    bool
+	allowMissing bool
+}
+
+// NewS3 creates a new `S3` object store with the same defaults as
+// `object.NewGCS()`.
+func NewS3() *S3 {
+	return &S3{
+		impl:         &defaultS3Impl{},
+		noClobber:    true,
+		allowMissing: true,
+	}
+}
+
+// SetImpl can be used to set the internal `aws` CLI implementation.
+func (s *S3) SetImpl(impl s3Impl) {
+	s.impl = impl
+}
+
+//counterfeiter:generate . s3Impl
+type s3Impl interface {
+	AWS(args ...string) error
+	AWSOutput(args ...string) (string, error)
+	AWSStatus(args ...string) (bool, error)
+}
+
+type defaultS3Impl struct{}
+
+func (*defaultS3Impl) AWS(args ...string) error {
+	return command.New(awsExecutable, args...).RunSilentSuccess()
+}
+
+func (*defaultS3Impl) AWSOutput(args ...string) (string, error) {
+	stream, err := command.New(awsExecutable, args...).RunSilentSuccessOutput()
+	if err != nil {
+		return "", fmt.Errorf("executing %s: %w", awsExecutable, err)
+	}
+
+	return stream.OutputTrimNL(), nil
+}
+
+func (*defaultS3Impl) AWSStatus(args ...string) (bool, error) {
+	status, err := command.New(awsExecutable, args...).RunSilent()
+	if err != nil {
+		return false, err
+	}
+
+	return status.Success(), nil
+}
+
+func (s *S3) SetOptions(opts ...object.OptFn) {
+	for _, f := range opts {
+		f(s)
+	}
+}
+
+// WithConcurrent is a no-op, because the `aws` CLI always transfers
+// concurrently.
+func (s *S3) WithConcurrent(bool) object.OptFn {
+	return func(object.Store) {}
+}
+
+// WithRecursive is a no-op, because directories are always copied
+// recursively.
+func (s *S3) WithRecursive(bool) object.OptFn {
+	return func(object.Store) {}
+}
+
+func (s *S3) WithNoClobber(noClobber bool) object.OptFn {
+	return func(object.Store) {
+		s.noClobber = noClobber
+	}
+}
+
+func (s *S3) WithAllowMissing(allowMissing bool) object.OptFn {
+	return func(object.Store) {
+		s.allowMissing = allowMissing
+	}
+}
+
+// NormalizePath joins the path parts and ensures that the result is prefixed
+// with `S3Prefix`.
+func (s *S3) NormalizePath(pathParts ...string) (string, error) {
+	switch len(pathParts) {
+	case 0:
+		return "", errors.New("must contain at least one path part")
+	case 1:
+		if pathParts[0] == "" {
+			return "", errors.New("path should not be an empty string")
+		}
+	}
+
+	for i, part := range pathParts {
+		if i > 0 && IsS3(part) {
+			return "", fmt.Errorf(
+				"one of the path parts contained a `%s`, which may suggest a filepath.Join() error in the caller",
+				s3PrefixJoined,
+			)
+		}
+	}
+
+	path := trimS3Prefix(filepath.Join(pathParts...))
+	if path == "" || path == "." {
+		return "", errors.New("all paths provided were empty")
+	}
+
+	return S3Prefix + path, nil
+}
+
+// IsPathNormalized determines if a path is prefixed with `s3://`.
+func (s *S3) IsPathNormalized(path string) bool {
+	return strings.HasPrefix(path, S3Prefix) &&
+		!strings.Contains(strings.TrimPrefix(path, S3Prefix), s3PrefixJoined)
+}
+
+// PathExists returns true if the specified object or prefix exists.
+func (s *S3) PathExists(path string) (bool, error) {
+	if !s.IsPathNormalized(path) {
+		return false, fmt.Errorf("path %s does not begin with `%s`", path, S3Prefix)
+	}
+
+	exists, err := s.objectExists(path)
+	if err != nil {
+		return false, err
+	}
+
+	if exists || s.isPrefix(path) {
+		logrus.Infof("Found %s", path)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// objectExists returns true if the path is an existing object.
+func (s *S3) objectExists(path string) (bool, error) {
+	bucket, key, _ := strings.Cut(trimS3Prefix(path), "/")
+	if key == "" {
+		return false, nil
+	}
+
+	exists, err := s.impl.AWSStatus(
+		"s3api", "head-object", "--bucket", bucket, "--key", key,
+	)
+	if err != nil {
+		return false, fmt.Errorf("checking if object %s exists: %w", path, err)
+	}
+
+	return exists, nil
+}
+
+// isPrefix returns true if the path is a prefix containing objects, which
+// is the S3 equivalent of a directory.
+func (s *S3) isPrefix(path string) bool {
+	output, err := s.impl.AWSOutput(
+		"s3", "ls", strings.TrimSuffix(path, "/")+"/",
+	)
+
+	return err == nil && output != ""
+}
+
+// CopyToRemote copies a local file or directory to the specified S3 path.
+func (s *S3) CopyToRemote(src, remote string) error {
+	logrus.Infof("Copying %s to S3 (%s)", src, remote)
+
+	remote, err := s.NormalizePath(remote)
+	if err != nil {
+		return fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		if s.allowMissing {
+			logrus.Infof("Source directory (%s) does not exist. Skipping S3 upload.", src)
+
+			return nil
+		}
+
+		return errors.New("source directory does not exist")
+	}
+
+	// Like gsutil, copy into existing prefixes
+	if s.isPrefix(remote) {
+		remote = remote + "/" + filepath.Base(src)
+	}
+
+	return s.copy(src, remote, info.IsDir(), nil)
+}
+
+// CopyToLocal copies an S3 path to the specified local file or directory.
+func (s *S3) CopyToLocal(remote, dst string) error {
+	logrus.Infof("Copying S3 (%s) to %s", remote, dst)
+
+	remote, err := s.NormalizePath(remote)
+	if err != nil {
+		return fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	// Like gsutil, copy into existing directories
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(strings.TrimSuffix(remote, "/")))
+	}
+
+	return s.copy(remote, dst, s.isPrefix(remote), nil)
+}
+
+// CopyBucketToBucket copies between two S3 paths.
+func (s *S3) CopyBucketToBucket(src, dst string) error {
+	logrus.Infof("Copying %s to %s", src, dst)
+
+	src, err := s.NormalizePath(src)
+	if err != nil {
+		return fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	dst, err = s.NormalizePath(dst)
+	if err != nil {
+		return fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	return s.copy(src, dst, s.isPrefix(src), nil)
+}
+
+// copy runs `aws s3 cp` and honors the no clobber option for single files.
+func (s *S3) copy(src, dst string, recursive bool, headers map[string]string) error {
+	if s.noClobber && !recursive {
+		exists, err := s.exists(dst)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			logrus.Infof("Skipping existing file %s", dst)
+
+			return nil
+		}
+	}
+
+	args := []string{"s3", "cp"}
+	if recursive {
+		args = append(args, "--recursive")
+	}
+
+	if contentType, ok := headers["content-type"]; ok {
+		args = append(args, "--content-type", contentType)
+	}
+
+	if cacheControl, ok := headers["cache-control"]; ok {
+		args = append(args, "--cache-control", cacheControl)
+	}
+
+	args = append(args, src, dst)
+
+	if err := s.impl.AWS(args...); err != nil {
+		return fmt.Errorf("s3 copy: %w", err)
+	}
+
+	return nil
+}
+
+// exists checks if a local or S3 file exists.
+func (s *S3) exists(path string) (bool, error) {
+	if IsS3(path) {
+		return s.objectExists(path)
+	}
+
+	_, err := os.Stat(path)
+
+	return err == nil, nil
+}
+
+// RsyncRecursive runs `aws s3 sync`. Both paths can either be S3 paths or
+// local directories.
+func (s *S3) RsyncRecursive(src, dst string) error {
+	if err := s.impl.AWS("s3", "sync", src, dst); err != nil {
+		return fmt.Errorf("running aws s3 sync: %w", err)
+	}
+
+	return nil
+}
+
+// GetReleasePath returns an S3 path to retrieve builds from or push builds to.
+//
+// Expected destination format:
+//
+//	s3://<bucket>/<gcsRoot>[/fast][/<version>]
+func (s *S3) GetReleasePath(
+	bucket, gcsRoot, version string, fast bool,
+) (string, error) {
+	parts, err := releasePathParts(bucket, gcsRoot, version, fast)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := s.NormalizePath(parts...)
+	if err != nil {
+		return "", fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	logrus.Infof("Release path is %s", path)
+
+	return path, nil
+}
+
+// GetMarkerPath returns an S3 path where version markers should be stored.
+//
+// Expected destination format:
+//
+//	s3://<bucket>/<gcsRoot>[/fast]
+func (s *S3) GetMarkerPath(
+	bucket, gcsRoot string, fast bool,
+) (string, error) {
+	parts, err := releasePathParts(bucket, gcsRoot, "", fast)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := s.NormalizePath(parts...)
+	if err != nil {
+		return "", fmt.Errorf("normalize S3 path: %w", err)
+	}
+
+	logrus.Infof("Version marker path is %s", path)
+
+	return path, nil
+}
+
+// CheckBucket verifies that the bucket exists and is accessible.
+func (s *S3) CheckBucket(bucket string) error {
+	name, _, _ := strings.Cut(trimS3Prefix(bucket), "/")
+
+	if err := s.impl.AWS("s3api", "head-bucket", "--bucket", name); err != nil {
+		return fmt.Errorf("access S3 bucket %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// GSUtilOutput translates the subset of `gsutil` commands used by the release
+// tooling into `aws` CLI calls: `ls`, `cat`, `cp` and `stat`. The
+// `Content-Type` and `Cache-Control` headers are passed to `cp`.
+func (s *S3) GSUtilOutput(args ...string) (string, error) {
+	cmd, paths, noClobber := parseGSUtilArgs(args)
+
+	switch cmd {
+	case "ls":
+		if len(paths) != 1 {
+			return "", errors.New("ls requires exactly one path")
+		}
+
+		return s.impl.AWSOutput("s3", "ls", paths[0])
+
+	case "cat":
+		if len(paths) != 1 {
+			return "", errors.New("cat requires exactly one path")
+		}
+
+		output, err := s.impl.AWSOutput("s3", "cp", paths[0], "-")
+		if err != nil {
+			return "", fmt.Errorf("read %s: %w", paths[0], err)
+		}
+
+		return strings.TrimSpace(output), nil
+
+	case "stat":
+		if len(paths) != 1 {
+			return "", errors.New("stat requires exactly one path")
+		}
+
+		exists, err := s.objectExists(paths[0])
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return "", fmt.Errorf("stat %s: %w", paths[0], fs.ErrNotExist)
+		}
+
+		return "", nil
+
+	case "cp":
+		if len(paths) != 2 {
+			return "", errors.New("cp requires a source and a destination")
+		}
+
+		store := &S3{impl: s.impl, noClobber: noClobber}
+
+		return "", store.copy(paths[0], paths[1], false, gsutilHeaders(args))
+	}
+
+	return "", fmt.Errorf("unsupported gsutil command for S3 bucket: %q", cmd)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/objectstore"
+	"k8s.io/release/pkg/objectstore/objectstorefakes"
+)
+
+func TestS3PublicURL(t *testing.T) {
+	t.Setenv(objectstore.S3EndpointEnvKey, "")
+	require.Equal(t, "https://s3.amazonaws.com/bucket/release", objectstore.S3PublicURL("s3://bucket/release"))
+
+	t.Setenv(objectstore.S3EndpointEnvKey, "http://minio:9000/")
+	require.Equal(t, "http://minio:9000/bucket/release", objectstore.S3PublicURL(filepath.Join("s3://bucket", "release")))
+}
+
+func TestS3NormalizePath(t *testing.T) {
+	sut := objectstore.NewS3()
+
+	res, err := sut.NormalizePath("s3://bucket", "release", "v1.20.0")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/release/v1.20.0", res)
+
+	res, err = sut.GetMarkerPath("s3://bucket", "release", true)
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/release/fast", res)
+
+	_, err = sut.NormalizePath("s3://bucket", "s3://other")
+	require.Error(t, err)
+
+	_, err = sut.GetReleasePath("s3://bucket", "", "v1.20.0", false)
+	require.Error(t, err)
+}
+
+func TestS3CopyToRemote(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "kubernetes.tar.gz")
+	require.NoError(t, os.WriteFile(src, []byte("tar"), 0o600))
+
+	for _, tc := range []struct {
+		prepare   func(*objectstorefakes.FakeS3Impl, *objectstore.S3)
+		assert    func(*objectstorefakes.FakeS3Impl)
+		src       string
+		shouldErr bool
+	}{
+		{ // success
+			prepare: func(*objectstorefakes.FakeS3Impl, *objectstore.S3) {},
+			assert: func(mock *objectstorefakes.FakeS3Impl) {
+				require.Equal(t, 1, mock.AWSCallCount())
+				require.Equal(t,
+					[]string{"s3", "cp", src, "s3://bucket/stage/kubernetes.tar.gz"},
+					mock.AWSArgsForCall(0),
+				)
+			},
+		},
+		{ // no clobber skips existing object
+			prepare: func(mock *objectstorefakes.FakeS3Impl, _ *objectstore.S3) {
+				mock.AWSStatusReturns(true, nil)
+			},
+			assert: func(mock *objectstorefakes.FakeS3Impl) {
+				require.Zero(t, mock.AWSCallCount())
+			},
+		},
+		{ // clobber overwrites existing object
+			prepare: func(mock *objectstorefakes.FakeS3Impl, sut *objectstore.S3) {
+				mock.AWSStatusReturns(true, nil)
+				sut.SetOptions(sut.WithNoClobber(false))
+			},
+			assert: func(mock *objectstorefakes.FakeS3Impl) {
+				require.Equal(t, 1, mock.AWSCallCount())
+			},
+		},
+		{ // existing prefix
+			prepare: func(mock *objectstorefakes.FakeS3Impl, _ *objectstore.S3) {
+				mock.AWSOutputReturns("PRE v1.20.0/", nil)
+			},
+			assert: func(mock *objectstorefakes.FakeS3Impl) {
+				require.Equal(t,
+					[]string{"s3", "cp", src, "s3://bucket/stage/kubernetes.tar.gz/kubernetes.tar.gz"},
+					mock.AWSArgsForCall(0),
+				)
+			},
+		},
+		{ // missing source allowed
+			src:     "/missing",
+			prepare: func(*objectstorefakes.FakeS3Impl, *objectstore.S3) {},
+			assert: func(mock *objectstorefakes.FakeS3Impl) {
+				require.Zero(t, mock.AWSCallCount())
+			},
+		},
+		{ // missing source not allowed
+			src: "/missing",
+			prepare: func(_ *objectstorefakes.FakeS3Impl, sut *objectstore.S3) {
+				sut.SetOptions(sut.WithAllowMissing(false))
+			},
+			shouldErr: true,
+		},
+		{ // aws fails
+			prepare: func(mock *objectstorefakes.FakeS3Impl, _ *objectstore.S3) {
+				mock.AWSReturns(errors.New("error"))
+			},
+			shouldErr: true,
+		},
+	} {
+		sut := objectstore.NewS3()
+		mock := &objectstorefakes.FakeS3Impl{}
+		mock.AWSOutputReturns("", errors.New("not found"))
+		sut.SetImpl(mock)
+		tc.prepare(mock, sut)
+
+		file := src
+		if tc.src != "" {
+			file = tc.src
+		}
+
+		err := sut.CopyToRemote(file, "s3://bucket/stage/kubernetes.tar.gz")
+		if tc.shouldErr {
+			require.Error(t, err)
+
+			continue
+		}
+
+		require.NoError(t, err)
+		tc.assert(mock)
+	}
+}
+
+func TestS3CopyToLocal(t *testing.T) {
+	sut := objectstore.NewS3()
+	mock := &objectstorefakes.FakeS3Impl{}
+	sut.SetImpl(mock)
+
+	dir := t.TempDir()
+
+	// Prefixes are copied recursively into existing directories
+	mock.AWSOutputReturns("PRE bin/", nil)
+	require.NoError(t, sut.CopyToLocal("s3://bucket/stage/images", dir))
+	require.Equal(t,
+		[]string{"s3", "cp", "--recursive", "s3://bucket/stage/images", filepath.Join(dir, "images")},
+		mock.AWSArgsForCall(0),
+	)
+}
+
+func TestS3GSUtilOutput(t *testing.T) {
+	sut := objectstore.NewS3()
+	mock := &objectstorefakes.FakeS3Impl{}
+	sut.SetImpl(mock)
+
+	// cp with headers
+	_, err := sut.GSUtilOutput(
+		"-m",
+		"-h", "Content-Type:text/plain",
+		"-h", "Cache-Control:private, max-age=0, no-transform",
+		"cp", "/tmp/latest", "s3://bucket/release/latest.txt",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"s3", "cp",
+		"--content-type", "text/plain",
+		"--cache-control", "private, max-age=0, no-transform",
+		"/tmp/latest", "s3://bucket/release/latest.txt",
+	}, mock.AWSArgsForCall(0))
+
+	// cat
+	mock.AWSOutputReturns("v1.20.0\n", nil)
+	res, err := sut.GSUtilOutput("cat", "s3://bucket/release/latest.txt")
+	require.NoError(t, err)
+	require.Equal(t, "v1.20.0", res)
+	require.Equal(t,
+		[]string{"s3", "cp", "s3://bucket/release/latest.txt", "-"},
+		mock.AWSOutputArgsForCall(0),
+	)
+
+	// stat
+	mock.AWSStatusReturns(true, nil)
+	_, err = sut.GSUtilOutput("-q", "stat", "s3://bucket/release/index.json")
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{"s3api", "head-object", "--bucket", "bucket", "--key", "release/index.json"},
+		mock.AWSStatusArgsForCall(0),
+	)
+
+	mock.AWSStatusReturns(false, nil)
+	_, err = sut.GSUtilOutput("-q", "stat", "s3://bucket/release/index.json")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// unsupported
+	_, err = sut.GSUtilOutput("acl", "ch", "s3://bucket")
+	require.Error(t, err)
+}
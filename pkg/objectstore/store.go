@@ -0,0 +1,336 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/release-sdk/gcli"
+	"sigs.k8s.io/release-sdk/object"
+)
+
+// Store is an `object.Store` which dispatches all operations on `file://`
+// paths to the local file system backend, on `s3://` paths to the S3 backend
+// and everything else to GCS. This allows the release tooling to select the
+// backend only by the configured bucket.
+type Store struct {
+	gcs   *object.GCS
+	local *Local
+	s3    *S3
+}
+
+// New creates a new `Store` with the default options of `object.NewGCS()`.
+func New() *Store {
+	return &Store{
+		gcs:   object.NewGCS(),
+		local: NewLocal(),
+		s3:    NewS3(),
+	}
+}
+
+// S3 returns the S3 backend of the store.
+func (s *Store) S3() *S3 {
+	return s.s3
+}
+
+func (s *Store) SetOptions(opts ...object.OptFn) {
+	for _, f := range opts {
+		f(s)
+	}
+}
+
+func (s *Store) WithConcurrent(concurrent bool) object.OptFn {
+	return func(object.Store) {
+		s.gcs.SetOptions(s.gcs.WithConcurrent(concurrent))
+		s.local.SetOptions(s.local.WithConcurrent(concurrent))
+		s.s3.SetOptions(s.s3.WithConcurrent(concurrent))
+	}
+}
+
+func (s *Store) WithRecursive(recursive bool) object.OptFn {
+	return func(object.Store) {
+		s.gcs.SetOptions(s.gcs.WithRecursive(recursive))
+		s.local.SetOptions(s.local.WithRecursive(recursive))
+		s.s3.SetOptions(s.s3.WithRecursive(recursive))
+	}
+}
+
+func (s *Store) WithNoClobber(noClobber bool) object.OptFn {
+	return func(object.Store) {
+		s.gcs.SetOptions(s.gcs.WithNoClobber(noClobber))
+		s.local.SetOptions(s.local.WithNoClobber(noClobber))
+		s.s3.SetOptions(s.s3.WithNoClobber(noClobber))
+	}
+}
+
+func (s *Store) WithAllowMissing(allowMissing bool) object.OptFn {
+	return func(object.Store) {
+		s.gcs.SetOptions(s.gcs.WithAllowMissing(allowMissing))
+		s.local.SetOptions(s.local.WithAllowMissing(allowMissing))
+		s.s3.SetOptions(s.s3.WithAllowMissing(allowMissing))
+	}
+}
+
+// URL joins the path parts and prefixes the result with `FilePrefix` for
+// local buckets, `S3Prefix` for S3 buckets or with `object.GcsPrefix`
+// otherwise.
+func URL(pathParts ...string) string {
+	path := filepath.Join(pathParts...)
+	if IsLocal(path) {
+		return FilePrefix + Path(path)
+	}
+
+	if IsS3(path) {
+		return S3Prefix + trimS3Prefix(path)
+	}
+
+	return object.GcsPrefix + strings.TrimLeft(strings.TrimPrefix(path, "gs:/"), "/")
+}
+
+// backend returns the store responsible for the provided path.
+func (s *Store) backend(path string) object.Store {
+	switch {
+	case IsLocal(path):
+		return s.local
+	case IsS3(path):
+		return s.s3
+	}
+
+	return s.gcs
+}
+
+// gsutilBackend returns the `gsutil` emulation for the provided arguments,
+// or nil if `gsutil` itself should be used.
+func (s *Store) gsutilBackend(args []string) interface {
+	GSUtilOutput(args ...string) (string, error)
+} {
+	switch {
+	case slices.ContainsFunc(args, IsS3):
+		return s.s3
+	case slices.ContainsFunc(args, IsLocal):
+		return s.local
+	}
+
+	return nil
+}
+
+func (s *Store) NormalizePath(pathParts ...string) (string, error) {
+	if len(pathParts) == 0 {
+		return "", errors.New("must contain at least one path part")
+	}
+
+	return s.backend(pathParts[0]).NormalizePath(pathParts...)
+}
+
+func (s *Store) IsPathNormalized(path string) bool {
+	return s.backend(path).IsPathNormalized(path)
+}
+
+func (s *Store) PathExists(path string) (bool, error) {
+	return s.backend(path).PathExists(path)
+}
+
+func (s *Store) CopyToRemote(local, remote string) error {
+	return s.backend(remote).CopyToRemote(local, remote)
+}
+
+func (s *Store) CopyToLocal(remote, local string) error {
+	return s.backend(remote).CopyToLocal(remote, local)
+}
+
+func (s *Store) CopyBucketToBucket(src, dst string) error {
+	if IsLocal(src) != IsLocal(dst) || IsS3(src) != IsS3(dst) {
+		return fmt.Errorf(
+			"copying between different object stores is not supported: %s to %s",
+			src, dst,
+		)
+	}
+
+	return s.backend(src).CopyBucketToBucket(src, dst)
+}
+
+// RsyncRecursive selects the backend by the bucket paths, while plain local
+// directories are supported by all of them. Syncing between different
+// object stores is not supported.
+func (s *Store) RsyncRecursive(src, dst string) error {
+	var backend object.Store
+
+	for _, path := range []string{src, dst} {
+		var b object.Store
+
+		switch {
+		case IsLocal(path):
+			b = s.local
+		case IsS3(path):
+			b = s.s3
+		case s.gcs.IsPathNormalized(path):
+			b = s.gcs
+		default:
+			continue
+		}
+
+		if backend != nil && backend != b {
+			return fmt.Errorf(
+				"syncing between different object stores is not supported: %s to %s",
+				src, dst,
+			)
+		}
+
+		backend = b
+	}
+
+	if backend == nil {
+		backend = s.local
+	}
+
+	return backend.RsyncRecursive(src, dst)
+}
+
+func (s *Store) GetReleasePath(
+	bucket, gcsRoot, version string, fast bool,
+) (string, error) {
+	return s.backend(bucket).GetReleasePath(bucket, gcsRoot, version, fast)
+}
+
+func (s *Store) GetMarkerPath(
+	bucket, gcsRoot string, fast bool,
+) (string, error) {
+	return s.backend(bucket).GetMarkerPath(bucket, gcsRoot, fast)
+}
+
+// GSUtil runs `gsutil` with the provided arguments, or its emulation if any
+// of them is a `file://` or `s3://` path.
+func (s *Store) GSUtil(args ...string) error {
+	if backend := s.gsutilBackend(args); backend != nil {
+		_, err := backend.GSUtilOutput(args...)
+
+		return err
+	}
+
+	return gcli.GSUtil(args...)
+}
+
+// GSUtilOutput runs `gsutil` with the provided arguments, or its emulation if
+// any of them is a `file://` or `s3://` path, and returns its output.
+func (s *Store) GSUtilOutput(args ...string) (string, error) {
+	if backend := s.gsutilBackend(args); backend != nil {
+		return backend.GSUtilOutput(args...)
+	}
+
+	return gcli.GSUtilOutput(args...)
+}
+
+// GSUtilStatus runs `gsutil` with the provided arguments, or its emulation if
+// any of them is a `file://` or `s3://` path, and returns if it succeeded.
+func (s *Store) GSUtilStatus(args ...string) (bool, error) {
+	if backend := s.gsutilBackend(args); backend != nil {
+		_, err := backend.GSUtilOutput(args...)
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+
+	status, err := gcli.GSUtilStatus(args...)
+	if err != nil {
+		return false, err
+	}
+
+	return status.Success(), nil
+}
+
+// CheckBucket verifies that a local or S3 bucket is accessible. GCS buckets
+// have to be checked by the caller.
+func (s *Store) CheckBucket(bucket string) error {
+	switch {
+	case IsLocal(bucket):
+		return s.local.CheckBucket(bucket)
+	case IsS3(bucket):
+		return s.s3.CheckBucket(bucket)
+	}
+
+	return fmt.Errorf("%s is not a local or S3 bucket", bucket)
+}
+
+// releasePathParts returns the path parts of the release or version marker
+// directory by using the same layout as `object.GCS`:
+//
+//	<bucket>/<gcsRoot>[/fast][/<version>]
+func releasePathParts(bucket, gcsRoot, version string, fast bool) ([]string, error) {
+	if gcsRoot == "" {
+		return nil, errors.New("GCS root must be specified")
+	}
+
+	parts := []string{bucket, gcsRoot}
+	if fast {
+		parts = append(parts, "fast")
+	}
+
+	if version != "" {
+		parts = append(parts, version)
+	}
+
+	return parts, nil
+}
+
+// parseGSUtilArgs splits `gsutil` arguments into the command, its paths and
+// the `-n` (no clobber) flag. Flags with values like `-h` are skipped.
+func parseGSUtilArgs(args []string) (cmd string, paths []string, noClobber bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		switch {
+		case arg == "-h" || arg == "-o":
+			i++
+		case arg == "-n":
+			noClobber = true
+		case strings.HasPrefix(arg, "-"):
+		case cmd == "":
+			cmd = arg
+		default:
+			paths = append(paths, arg)
+		}
+	}
+
+	return cmd, paths, noClobber
+}
+
+// gsutilHeaders returns the values of all `-h` header arguments.
+func gsutilHeaders(args []string) map[string]string {
+	headers := map[string]string{}
+
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] != "-h" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(args[i+1], ":")
+		if ok {
+			headers[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+		}
+
+		i++
+	}
+
+	return headers
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/objectstore"
+)
+
+func TestStoreDispatch(t *testing.T) {
+	sut := objectstore.New()
+
+	res, err := sut.NormalizePath("file:///tmp/bucket", "release")
+	require.NoError(t, err)
+	require.Equal(t, "file:///tmp/bucket/release", res)
+
+	res, err = sut.NormalizePath("bucket", "release")
+	require.NoError(t, err)
+	require.Equal(t, "gs://bucket/release", res)
+
+	res, err = sut.GetMarkerPath("file:///tmp/bucket", "release", true)
+	require.NoError(t, err)
+	require.Equal(t, "file:///tmp/bucket/release/fast", res)
+
+	res, err = sut.GetReleasePath("bucket", "release", "v1.20.0", false)
+	require.NoError(t, err)
+	require.Equal(t, "gs://bucket/release/v1.20.0", res)
+
+	res, err = sut.NormalizePath("s3://bucket", "release")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/release", res)
+
+	require.Error(t, sut.CopyBucketToBucket("file:///tmp/bucket", "gs://bucket"))
+	require.Error(t, sut.CopyBucketToBucket("s3://bucket", "gs://bucket"))
+	require.Error(t, sut.RsyncRecursive("gs://bucket", "file:///tmp/bucket"))
+	require.Error(t, sut.RsyncRecursive("s3://bucket/stage", "file:///tmp/bucket"))
+}
+
+func TestStoreGSUtilStatus(t *testing.T) {
+	bucketDir := t.TempDir()
+	bucket := objectstore.FilePrefix + bucketDir
+	require.NoError(t, os.WriteFile(filepath.Join(bucketDir, "index.json"), []byte("{}"), 0o600))
+
+	sut := objectstore.New()
+
+	success, err := sut.GSUtilStatus("-q", "stat", bucket+"/index.json")
+	require.NoError(t, err)
+	require.True(t, success)
+
+	success, err = sut.GSUtilStatus("-q", "stat", bucket+"/missing.json")
+	require.NoError(t, err)
+	require.False(t, success)
+}
+
+func TestURL(t *testing.T) {
+	require.Equal(t, "file:///tmp/bucket/stage/v1.20.0", objectstore.URL("file:///tmp/bucket", "stage", "v1.20.0"))
+	require.Equal(t, "gs://bucket/stage/v1.20.0", objectstore.URL("bucket", "stage", "v1.20.0"))
+	require.Equal(t, "gs://bucket/stage", objectstore.URL("gs://bucket", "stage"))
+	require.Equal(t, "s3://bucket/stage", objectstore.URL("s3://bucket", "stage"))
+}
@@ -17,14 +17,32 @@ limitations under the License.
 package release
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/google"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/nozzle/throttler"
 	"github.com/sirupsen/logrus"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -35,17 +53,33 @@ import (
 	"k8s.io/release/pkg/consts"
 )
 
+// DefaultMaxImageWorkers is the default amount of images which get pushed or
+// validated in parallel.
+const DefaultMaxImageWorkers = 5
+
+// DefaultImageRetryBackoff is the default backoff used for retrying registry
+// operations which failed with a transient error.
+var DefaultImageRetryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   1.5,
+	Steps:    5,
+}
+
 // Images is a wrapper around container image related functionality.
 type Images struct {
 	imageImpl
-	signer *sign.Signer
+	signer       *sign.Signer
+	maxWorkers   int
+	retryBackoff wait.Backoff
 }
 
 // NewImages creates a new Images instance.
 func NewImages() *Images {
 	return &Images{
-		imageImpl: &defaultImageImpl{},
-		signer:    sign.New(sign.Default()),
+		imageImpl:    &defaultImageImpl{},
+		signer:       sign.New(sign.Default()),
+		maxWorkers:   DefaultMaxImageWorkers,
+		retryBackoff: DefaultImageRetryBackoff,
 	}
 }
 
@@ -54,6 +88,22 @@ func (i *Images) SetImpl(impl imageImpl) {
 	i.imageImpl = impl
 }
 
+// SetMaxWorkers sets the maximum amount of images which get pushed or
+// validated in parallel. Values lower than 1 reset it to the default.
+func (i *Images) SetMaxWorkers(maxWorkers int) {
+	if maxWorkers < 1 {
+		maxWorkers = DefaultMaxImageWorkers
+	}
+
+	i.maxWorkers = maxWorkers
+}
+
+// SetRetryBackoff sets the backoff for retrying registry operations which
+// failed with a transient error.
+func (i *Images) SetRetryBackoff(backoff wait.Backoff) {
+	i.retryBackoff = backoff
+}
+
 // imageImpl is a client for working with container images.
 //
 //counterfeiter:generate . imageImpl
@@ -61,6 +111,9 @@ type imageImpl interface {
 	Execute(cmd string, args ...string) error
 	ExecuteOutput(cmd string, args ...string) (string, error)
 	RepoTagFromTarball(path string) (string, error)
+	ImageFromTarball(path, tag string) (v1.Image, error)
+	WriteImage(reference string, image v1.Image) error
+	WriteIndex(reference string, index v1.ImageIndex) error
 	SignImage(*sign.Signer, string) error
 	VerifyImage(*sign.Signer, string) error
 }
@@ -81,15 +134,45 @@ func (*defaultImageImpl) ExecuteOutput(cmd string, args ...string) (string, erro
 }
 
 func (*defaultImageImpl) RepoTagFromTarball(path string) (string, error) {
-	tagOutput, err := command.
-		New("tar", "xf", path, "manifest.json", "-O").
-		Pipe("jq", "-r", ".[0].RepoTags[0]").
-		RunSilentSuccessOutput()
+	manifest, err := tarball.LoadManifest(func() (io.ReadCloser, error) {
+		return os.Open(path)
+	})
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("load manifest from tarball: %w", err)
+	}
+
+	if len(manifest) == 0 || len(manifest[0].RepoTags) == 0 {
+		return "", fmt.Errorf("no repo tags found in tarball %s", path)
 	}
 
-	return tagOutput.OutputTrimNL(), nil
+	return manifest[0].RepoTags[0], nil
+}
+
+func (*defaultImageImpl) ImageFromTarball(path, tag string) (v1.Image, error) {
+	imageTag, err := name.NewTag(tag)
+	if err != nil {
+		return nil, fmt.Errorf("parse tag %s: %w", tag, err)
+	}
+
+	return tarball.ImageFromPath(path, &imageTag)
+}
+
+func (*defaultImageImpl) WriteImage(reference string, image v1.Image) error {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return fmt.Errorf("parse reference %s: %w", reference, err)
+	}
+
+	return remote.Write(ref, image, remote.WithAuthFromKeychain(keychain))
+}
+
+func (*defaultImageImpl) WriteIndex(reference string, index v1.ImageIndex) error {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return fmt.Errorf("parse reference %s: %w", reference, err)
+	}
+
+	return remote.WriteIndex(ref, index, remote.WithAuthFromKeychain(keychain))
 }
 
 func (*defaultImageImpl) SignImage(signer *sign.Signer, reference string) error {
@@ -108,10 +191,20 @@ func (*defaultImageImpl) VerifyImage(_ *sign.Signer, _ string) error {
 	return nil
 }
 
-var tagRegex = regexp.MustCompile(`^.+/(.+):.+$`)
+var (
+	tagRegex = regexp.MustCompile(`^.+/(.+):.+$`)
 
-// PublishImages releases container images to the provided target registry.
-func (i *Images) Publish(registry, version, buildPath string) error {
+	// keychain resolves the registry credentials from the docker config and
+	// falls back to the Google application default credentials.
+	keychain = authn.NewMultiKeychain(authn.DefaultKeychain, google.Keychain)
+)
+
+// Publish releases container images to the provided target registry without
+// requiring a container runtime. The per architecture images are read from
+// their tarballs and pushed, before the manifest list of every image gets
+// assembled and pushed, too. Both happens in parallel and all failures are
+// reported together. It returns the digests of all pushed references.
+func (i *Images) Publish(registry, version, buildPath string) (map[string]string, error) {
 	version = i.normalizeVersion(version)
 
 	releaseImagesPath := filepath.Join(buildPath, ImagesPath)
@@ -120,129 +213,286 @@ func (i *Images) Publish(registry, version, buildPath string) error {
 		releaseImagesPath, registry,
 	)
 
+	tarballs := []imageTarball{}
+
 	manifestImages, err := i.GetManifestImages(
 		registry, version, buildPath,
 		func(path, origTag, newTagWithArch string) error {
-			if err := i.Execute(
-				"docker", "load", "-qi", path,
-			); err != nil {
-				return fmt.Errorf("load container image: %w", err)
-			}
-
-			if err := i.Execute(
-				"docker", "tag", origTag, newTagWithArch,
-			); err != nil {
-				return fmt.Errorf("tag container image: %w", err)
-			}
-
-			logrus.Infof("Pushing %s", newTagWithArch)
-
-			if err := i.Execute(
-				"gcloud", "docker", "--", "push", newTagWithArch,
-			); err != nil {
-				return fmt.Errorf("push container image: %w", err)
-			}
-
-			if err := i.SignImage(i.signer, newTagWithArch); err != nil {
-				return fmt.Errorf("sign container image: %w", err)
-			}
-
-			if err := i.Execute(
-				"docker", "rmi", origTag, newTagWithArch,
-			); err != nil {
-				return fmt.Errorf("remove local container image: %w", err)
-			}
+			tarballs = append(tarballs, imageTarball{path, origTag, newTagWithArch})
 
 			return nil
 		},
 	)
 	if err != nil {
-		return fmt.Errorf("get manifest images: %w", err)
+		return nil, fmt.Errorf("get manifest images: %w", err)
 	}
 
-	if err := os.Setenv("DOCKER_CLI_EXPERIMENTAL", "enabled"); err != nil {
-		return fmt.Errorf("enable docker experimental CLI: %w", err)
+	var mu sync.Mutex
+
+	digests := map[string]string{}
+	archImages := map[string]v1.Image{}
+
+	if err := i.forEach(len(tarballs), func(idx int) error {
+		source := tarballs[idx]
+
+		image, digest, err := i.publishTarball(source)
+		if err != nil {
+			return fmt.Errorf("publish %s: %w", source.path, err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		digests[source.newTagWithArch] = digest
+		archImages[source.newTagWithArch] = image
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("publish container images: %w", err)
 	}
 
-	for image, arches := range manifestImages {
-		imageVersion := fmt.Sprintf("%s:%s", image, version)
-		logrus.Infof("Creating manifest image %s", imageVersion)
+	images := slices.Sorted(maps.Keys(manifestImages))
 
-		manifests := []string{}
-		for _, arch := range arches {
+	if err := i.forEach(len(images), func(idx int) error {
+		imageVersion := fmt.Sprintf("%s:%s", images[idx], version)
+
+		manifests := []v1.Image{}
+		for _, arch := range manifestImages[images[idx]] {
 			manifests = append(manifests,
-				fmt.Sprintf("%s-%s:%s", image, arch, version),
+				archImages[fmt.Sprintf("%s-%s:%s", images[idx], arch, version)],
 			)
 		}
 
-		if err := i.Execute("docker", append(
-			[]string{"manifest", "create", "--amend", imageVersion},
-			manifests...,
-		)...); err != nil {
-			return fmt.Errorf("create manifest: %w", err)
+		digest, err := i.publishManifestList(
+			imageVersion, manifestImages[images[idx]], manifests,
+		)
+		if err != nil {
+			return fmt.Errorf("publish manifest list %s: %w", imageVersion, err)
 		}
 
-		for _, arch := range arches {
-			logrus.Infof(
-				"Annotating %s-%s:%s with --arch %s",
-				image, arch, version, arch,
-			)
+		mu.Lock()
+		defer mu.Unlock()
 
-			if err := i.Execute(
-				"docker", "manifest", "annotate", "--arch", arch,
-				imageVersion, fmt.Sprintf("%s-%s:%s", image, arch, version),
-			); err != nil {
-				return fmt.Errorf("annotate manifest with arch: %w", err)
-			}
+		digests[imageVersion] = digest
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("publish manifest lists: %w", err)
+	}
+
+	return digests, nil
+}
+
+// imageTarball is a single image tarball of the build to be published.
+type imageTarball struct {
+	path           string
+	origTag        string
+	newTagWithArch string
+}
+
+// publishTarball pushes and signs the image of a single tarball and returns
+// it together with its digest.
+func (i *Images) publishTarball(source imageTarball) (v1.Image, string, error) {
+	image, err := i.ImageFromTarball(source.path, source.origTag)
+	if err != nil {
+		return nil, "", fmt.Errorf("load container image: %w", err)
+	}
+
+	logrus.Infof("Pushing %s", source.newTagWithArch)
+
+	if err := i.retry(func() error {
+		return i.WriteImage(source.newTagWithArch, image)
+	}); err != nil {
+		return nil, "", fmt.Errorf("push container image: %w", err)
+	}
+
+	digest, err := image.Digest()
+	if err != nil {
+		return nil, "", fmt.Errorf("get container image digest: %w", err)
+	}
+
+	if err := i.SignImage(i.signer, source.newTagWithArch); err != nil {
+		return nil, "", fmt.Errorf("sign container image: %w", err)
+	}
+
+	return image, digest.String(), nil
+}
+
+// publishManifestList assembles, pushes and signs the manifest list of the
+// provided per architecture images and returns its digest.
+func (i *Images) publishManifestList(
+	imageVersion string, arches []string, images []v1.Image,
+) (string, error) {
+	logrus.Infof("Creating manifest image %s", imageVersion)
+
+	var index v1.ImageIndex = empty.Index
+
+	for idx, arch := range arches {
+		mediaType, err := indexMediaType(images[idx])
+		if err != nil {
+			return "", fmt.Errorf("get manifest media type: %w", err)
 		}
 
-		logrus.Infof("Pushing manifest image %s", imageVersion)
-
-		if err := wait.ExponentialBackoff(wait.Backoff{
-			Duration: time.Second,
-			Factor:   1.5,
-			Steps:    5,
-		}, func() (bool, error) {
-			if err := i.Execute("docker", "manifest", "push", imageVersion, "--purge"); err == nil {
-				return true, nil
-			} else if strings.Contains(err.Error(), "request canceled while waiting for connection") {
-				// The error is unfortunately not exported:
-				// https://github.com/golang/go/blob/dc04f3b/src/net/http/client.go#L720
-				// https://github.com/golang/go/blob/dc04f3b/src/net/http/transport.go#L2518
-				// ref: https://github.com/kubernetes/release/issues/2810
-				logrus.Info("Retrying manifest push")
-
-				return false, nil
-			}
+		platform, err := imagePlatform(images[idx], arch)
+		if err != nil {
+			return "", fmt.Errorf("get platform for %s: %w", arch, err)
+		}
+
+		index = mutate.AppendManifests(
+			mutate.IndexMediaType(index, mediaType),
+			mutate.IndexAddendum{
+				Add:        images[idx],
+				Descriptor: v1.Descriptor{Platform: platform},
+			},
+		)
+	}
+
+	logrus.Infof("Pushing manifest image %s", imageVersion)
+
+	if err := i.retry(func() error {
+		return i.WriteIndex(imageVersion, index)
+	}); err != nil {
+		return "", fmt.Errorf("push manifest: %w", err)
+	}
+
+	digest, err := index.Digest()
+	if err != nil {
+		return "", fmt.Errorf("get manifest digest: %w", err)
+	}
+
+	if err := i.SignImage(i.signer, imageVersion); err != nil {
+		return "", fmt.Errorf("sign manifest list: %w", err)
+	}
+
+	return digest.String(), nil
+}
+
+// forEach runs fn for every index up to total by using at most the configured
+// amount of parallel workers. It does not stop on failures and returns all
+// errors joined together.
+func (i *Images) forEach(total int, fn func(idx int) error) error {
+	if total == 0 {
+		return nil
+	}
+
+	maxWorkers := i.maxWorkers
+	if maxWorkers < 1 {
+		maxWorkers = DefaultMaxImageWorkers
+	}
+
+	t := throttler.New(maxWorkers, total)
+
+	for idx := range total {
+		go func() {
+			t.Done(fn(idx))
+		}()
+
+		t.Throttle()
+	}
+
+	return errors.Join(t.Errs()...)
+}
+
+// retry runs fn and retries it with the configured backoff as long as it fails
+// with a transient registry error.
+func (i *Images) retry(fn func() error) error {
+	var lastErr error
 
-			return false, err
-		}); err != nil {
-			return fmt.Errorf("push manifest: %w", err)
+	if err := wait.ExponentialBackoff(i.retryBackoff, func() (bool, error) {
+		lastErr = fn()
+		if lastErr == nil {
+			return true, nil
 		}
 
-		if err := i.SignImage(i.signer, imageVersion); err != nil {
-			return fmt.Errorf("sign manifest list: %w", err)
+		if isTransientRegistryError(lastErr) {
+			logrus.Infof("Retrying after transient registry error: %v", lastErr)
+
+			return false, nil
+		}
+
+		return false, lastErr
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return fmt.Errorf("retries exhausted: %w", lastErr)
 		}
+
+		return err
 	}
 
 	return nil
 }
 
+// isTransientRegistryError returns true if the error is worth to be retried.
+func isTransientRegistryError(err error) bool {
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		return transportErr.Temporary() ||
+			transportErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	// The error is unfortunately not exported:
+	// https://github.com/golang/go/blob/dc04f3b/src/net/http/client.go#L720
+	// https://github.com/golang/go/blob/dc04f3b/src/net/http/transport.go#L2518
+	// ref: https://github.com/kubernetes/release/issues/2810
+	return strings.Contains(err.Error(), "request canceled while waiting for connection")
+}
+
+// imagePlatform returns the platform of the image for the manifest list,
+// whereas the architecture is always the one of the images path.
+func imagePlatform(image v1.Image, arch string) (*v1.Platform, error) {
+	platform := &v1.Platform{OS: "linux", Architecture: arch}
+
+	config, err := image.ConfigFile()
+	if err != nil {
+		return nil, fmt.Errorf("get image config: %w", err)
+	}
+
+	if config.OS != "" {
+		platform.OS = config.OS
+	}
+
+	if config.Architecture == arch {
+		platform.Variant = config.Variant
+	}
+
+	return platform, nil
+}
+
+// indexMediaType returns the media type of a manifest list containing the
+// image, which is a docker manifest list for docker images and an OCI index
+// otherwise.
+func indexMediaType(image v1.Image) (types.MediaType, error) {
+	mediaType, err := image.MediaType()
+	if err != nil {
+		return "", fmt.Errorf("get image media type: %w", err)
+	}
+
+	if mediaType == types.DockerManifestSchema2 {
+		return types.DockerManifestList, nil
+	}
+
+	return types.OCIImageIndex, nil
+}
+
 // Validates that image manifests have been pushed to a specified remote
-// registry.
+// registry. The images are validated in parallel and all failures are
+// reported together.
 func (i *Images) Validate(registry, version, buildPath string) error {
 	logrus.Infof("Validating image manifests in %s", registry)
 
 	version = i.normalizeVersion(version)
 
+	archImages := []string{}
+
 	manifestImages, err := i.GetManifestImages(
 		registry, version, buildPath,
 		func(_, _, image string) error {
-			logrus.Infof("Verifying that image is signed: %s", image)
-
-			if err := i.VerifyImage(i.signer, image); err != nil {
-				return fmt.Errorf("verify signed image: %w", err)
-			}
+			archImages = append(archImages, image)
 
 			return nil
 		},
@@ -251,63 +501,100 @@ func (i *Images) Validate(registry, version, buildPath string) error {
 		return fmt.Errorf("get manifest images: %w", err)
 	}
 
+	if err := i.forEach(len(archImages), func(idx int) error {
+		logrus.Infof("Verifying that image is signed: %s", archImages[idx])
+
+		if err := i.VerifyImage(i.signer, archImages[idx]); err != nil {
+			return fmt.Errorf("verify signed image %s: %w", archImages[idx], err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("verify signed images: %w", err)
+	}
+
 	logrus.Infof("Got manifest images %+v", manifestImages)
 
-	for image, arches := range manifestImages {
-		imageVersion := fmt.Sprintf("%s:%s", image, version)
+	images := slices.Sorted(maps.Keys(manifestImages))
 
-		manifestBytes, err := crane.Manifest(imageVersion)
-		if err != nil {
-			return fmt.Errorf("get remote manifest from %s: %w", imageVersion, err)
-		}
+	if err := i.forEach(len(images), func(idx int) error {
+		return i.validateManifestList(
+			fmt.Sprintf("%s:%s", images[idx], version),
+			manifestImages[images[idx]],
+		)
+	}); err != nil {
+		return fmt.Errorf("validate manifest lists: %w", err)
+	}
 
-		logrus.Info("Verifying that image manifest list is signed")
+	return nil
+}
 
-		if err := i.VerifyImage(i.signer, imageVersion); err != nil {
-			return fmt.Errorf("verify signed manifest list: %w", err)
-		}
+// validateManifestList verifies that the remote manifest list is signed and
+// contains all provided architectures.
+func (i *Images) validateManifestList(imageVersion string, arches []string) error {
+	var manifestBytes []byte
 
-		manifest := string(manifestBytes)
+	if err := i.retry(func() (err error) {
+		manifestBytes, err = crane.Manifest(imageVersion)
 
-		manifestFile, err := os.CreateTemp("", "manifest-")
-		if err != nil {
-			return fmt.Errorf("create temp file for manifest: %w", err)
-		}
+		return err
+	}); err != nil {
+		return fmt.Errorf("get remote manifest from %s: %w", imageVersion, err)
+	}
 
-		if _, err := manifestFile.WriteString(manifest); err != nil {
-			return fmt.Errorf("write manifest to %s: %w", manifestFile.Name(), err)
-		}
+	logrus.Infof("Verifying that image manifest list %s is signed", imageVersion)
 
-		for _, arch := range arches {
-			logrus.Infof(
-				"Checking image digest for %s on %s architecture", image, arch,
-			)
+	if err := i.VerifyImage(i.signer, imageVersion); err != nil {
+		return fmt.Errorf("verify signed manifest list %s: %w", imageVersion, err)
+	}
 
-			digest, err := i.ExecuteOutput(
-				"jq", "--arg", "a", arch, "-r",
-				".manifests[] | select(.platform.architecture == $a) | .digest",
-				manifestFile.Name(),
-			)
-			if err != nil {
-				return fmt.Errorf("get digest from manifest file %s for arch %s: %w", manifestFile.Name(), arch, err)
-			}
+	manifestFile, err := os.CreateTemp("", "manifest-")
+	if err != nil {
+		return fmt.Errorf("create temp file for manifest: %w", err)
+	}
 
-			if digest == "" {
-				return fmt.Errorf(
-					"could not find the image digest for %s on %s",
-					imageVersion, arch,
-				)
-			}
+	if _, err := manifestFile.Write(manifestBytes); err != nil {
+		return fmt.Errorf("write manifest to %s: %w", manifestFile.Name(), err)
+	}
 
-			logrus.Infof("Digest for %s on %s: %s", imageVersion, arch, digest)
+	errs := []error{}
+
+	for _, arch := range arches {
+		logrus.Infof(
+			"Checking image digest for %s on %s architecture", imageVersion, arch,
+		)
+
+		digest, err := i.ExecuteOutput(
+			"jq", "--arg", "a", arch, "-r",
+			".manifests[] | select(.platform.architecture == $a) | .digest",
+			manifestFile.Name(),
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"get digest from manifest file %s for arch %s: %w",
+				manifestFile.Name(), arch, err,
+			))
+
+			continue
 		}
 
-		if err := os.RemoveAll(manifestFile.Name()); err != nil {
-			return fmt.Errorf("remove manifest file: %w", err)
+		if digest == "" {
+			errs = append(errs, fmt.Errorf(
+				"could not find the image digest for %s on %s",
+				imageVersion, arch,
+			))
+
+			continue
 		}
+
+		logrus.Infof("Digest for %s on %s: %s", imageVersion, arch, digest)
 	}
 
-	return nil
+	if err := os.RemoveAll(manifestFile.Name()); err != nil {
+		errs = append(errs, fmt.Errorf("remove manifest file: %w", err))
+	}
+
+	return errors.Join(errs...)
 }
 
 // Exists verifies that a set of image manifests exists on a specified remote
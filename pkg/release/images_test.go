@@ -19,14 +19,29 @@ package release_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
-
-	"github.com/stretchr/testify/assert"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/stretchr/testify/require"
 
+	"k8s.io/apimachinery/pkg/util/wait"
+
 	"k8s.io/release/pkg/release"
 	"k8s.io/release/pkg/release/releasefakes"
 )
@@ -35,27 +50,56 @@ func TestPublish(t *testing.T) {
 	t.Parallel()
 
 	for _, tc := range []struct {
-		name        string
-		prepare     func(*releasefakes.FakeImageImpl) (buildPath string, cleanup func())
-		shouldError bool
+		name          string
+		prepare       func(*releasefakes.FakeImageImpl) (buildPath string)
+		assert        func(registry string, digests map[string]string)
+		shouldError   bool
+		errorContains []string
 	}{
 		{
 			name: "success",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
+				return tempDir
+			},
+			assert: func(registry string, digests map[string]string) {
+				require.Len(t, digests, 12)
+
+				for ref, digest := range digests {
+					remoteDigest, err := crane.Digest(ref)
+					require.NoError(t, err)
+					require.Equal(t, digest, remoteDigest)
+				}
+
+				ref, err := name.ParseReference(registry + "/kube-apiserver:v1.18.9")
+				require.NoError(t, err)
+
+				index, err := remote.Index(ref)
+				require.NoError(t, err)
+
+				manifest, err := index.IndexManifest()
+				require.NoError(t, err)
+				require.Equal(t, types.DockerManifestList, manifest.MediaType)
+				require.Len(t, manifest.Manifests, 3)
+
+				for i, arch := range []string{"amd64", "arm", "arm64"} {
+					require.Equal(t, arch, manifest.Manifests[i].Platform.Architecture)
+					require.Equal(t, "linux", manifest.Manifests[i].Platform.OS)
+					require.Equal(t,
+						digests[fmt.Sprintf("%s/kube-apiserver-%s:v1.18.9", registry, arch)],
+						manifest.Manifests[i].Digest.String(),
+					)
 				}
 			},
 			shouldError: false,
 		},
 		{
 			name: "success skipping wrong dirs/files",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
 				// arch is not a directory, should be just skipped
 				require.NoError(t, os.WriteFile(
@@ -69,213 +113,217 @@ func TestPublish(t *testing.T) {
 					[]byte{}, os.FileMode(0o644),
 				))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
+			},
+			assert: func(_ string, digests map[string]string) {
+				require.Len(t, digests, 12)
 			},
 			shouldError: false,
 		},
 		{
 			name: "success no images",
-			prepare: func(*releasefakes.FakeImageImpl) (string, func()) {
-				tempDir := newImagesPath(t)
-
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+			prepare: func(*releasefakes.FakeImageImpl) string {
+				return newImagesPath(t)
+			},
+			assert: func(_ string, digests map[string]string) {
+				require.Empty(t, digests)
 			},
 			shouldError: false,
 		},
 		{
-			name: "failure on docker load",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "success with retry on transient registry error",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.ExecuteReturnsOnCall(0, errors.New(""))
+				writeImage := mock.WriteImageStub
+				mock.WriteImageStub = func(reference string, image v1.Image) error {
+					if mock.WriteImageCallCount() == 1 {
+						return &transport.Error{StatusCode: http.StatusServiceUnavailable}
+					}
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
+					return writeImage(reference, image)
 				}
-			},
-			shouldError: true,
-		},
-		{
-			name: "failure on docker tag",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
-				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
-
-				mock.ExecuteReturnsOnCall(1, errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
-			shouldError: true,
+			assert: func(_ string, digests map[string]string) {
+				require.Len(t, digests, 12)
+			},
+			shouldError: false,
 		},
 		{
-			name: "failure on docker push",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "failure on image load",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.ExecuteReturnsOnCall(2, errors.New(""))
+				mock.ImageFromTarballStub = nil
+				mock.ImageFromTarballReturns(nil, errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
-			name: "failure on docker rmi",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "failure on image push",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.ExecuteReturnsOnCall(3, errors.New(""))
+				mock.WriteImageStub = nil
+				mock.WriteImageReturns(errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
-			name: "failure on docker manifest create",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "failure on manifest push",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.ExecuteReturnsOnCall(36, errors.New(""))
+				mock.WriteIndexStub = nil
+				mock.WriteIndexReturns(errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
-			name: "failure on docker manifest annotate",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "failure on manifest push with exhausted retries",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.ExecuteReturnsOnCall(37, errors.New(""))
+				mock.WriteIndexStub = nil
+				mock.WriteIndexReturns(
+					&transport.Error{StatusCode: http.StatusTooManyRequests},
+				)
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
-			shouldError: true,
+			shouldError:   true,
+			errorContains: []string{"retries exhausted"},
 		},
 		{
-			name: "failure on docker manifest push",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			name: "failure on multiple architectures",
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				i := 0
-				mock.ExecuteCalls(func(cmd string, args ...string) error {
-					// There is an ExponentialBackoff which we have to overcome
-					if i == 40 {
-						return errors.New("")
+				imageFromTarball := mock.ImageFromTarballStub
+				mock.ImageFromTarballStub = func(path, tag string) (v1.Image, error) {
+					if strings.Contains(path, "kube-proxy") {
+						return nil, fmt.Errorf("broken tarball %s", path)
 					}
-					i++
-
-					return nil
-				})
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
+					return imageFromTarball(path, tag)
 				}
+
+				return tempDir
 			},
 			shouldError: true,
+			errorContains: []string{
+				filepath.Join("amd64", "kube-proxy.tar"),
+				filepath.Join("arm", "kube-proxy.tar"),
+				filepath.Join("arm64", "kube-proxy.tar"),
+			},
 		},
 		{
 			name: "failure get repo tag from tarball",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.RepoTagFromTarballReturnsOnCall(3, "", errors.New(""))
+				mock.RepoTagFromTarballStub = nil
+				mock.RepoTagFromTarballReturns("", errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
 			name: "failure wrong repo tag from tarball",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.RepoTagFromTarballReturnsOnCall(3, "wrong-tag", nil)
+				mock.RepoTagFromTarballStub = nil
+				mock.RepoTagFromTarballReturns("wrong-tag", nil)
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
 			name: "failure no images-path",
-			prepare: func(*releasefakes.FakeImageImpl) (string, func()) {
-				return t.TempDir(), func() {}
+			prepare: func(*releasefakes.FakeImageImpl) string {
+				return t.TempDir()
 			},
 			shouldError: true,
 		},
 		{
 			name: "failure on sign image",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
 				mock.SignImageReturns(errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 		{
 			name: "failure on sign manifest",
-			prepare: func(mock *releasefakes.FakeImageImpl) (string, func()) {
+			prepare: func(mock *releasefakes.FakeImageImpl) string {
 				tempDir := newImagesPath(t)
-				prepareImages(t, tempDir, mock)
+				prepareTarballs(t, tempDir, mock)
 
-				mock.SignImageReturnsOnCall(10, errors.New(""))
+				mock.SignImageReturnsOnCall(9, errors.New(""))
 
-				return tempDir, func() {
-					require.NoError(t, os.RemoveAll(tempDir))
-				}
+				return tempDir
 			},
 			shouldError: true,
 		},
 	} {
 		prepare := tc.prepare
+		assertDigests := tc.assert
 		shouldError := tc.shouldError
+		errorContains := tc.errorContains
 
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
+			server := httptest.NewServer(registry.New(
+				registry.Logger(log.New(io.Discard, "", 0)),
+			))
+			defer server.Close()
+
+			targetRegistry := strings.TrimPrefix(server.URL, "http://")
+
 			sut := release.NewImages()
+			sut.SetMaxWorkers(2)
+			sut.SetRetryBackoff(wait.Backoff{Duration: time.Millisecond, Steps: 3})
+
 			clientMock := &releasefakes.FakeImageImpl{}
 			sut.SetImpl(clientMock)
 
-			buildPath, cleanup := prepare(clientMock)
-			defer cleanup()
+			buildPath := prepare(clientMock)
 
-			err := sut.Publish(release.GCRIOPathProd, "v1.18.9", buildPath)
+			digests, err := sut.Publish(targetRegistry, "v1.18.9", buildPath)
 
 			if shouldError {
-				assert.Error(t, err)
+				require.Error(t, err)
+
+				for _, msg := range errorContains {
+					require.ErrorContains(t, err, msg)
+				}
 			} else {
-				assert.NoError(t, err)
+				require.NoError(t, err)
+				assertDigests(targetRegistry, digests)
 			}
 		})
 	}
@@ -424,3 +472,64 @@ func prepareImages(t *testing.T, tempDir string, mock *releasefakes.FakeImageImp
 		}
 	}
 }
+
+// prepareTarballs writes image tarballs for all architectures and lets the
+// mock read and push them natively.
+func prepareTarballs(t *testing.T, tempDir string, mock *releasefakes.FakeImageImpl) {
+	for _, arch := range []string{"amd64", "arm", "arm64"} {
+		archPath := filepath.Join(tempDir, release.ImagesPath, arch)
+		require.NoError(t, os.MkdirAll(archPath, os.FileMode(0o755)))
+
+		for _, image := range []string{
+			"conformance-amd64", "kube-apiserver", "kube-proxy",
+		} {
+			img, err := random.Image(64, 1)
+			require.NoError(t, err)
+
+			tag, err := name.NewTag(fmt.Sprintf("registry.k8s.io/%s:v1.18.9", image))
+			require.NoError(t, err)
+
+			require.NoError(t, tarball.WriteToFile(
+				filepath.Join(archPath, image+".tar"), tag, img,
+			))
+		}
+	}
+
+	mock.RepoTagFromTarballStub = func(path string) (string, error) {
+		manifest, err := tarball.LoadManifest(func() (io.ReadCloser, error) {
+			return os.Open(path)
+		})
+		if err != nil {
+			return "", err
+		}
+
+		return manifest[0].RepoTags[0], nil
+	}
+
+	mock.ImageFromTarballStub = func(path, tag string) (v1.Image, error) {
+		imageTag, err := name.NewTag(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		return tarball.ImageFromPath(path, &imageTag)
+	}
+
+	mock.WriteImageStub = func(reference string, image v1.Image) error {
+		ref, err := name.ParseReference(reference)
+		if err != nil {
+			return err
+		}
+
+		return remote.Write(ref, image)
+	}
+
+	mock.WriteIndexStub = func(reference string, index v1.ImageIndex) error {
+		ref, err := name.ParseReference(reference)
+		if err != nil {
+			return err
+		}
+
+		return remote.WriteIndex(ref, index)
+	}
+}
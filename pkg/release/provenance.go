@@ -29,17 +29,20 @@ import (
 
 	"sigs.k8s.io/bom/pkg/provenance"
 	"sigs.k8s.io/bom/pkg/spdx"
-	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/util"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 func NewProvenanceChecker(opts *ProvenanceCheckerOptions) *ProvenanceChecker {
 	p := &ProvenanceChecker{
-		objStore: object.NewGCS(),
+		objStore: objectstore.New(),
 		options:  opts,
 	}
-	p.objStore.WithConcurrent(true)
-	p.objStore.WithRecursive(true)
+	p.objStore.SetOptions(
+		p.objStore.WithConcurrent(true),
+		p.objStore.WithRecursive(true),
+	)
 	p.impl = &defaultProvenanceCheckerImpl{}
 
 	return p
@@ -47,7 +50,7 @@ func NewProvenanceChecker(opts *ProvenanceCheckerOptions) *ProvenanceChecker {
 
 // ProvenanceChecker is the main structure to check the provenance.
 type ProvenanceChecker struct {
-	objStore *object.GCS
+	objStore *objectstore.Store
 	options  *ProvenanceCheckerOptions
 	impl     provenanceCheckerImplementation
 }
@@ -64,7 +67,7 @@ func (pc *ProvenanceChecker) CheckStageProvenance(buildVersion string) error {
 	pc.options.StageDirectory = filepath.Join(pc.options.ScratchDirectory, hex.EncodeToString(h.Sum(nil)))
 
 	gcsPath, err := pc.objStore.NormalizePath(
-		object.GcsPrefix + filepath.Join(
+		filepath.Join(
 			pc.options.StageBucket, StagePath, buildVersion,
 		) + string(filepath.Separator),
 	)
@@ -122,7 +125,7 @@ type ProvenanceCheckerOptions struct {
 }
 
 type provenanceCheckerImplementation interface {
-	downloadStagedArtifacts(*ProvenanceCheckerOptions, *object.GCS, string) error
+	downloadStagedArtifacts(*ProvenanceCheckerOptions, *objectstore.Store, string) error
 	processAttestation(*ProvenanceCheckerOptions, string) (*provenance.Statement, error)
 	checkProvenance(*ProvenanceCheckerOptions, *provenance.Statement) error
 	generateFinalAttestation(opts *ProvenanceCheckerOptions, sbom, stageProvenance, version string) error
@@ -132,7 +135,7 @@ type defaultProvenanceCheckerImpl struct{}
 
 // downloadReleaseArtifacts sybc.
 func (di *defaultProvenanceCheckerImpl) downloadStagedArtifacts(
-	opts *ProvenanceCheckerOptions, objStore *object.GCS, path string,
+	opts *ProvenanceCheckerOptions, objStore *objectstore.Store, path string,
 ) error {
 	logrus.Infof("Synching stage from %s to %s", path, opts.StageDirectory)
 
@@ -161,7 +164,7 @@ func (di *defaultProvenanceCheckerImpl) processAttestation(
 
 	// We've downloaded all artifacts, so to check we need to strip
 	// the gcs bucket prefix from the subjects to read from the local copy
-	gcsPath := object.GcsPrefix + filepath.Join(opts.StageBucket, StagePath)
+	gcsPath := objectstore.URL(opts.StageBucket, StagePath)
 
 	newSubjects := []intoto.Subject{}
 
@@ -200,7 +203,7 @@ func (di *defaultProvenanceCheckerImpl) generateFinalAttestation(
 
 	// Rewrite the provenance sublects to list their full paths in the bucket
 	for i, sub := range slsaStatement.Subject {
-		slsaStatement.Subject[i].Name = object.GcsPrefix + filepath.Join(
+		slsaStatement.Subject[i].Name = objectstore.URL(
 			opts.StageBucket, "release", version, sub.Name,
 		)
 	}
@@ -286,7 +289,7 @@ func (di *defaultProvenanceReaderImpl) GetStagingSubjects(
 	}
 
 	for i, s := range dummy.Subject {
-		dummy.Subject[i].Name = object.GcsPrefix + filepath.Join(gcsPath, s.Name)
+		dummy.Subject[i].Name = objectstore.URL(gcsPath, s.Name)
 	}
 
 	return dummy.Subject, nil
@@ -322,7 +325,7 @@ func (di *defaultProvenanceReaderImpl) GetBuildSubjects(
 		// Now the tricky part. We need to re-append the version tag. Eg
 		// gcs-stage/v1.23.0-alpha.4/file.txt should be
 		// v1.23.0-alpha.4/gcs-stage/v1.23.0-alpha.4/file.txt should be
-		subject.Name = object.GcsPrefix + filepath.Join(gcsPath, version, subject.Name)
+		subject.Name = objectstore.URL(gcsPath, version, subject.Name)
 
 		newSubjects = append(newSubjects, subject)
 	}
@@ -27,10 +27,10 @@ import (
 	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 
-	"sigs.k8s.io/release-sdk/gcli"
-	"sigs.k8s.io/release-sdk/object"
 	"sigs.k8s.io/release-utils/http"
 	"sigs.k8s.io/release-utils/util"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 // Publisher is the structure for publishing anything release related.
@@ -38,15 +38,16 @@ type Publisher struct {
 	client publisherClient
 }
 
-// NewPublisher creates a new Publisher instance.
+// NewPublisher creates a new Publisher instance. Buckets prefixed with
+// `file://` are published to the local file system.
 func NewPublisher() *Publisher {
-	objStore := *object.NewGCS()
+	objStore := objectstore.New()
 	objStore.SetOptions(
 		objStore.WithNoClobber(false),
 	)
 
 	return &Publisher{
-		client: &defaultPublisher{&objStore},
+		client: &defaultPublisher{objStore},
 	}
 }
 
@@ -76,24 +77,19 @@ type publisherClient interface {
 }
 
 type defaultPublisher struct {
-	objStore object.Store
+	objStore *objectstore.Store
 }
 
-func (*defaultPublisher) GSUtil(args ...string) error {
-	return gcli.GSUtil(args...)
+func (d *defaultPublisher) GSUtil(args ...string) error {
+	return d.objStore.GSUtil(args...)
 }
 
-func (*defaultPublisher) GSUtilOutput(args ...string) (string, error) {
-	return gcli.GSUtilOutput(args...)
+func (d *defaultPublisher) GSUtilOutput(args ...string) (string, error) {
+	return d.objStore.GSUtilOutput(args...)
 }
 
-func (*defaultPublisher) GSUtilStatus(args ...string) (bool, error) {
-	status, err := gcli.GSUtilStatus(args...)
-	if err != nil {
-		return false, err
-	}
-
-	return status.Success(), nil
+func (d *defaultPublisher) GSUtilStatus(args ...string) (bool, error) {
+	return d.objStore.GSUtilStatus(args...)
 }
 
 func (*defaultPublisher) GetURLResponse(url string) (string, error) {
@@ -385,7 +381,8 @@ func (p *Publisher) PublishToGcs(
 
 	var content string
 
-	if !privateBucket {
+	// Local buckets have no public link and are validated like private ones
+	if !privateBucket && !objectstore.IsLocal(markerPath) {
 		// If public, validate public link
 		logrus.Infof("Validating uploaded version file using HTTP at %s", publicLink)
 
@@ -19,6 +19,7 @@ package release_test
 import (
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/blang/semver/v4"
@@ -161,6 +162,38 @@ func TestPublishVersion(t *testing.T) {
 	}
 }
 
+func TestPublishVersionLocalBucket(t *testing.T) {
+	const version = "v1.20.0"
+
+	bucketDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(
+		filepath.Join(bucketDir, "release", version, "bin"), os.FileMode(0o755),
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(bucketDir, "release", "stable-1.txt"), []byte("v1.21.0"), os.FileMode(0o644),
+	))
+
+	sut := release.NewPublisher()
+	require.NoError(t, sut.PublishVersion(
+		"release", version, t.TempDir(), "file://"+bucketDir, "release", nil, false, false,
+	))
+
+	for marker, expected := range map[string]string{
+		"stable.txt":      version,
+		"stable-1.txt":    "v1.21.0",
+		"stable-1.20.txt": version,
+	} {
+		content, err := os.ReadFile(filepath.Join(bucketDir, "release", marker))
+		require.NoError(t, err)
+		require.Equal(t, expected, string(content))
+	}
+
+	// The release directory has to exist
+	require.Error(t, sut.PublishVersion(
+		"release", "v1.21.0", t.TempDir(), "file://"+bucketDir, "release", nil, false, false,
+	))
+}
+
 func TestPublishReleaseNotesIndex(t *testing.T) {
 	err := errors.New("")
 
@@ -130,6 +130,16 @@ func (gp *GitObjectPusher) PushBranch(branchName string) error {
 	return nil
 }
 
+// HasRemoteTag returns true if the tag already exists in the remote repo.
+func (gp *GitObjectPusher) HasRemoteTag(tag string) (bool, error) {
+	exists, err := gp.repo.HasRemoteTag(tag)
+	if err != nil {
+		return false, fmt.Errorf("checking if tag %s exists in remote: %w", tag, err)
+	}
+
+	return exists, nil
+}
+
 // PushTags convenience method to push a list of tags to the remote repo.
 func (gp *GitObjectPusher) PushTags(tagList []string) (err error) {
 	for _, tag := range tagList {
@@ -39,6 +39,8 @@ import (
 	rhash "sigs.k8s.io/release-utils/hash"
 	"sigs.k8s.io/release-utils/tar"
 	"sigs.k8s.io/release-utils/util"
+
+	"k8s.io/release/pkg/objectstore"
 )
 
 const (
@@ -251,6 +253,15 @@ func GetWorkspaceVersion() (string, error) {
 
 // URLPrefixForBucket returns the URL prefix for the provided bucket string.
 func URLPrefixForBucket(bucket string) string {
+	// Local buckets are referenced by their file URL
+	if objectstore.IsLocal(bucket) {
+		return objectstore.FilePrefix + objectstore.Path(bucket)
+	}
+
+	if objectstore.IsS3(bucket) {
+		return objectstore.S3PublicURL(bucket)
+	}
+
 	bucket = strings.TrimPrefix(bucket, object.GcsPrefix)
 	urlPrefix := "https://storage.googleapis.com/" + bucket
 
@@ -239,6 +239,11 @@ func TestURLPrefixForBucket(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, parsed)
 	}
+
+	require.Equal(t, "file:///tmp/bucket", URLPrefixForBucket("file:///tmp/bucket"))
+
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	require.Equal(t, "https://s3.amazonaws.com/bucket/release", URLPrefixForBucket("s3://bucket/release"))
 }
 
 func TestCopyBinaries(t *testing.T) {
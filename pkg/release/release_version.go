@@ -17,6 +17,7 @@ limitations under the License.
 package release
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -103,6 +104,38 @@ func (r *Versions) String() string {
 	return sb.String()
 }
 
+// versionsJSON is the serializable representation of `Versions`.
+type versionsJSON struct {
+	Prime    string `json:"prime"`
+	Official string `json:"official,omitempty"`
+	RC       string `json:"rc,omitempty"`
+	Beta     string `json:"beta,omitempty"`
+	Alpha    string `json:"alpha,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *Versions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&versionsJSON{
+		Prime:    r.prime,
+		Official: r.official,
+		RC:       r.rc,
+		Beta:     r.beta,
+		Alpha:    r.alpha,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Versions) UnmarshalJSON(data []byte) error {
+	v := &versionsJSON{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("unmarshal release versions: %w", err)
+	}
+
+	*r = Versions{v.Prime, v.Official, v.RC, v.Beta, v.Alpha}
+
+	return nil
+}
+
 // Ordered returns a list of ordered release versions.
 func (r *Versions) Ordered() (versions []string) {
 	if r.Official() != "" {
@@ -17,6 +17,7 @@ limitations under the License.
 package release_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -180,3 +181,21 @@ func TestGenerateReleaseVersion(t *testing.T) {
 		))
 	}
 }
+
+func TestVersionsJSON(t *testing.T) {
+	versions := release.NewReleaseVersions(
+		"v1.20.0", "v1.20.0", "", "", "v1.21.0-alpha.0",
+	)
+
+	data, err := json.Marshal(versions)
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"prime":"v1.20.0","official":"v1.20.0","alpha":"v1.21.0-alpha.0"}`,
+		string(data),
+	)
+
+	res := &release.Versions{}
+	require.NoError(t, json.Unmarshal(data, res))
+	require.Equal(t, versions, res)
+	require.Equal(t, []string{"v1.20.0", "v1.21.0-alpha.0"}, res.Ordered())
+}
@@ -20,6 +20,7 @@ package releasefakes
 import (
 	"sync"
 
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"sigs.k8s.io/release-sdk/sign"
 )
 
@@ -50,6 +51,20 @@ type FakeImageImpl struct {
 		result1 string
 		result2 error
 	}
+	ImageFromTarballStub        func(string, string) (v1.Image, error)
+	imageFromTarballMutex       sync.RWMutex
+	imageFromTarballArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	imageFromTarballReturns struct {
+		result1 v1.Image
+		result2 error
+	}
+	imageFromTarballReturnsOnCall map[int]struct {
+		result1 v1.Image
+		result2 error
+	}
 	RepoTagFromTarballStub        func(string) (string, error)
 	repoTagFromTarballMutex       sync.RWMutex
 	repoTagFromTarballArgsForCall []struct {
@@ -87,6 +102,30 @@ type FakeImageImpl struct {
 	verifyImageReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WriteImageStub        func(string, v1.Image) error
+	writeImageMutex       sync.RWMutex
+	writeImageArgsForCall []struct {
+		arg1 string
+		arg2 v1.Image
+	}
+	writeImageReturns struct {
+		result1 error
+	}
+	writeImageReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteIndexStub        func(string, v1.ImageIndex) error
+	writeIndexMutex       sync.RWMutex
+	writeIndexArgsForCall []struct {
+		arg1 string
+		arg2 v1.ImageIndex
+	}
+	writeIndexReturns struct {
+		result1 error
+	}
+	writeIndexReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -218,6 +257,71 @@ func (fake *FakeImageImpl) ExecuteOutputReturnsOnCall(i int, result1 string, res
 	}{result1, result2}
 }
 
+func (fake *FakeImageImpl) ImageFromTarball(arg1 string, arg2 string) (v1.Image, error) {
+	fake.imageFromTarballMutex.Lock()
+	ret, specificReturn := fake.imageFromTarballReturnsOnCall[len(fake.imageFromTarballArgsForCall)]
+	fake.imageFromTarballArgsForCall = append(fake.imageFromTarballArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ImageFromTarballStub
+	fakeReturns := fake.imageFromTarballReturns
+	fake.recordInvocation("ImageFromTarball", []interface{}{arg1, arg2})
+	fake.imageFromTarballMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImageImpl) ImageFromTarballCallCount() int {
+	fake.imageFromTarballMutex.RLock()
+	defer fake.imageFromTarballMutex.RUnlock()
+	return len(fake.imageFromTarballArgsForCall)
+}
+
+func (fake *FakeImageImpl) ImageFromTarballCalls(stub func(string, string) (v1.Image, error)) {
+	fake.imageFromTarballMutex.Lock()
+	defer fake.imageFromTarballMutex.Unlock()
+	fake.ImageFromTarballStub = stub
+}
+
+func (fake *FakeImageImpl) ImageFromTarballArgsForCall(i int) (string, string) {
+	fake.imageFromTarballMutex.RLock()
+	defer fake.imageFromTarballMutex.RUnlock()
+	argsForCall := fake.imageFromTarballArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImageImpl) ImageFromTarballReturns(result1 v1.Image, result2 error) {
+	fake.imageFromTarballMutex.Lock()
+	defer fake.imageFromTarballMutex.Unlock()
+	fake.ImageFromTarballStub = nil
+	fake.imageFromTarballReturns = struct {
+		result1 v1.Image
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageImpl) ImageFromTarballReturnsOnCall(i int, result1 v1.Image, result2 error) {
+	fake.imageFromTarballMutex.Lock()
+	defer fake.imageFromTarballMutex.Unlock()
+	fake.ImageFromTarballStub = nil
+	if fake.imageFromTarballReturnsOnCall == nil {
+		fake.imageFromTarballReturnsOnCall = make(map[int]struct {
+			result1 v1.Image
+			result2 error
+		})
+	}
+	fake.imageFromTarballReturnsOnCall[i] = struct {
+		result1 v1.Image
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeImageImpl) RepoTagFromTarball(arg1 string) (string, error) {
 	fake.repoTagFromTarballMutex.Lock()
 	ret, specificReturn := fake.repoTagFromTarballReturnsOnCall[len(fake.repoTagFromTarballArgsForCall)]
@@ -406,6 +510,130 @@ func (fake *FakeImageImpl) VerifyImageReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeImageImpl) WriteImage(arg1 string, arg2 v1.Image) error {
+	fake.writeImageMutex.Lock()
+	ret, specificReturn := fake.writeImageReturnsOnCall[len(fake.writeImageArgsForCall)]
+	fake.writeImageArgsForCall = append(fake.writeImageArgsForCall, struct {
+		arg1 string
+		arg2 v1.Image
+	}{arg1, arg2})
+	stub := fake.WriteImageStub
+	fakeReturns := fake.writeImageReturns
+	fake.recordInvocation("WriteImage", []interface{}{arg1, arg2})
+	fake.writeImageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImageImpl) WriteImageCallCount() int {
+	fake.writeImageMutex.RLock()
+	defer fake.writeImageMutex.RUnlock()
+	return len(fake.writeImageArgsForCall)
+}
+
+func (fake *FakeImageImpl) WriteImageCalls(stub func(string, v1.Image) error) {
+	fake.writeImageMutex.Lock()
+	defer fake.writeImageMutex.Unlock()
+	fake.WriteImageStub = stub
+}
+
+func (fake *FakeImageImpl) WriteImageArgsForCall(i int) (string, v1.Image) {
+	fake.writeImageMutex.RLock()
+	defer fake.writeImageMutex.RUnlock()
+	argsForCall := fake.writeImageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImageImpl) WriteImageReturns(result1 error) {
+	fake.writeImageMutex.Lock()
+	defer fake.writeImageMutex.Unlock()
+	fake.WriteImageStub = nil
+	fake.writeImageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImageImpl) WriteImageReturnsOnCall(i int, result1 error) {
+	fake.writeImageMutex.Lock()
+	defer fake.writeImageMutex.Unlock()
+	fake.WriteImageStub = nil
+	if fake.writeImageReturnsOnCall == nil {
+		fake.writeImageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeImageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImageImpl) WriteIndex(arg1 string, arg2 v1.ImageIndex) error {
+	fake.writeIndexMutex.Lock()
+	ret, specificReturn := fake.writeIndexReturnsOnCall[len(fake.writeIndexArgsForCall)]
+	fake.writeIndexArgsForCall = append(fake.writeIndexArgsForCall, struct {
+		arg1 string
+		arg2 v1.ImageIndex
+	}{arg1, arg2})
+	stub := fake.WriteIndexStub
+	fakeReturns := fake.writeIndexReturns
+	fake.recordInvocation("WriteIndex", []interface{}{arg1, arg2})
+	fake.writeIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImageImpl) WriteIndexCallCount() int {
+	fake.writeIndexMutex.RLock()
+	defer fake.writeIndexMutex.RUnlock()
+	return len(fake.writeIndexArgsForCall)
+}
+
+func (fake *FakeImageImpl) WriteIndexCalls(stub func(string, v1.ImageIndex) error) {
+	fake.writeIndexMutex.Lock()
+	defer fake.writeIndexMutex.Unlock()
+	fake.WriteIndexStub = stub
+}
+
+func (fake *FakeImageImpl) WriteIndexArgsForCall(i int) (string, v1.ImageIndex) {
+	fake.writeIndexMutex.RLock()
+	defer fake.writeIndexMutex.RUnlock()
+	argsForCall := fake.writeIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImageImpl) WriteIndexReturns(result1 error) {
+	fake.writeIndexMutex.Lock()
+	defer fake.writeIndexMutex.Unlock()
+	fake.WriteIndexStub = nil
+	fake.writeIndexReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImageImpl) WriteIndexReturnsOnCall(i int, result1 error) {
+	fake.writeIndexMutex.Lock()
+	defer fake.writeIndexMutex.Unlock()
+	fake.WriteIndexStub = nil
+	if fake.writeIndexReturnsOnCall == nil {
+		fake.writeIndexReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeIndexReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeImageImpl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -413,12 +641,18 @@ func (fake *FakeImageImpl) Invocations() map[string][][]interface{} {
 	defer fake.executeMutex.RUnlock()
 	fake.executeOutputMutex.RLock()
 	defer fake.executeOutputMutex.RUnlock()
+	fake.imageFromTarballMutex.RLock()
+	defer fake.imageFromTarballMutex.RUnlock()
 	fake.repoTagFromTarballMutex.RLock()
 	defer fake.repoTagFromTarballMutex.RUnlock()
 	fake.signImageMutex.RLock()
 	defer fake.signImageMutex.RUnlock()
 	fake.verifyImageMutex.RLock()
 	defer fake.verifyImageMutex.RUnlock()
+	fake.writeImageMutex.RLock()
+	defer fake.writeImageMutex.RUnlock()
+	fake.writeIndexMutex.RLock()
+	defer fake.writeIndexMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
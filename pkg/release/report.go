@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	// RunTypeStage is the report type of `krel stage` runs.
+	RunTypeStage = "stage"
+
+	// RunTypeRelease is the report type of `krel release` runs.
+	RunTypeRelease = "release"
+
+	// StageReportFilename is the name of the stage report within the staging
+	// bucket directory of the build version.
+	StageReportFilename = "stage-report.json"
+
+	// ReleaseReportFilename is the name of the release report within the
+	// staging bucket directory of the build version.
+	ReleaseReportFilename = "release-report.json"
+
+	// StepOutcomeSucceeded indicates that a step finished successfully.
+	StepOutcomeSucceeded = "succeeded"
+
+	// StepOutcomeFailed indicates that a step returned an error.
+	StepOutcomeFailed = "failed"
+
+	// StepOutcomeSkipped indicates that a step has been completed by a
+	// previous run.
+	StepOutcomeSkipped = "skipped"
+)
+
+// RunReport is the machine-readable summary of a stage or release run.
+type RunReport struct {
+	// Type is either `RunTypeStage` or `RunTypeRelease`.
+	Type string `json:"type"`
+
+	// KrelVersion is the version of krel used for the run.
+	KrelVersion string `json:"krelVersion"`
+
+	// NoMock, ReleaseType, ReleaseBranch and BuildVersion are the options
+	// of the run.
+	NoMock        bool   `json:"noMock"`
+	ReleaseType   string `json:"releaseType"`
+	ReleaseBranch string `json:"releaseBranch"`
+	BuildVersion  string `json:"buildVersion"`
+
+	// StartTime and EndTime frame the whole run.
+	StartTime       time.Time `json:"startTime"`
+	EndTime         time.Time `json:"endTime"`
+	DurationSeconds float64   `json:"durationSeconds"`
+
+	// Success indicates if the run finished without any error.
+	Success bool `json:"success"`
+
+	// Error is the error message of a failed run.
+	Error string `json:"error,omitempty"`
+
+	// Versions are the release versions computed by the run.
+	Versions *Versions `json:"versions,omitempty"`
+
+	// Steps contains the outcome of every executed step in order.
+	Steps []*StepReport `json:"steps"`
+
+	// Artifacts are the produced artifacts per release version.
+	Artifacts map[string]*ArtifactsReport `json:"artifacts,omitempty"`
+}
+
+// StepReport is the outcome of a single stage or release step.
+type StepReport struct {
+	Name            string    `json:"name"`
+	StartTime       time.Time `json:"startTime"`
+	EndTime         time.Time `json:"endTime"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Outcome         string    `json:"outcome"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// ArtifactsReport contains the artifacts produced for a single version.
+type ArtifactsReport struct {
+	// Binaries are the paths to the built binaries.
+	Binaries []string `json:"binaries,omitempty"`
+
+	// Tarballs are the paths to the release tarballs.
+	Tarballs []string `json:"tarballs,omitempty"`
+
+	// ImageArchives are the paths to the container image archives.
+	ImageArchives []string `json:"imageArchives,omitempty"`
+
+	// ImageDigests maps the pushed container image references to their
+	// digests.
+	ImageDigests map[string]string `json:"imageDigests,omitempty"`
+}
+
+// NewRunReport creates a new `RunReport` for the provided run type, starting
+// now.
+func NewRunReport(runType string) *RunReport {
+	return &RunReport{
+		Type:      runType,
+		StartTime: time.Now().UTC(),
+		Steps:     []*StepReport{},
+	}
+}
+
+// StartStep adds a new step to the report and returns it.
+func (r *RunReport) StartStep(name string) *StepReport {
+	step := &StepReport{Name: name, StartTime: time.Now().UTC()}
+	r.Steps = append(r.Steps, step)
+
+	return step
+}
+
+// Finish sets the end time and the outcome of the step.
+func (s *StepReport) Finish(outcome string, err error) {
+	s.EndTime = time.Now().UTC()
+	s.DurationSeconds = s.EndTime.Sub(s.StartTime).Seconds()
+	s.Outcome = outcome
+
+	if err != nil {
+		s.Error = err.Error()
+	}
+}
+
+// Finish sets the end time and the outcome of the whole run.
+func (r *RunReport) Finish(err error) {
+	r.EndTime = time.Now().UTC()
+	r.DurationSeconds = r.EndTime.Sub(r.StartTime).Seconds()
+	r.Success = err == nil
+
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// Write serializes the report as JSON into the provided file.
+func (r *RunReport) Write(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal run report: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil { //nolint:gosec // the report is public information
+		return fmt.Errorf("write run report: %w", err)
+	}
+
+	return nil
+}
+
+// ReadRunReport reads a `RunReport` from the provided JSON file.
+func ReadRunReport(path string) (*RunReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read run report: %w", err)
+	}
+
+	report := &RunReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("unmarshal run report: %w", err)
+	}
+
+	return report, nil
+}
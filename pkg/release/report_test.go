@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package release_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/release/pkg/release"
+)
+
+func TestRunReport(t *testing.T) {
+	report := release.NewRunReport(release.RunTypeStage)
+	report.Versions = release.NewReleaseVersions("", "v1.20.0", "", "", "")
+
+	report.StartStep("build").Finish(release.StepOutcomeSucceeded, nil)
+	report.StartStep("stage-artifacts").Finish(release.StepOutcomeFailed, errors.New("error"))
+	report.Finish(errors.New("stage-artifacts: error"))
+
+	require.False(t, report.Success)
+	require.Equal(t, "stage-artifacts: error", report.Error)
+	require.Len(t, report.Steps, 2)
+	require.Equal(t, "error", report.Steps[1].Error)
+	require.False(t, report.EndTime.Before(report.StartTime))
+
+	path := filepath.Join(t.TempDir(), release.StageReportFilename)
+	require.NoError(t, report.Write(path))
+
+	res, err := release.ReadRunReport(path)
+	require.NoError(t, err)
+	require.Equal(t, report.Type, res.Type)
+	require.Equal(t, report.Steps, res.Steps)
+	require.Equal(t, "v1.20.0", res.Versions.Official())
+
+	_, err = release.ReadRunReport(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}